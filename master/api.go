@@ -0,0 +1,456 @@
+package main
+
+import (
+	"crypto/subtle"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"net"
+	"net/http"
+	"protocol"
+	"strings"
+	"time"
+)
+
+//go:embed openapi.yaml
+var openApiSpec []byte
+
+type apiResult struct {
+	res any
+	err error
+}
+
+// apiAsync is returned by api functions whose result is only known once a slave
+// answered. The command queue sends the result to the channel later.
+type apiAsync chan apiResult
+
+func newApiAsync() apiAsync {
+	return make(apiAsync, 1)
+}
+
+// slaveReply returns a callback for slave requests that completes the async
+// result with no content or a bad gateway error.
+func (async apiAsync) slaveReply(err error) {
+	if err != nil {
+		async <- apiResult{err: newApiError(http.StatusBadGateway, "slave failed: %v", err)}
+		return
+	}
+	async <- apiResult{}
+}
+
+// apiError is an error with the HTTP status code it should be reported with.
+type apiError struct {
+	status int
+	msg    string
+}
+
+func (e *apiError) Error() string {
+	return e.msg
+}
+
+func newApiError(status int, format string, args ...any) error {
+	return &apiError{status: status, msg: fmt.Sprintf(format, args...)}
+}
+
+type apiServer struct {
+	m  *master
+	ch chan<- any
+}
+
+func (m *master) startApiServer(ch chan<- any) error {
+	api := &apiServer{m: m, ch: ch}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(openApiSpec)
+	})
+	api.handle(mux, "GET /api/v1/groups", api.listGroups)
+	api.handle(mux, "POST /api/v1/groups", api.createGroup)
+	api.handle(mux, "GET /api/v1/groups/{name}", api.getGroup)
+	api.handle(mux, "PUT /api/v1/groups/{name}", api.updateGroup)
+	api.handle(mux, "DELETE /api/v1/groups/{name}", api.deleteGroup)
+	api.handle(mux, "GET /api/v1/services", api.listServices)
+	api.handle(mux, "POST /api/v1/services", api.startService)
+	api.handle(mux, "GET /api/v1/services/{name}", api.getService)
+	api.handle(mux, "POST /api/v1/services/{name}/stop", api.stopService)
+	api.handle(mux, "POST /api/v1/services/{name}/command", api.executeCommand)
+	api.handle(mux, "GET /api/v1/slaves", api.listSlaves)
+	api.handle(mux, "POST /api/v1/slaves/{name}/drain", api.drainSlave)
+	api.handle(mux, "GET /api/v1/templates", api.listTemplates)
+	api.handle(mux, "GET /api/v1/templates/{name}/files", api.listTemplateFiles)
+	api.handle(mux, "GET /api/v1/templates/{name}/versions", api.listTemplateVersions)
+	mux.HandleFunc("GET /api/v1/events", api.streamEvents)
+
+	lis, err := net.Listen("tcp", m.cfg.ApiBindAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	log.Printf("started api server on %s", m.cfg.ApiBindAddr)
+
+	// no write timeout, the event stream stays open for as long as the client
+	// listens
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+	go func() {
+		defer recoverPanic()
+		err := srv.Serve(lis)
+		if err != nil {
+			log.Printf("failed to serve api: %v", err)
+		}
+	}()
+	return nil
+}
+
+// handle registers an authenticated endpoint. The handler returns a function
+// that is run on the command queue, so it can safely access the master state.
+func (api *apiServer) handle(mux *http.ServeMux, pattern string, handler func(r *http.Request) (func() (any, error), error)) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		if !api.authorized(r) {
+			writeApiError(w, newApiError(http.StatusUnauthorized, "invalid api token"))
+			return
+		}
+		fn, err := handler(r)
+		if err != nil {
+			writeApiError(w, err)
+			return
+		}
+		res, err := api.run(fn)
+		if err != nil {
+			writeApiError(w, err)
+			return
+		}
+		status := http.StatusOK
+		if r.Method == http.MethodPost && res != nil {
+			status = http.StatusCreated
+		}
+		if res == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJson(w, status, res)
+	})
+}
+
+func (api *apiServer) authorized(r *http.Request) bool {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return found && subtle.ConstantTimeCompare([]byte(token), []byte(api.m.cfg.ApiToken)) == 1
+}
+
+func (api *apiServer) run(fn func() (any, error)) (any, error) {
+	resCh := make(chan apiResult)
+	api.ch <- apiCmd{fn: fn, resCh: resCh}
+	res := <-resCh
+	if async, ok := res.res.(apiAsync); ok {
+		res = <-async
+	}
+	return res.res, res.err
+}
+
+func (api *apiServer) listGroups(r *http.Request) (func() (any, error), error) {
+	return func() (any, error) {
+		groups := []*protocol.Group{}
+		for _, g := range api.m.gm.groups {
+			groups = append(groups, proto.Clone(g.Group).(*protocol.Group))
+		}
+		return groups, nil
+	}, nil
+}
+
+func (api *apiServer) getGroup(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	return func() (any, error) {
+		g := api.m.gm.getGroup(name)
+		if g == nil {
+			return nil, newApiError(http.StatusNotFound, "unknown group: %s", name)
+		}
+		return proto.Clone(g.Group), nil
+	}, nil
+}
+
+func (api *apiServer) createGroup(r *http.Request) (func() (any, error), error) {
+	var info protocol.Group
+	err := decodeJson(r, &info)
+	if err != nil {
+		return nil, err
+	}
+	return func() (any, error) {
+		if api.m.gm.getGroup(info.Name) != nil {
+			return nil, newApiError(http.StatusConflict, "group %q already exists", info.Name)
+		}
+		err := api.m.gm.createGroup(&info)
+		if err != nil {
+			return nil, newApiError(http.StatusBadRequest, "cannot create group: %v", err)
+		}
+		log.Printf("group %q created via api", info.Name)
+		return proto.Clone(&info), nil
+	}, nil
+}
+
+func (api *apiServer) updateGroup(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	var info protocol.Group
+	err := decodeJson(r, &info)
+	if err != nil {
+		return nil, err
+	}
+	return func() (any, error) {
+		g := api.m.gm.getGroup(name)
+		if g == nil {
+			return nil, newApiError(http.StatusNotFound, "unknown group: %s", name)
+		}
+		err := api.m.gm.updateGroup(g, &info)
+		if err != nil {
+			return nil, newApiError(http.StatusBadRequest, "cannot update group: %v", err)
+		}
+		log.Printf("group %q updated via api", name)
+		return proto.Clone(g.Group), nil
+	}, nil
+}
+
+func (api *apiServer) deleteGroup(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	confirm := r.URL.Query().Get("confirm") == "true"
+	return func() (any, error) {
+		g := api.m.gm.getGroup(name)
+		if g == nil {
+			return nil, newApiError(http.StatusNotFound, "unknown group: %s", name)
+		}
+		if g.Static && !confirm {
+			return nil, newApiError(http.StatusConflict, "group %q is static, set confirm=true to delete it", name)
+		}
+		err := api.m.gm.removeGroup(g)
+		if err != nil {
+			return nil, fmt.Errorf("cannot delete group: %w", err)
+		}
+		log.Printf("group %q deleted via api", name)
+		return nil, nil
+	}, nil
+}
+
+func (api *apiServer) listServices(r *http.Request) (func() (any, error), error) {
+	group := r.URL.Query().Get("group")
+	return func() (any, error) {
+		svcs := []*protocol.Service{}
+		for _, svc := range api.m.sched.services {
+			if group == "" || svc.Group == group {
+				svcs = append(svcs, proto.Clone(svc.Service).(*protocol.Service))
+			}
+		}
+		return svcs, nil
+	}, nil
+}
+
+func (api *apiServer) getService(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	return func() (any, error) {
+		svc := api.m.sched.getService(name)
+		if svc == nil {
+			return nil, newApiError(http.StatusNotFound, "unknown service: %s", name)
+		}
+		return proto.Clone(svc.Service), nil
+	}, nil
+}
+
+func (api *apiServer) startService(r *http.Request) (func() (any, error), error) {
+	var req struct {
+		Group string `json:"group"`
+	}
+	err := decodeJson(r, &req)
+	if err != nil {
+		return nil, err
+	}
+	return func() (any, error) {
+		g := api.m.gm.getGroup(req.Group)
+		if g == nil {
+			return nil, newApiError(http.StatusNotFound, "unknown group: %s", req.Group)
+		}
+		if int32(len(api.m.gm.activeServices(g))) >= g.MaxServices {
+			return nil, newApiError(http.StatusConflict, "group %q already has %d services", g.Name, g.MaxServices)
+		}
+		svc := api.m.sched.promoteWarmService(g)
+		if svc == nil {
+			svc = api.m.sched.createService(g, false)
+		}
+		return proto.Clone(svc.Service), nil
+	}, nil
+}
+
+func (api *apiServer) stopService(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	return func() (any, error) {
+		svc := api.m.sched.getService(name)
+		if svc == nil {
+			return nil, newApiError(http.StatusNotFound, "unknown service: %s", name)
+		}
+		async := newApiAsync()
+		err := api.m.sched.requestStop(svc, async.slaveReply)
+		if err != nil {
+			return nil, newApiError(http.StatusConflict, "failed to stop service: %v", err)
+		}
+		return async, nil
+	}, nil
+}
+
+func (api *apiServer) executeCommand(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	var req struct {
+		Command string `json:"command"`
+	}
+	err := decodeJson(r, &req)
+	if err != nil {
+		return nil, err
+	}
+	return func() (any, error) {
+		svc := api.m.sched.getService(name)
+		if svc == nil {
+			return nil, newApiError(http.StatusNotFound, "unknown service: %s", name)
+		}
+		if svc.s == nil || svc.State != protocol.Service_STATE_ONLINE {
+			return nil, newApiError(http.StatusConflict, "service %q is not online", name)
+		}
+		async := newApiAsync()
+		svc.s.sendRequest(&protocol.PacketExecuteServiceCommand{
+			ServiceName: svc.Name,
+			Command:     req.Command,
+		}, async.slaveReply)
+		return async, nil
+	}, nil
+}
+
+func (api *apiServer) listSlaves(r *http.Request) (func() (any, error), error) {
+	return func() (any, error) {
+		slaves := []slaveInfo{}
+		for _, slv := range api.m.sm.slaves {
+			if slv.authenticated {
+				slaves = append(slaves, slv.info())
+			}
+		}
+		return slaves, nil
+	}, nil
+}
+
+func (api *apiServer) drainSlave(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	return func() (any, error) {
+		slv := api.m.sm.getSlave(name)
+		if slv == nil || !slv.authenticated {
+			return nil, newApiError(http.StatusNotFound, "unknown slave: %s", name)
+		}
+		err := slv.drain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to drain slave: %w", err)
+		}
+		return nil, nil
+	}, nil
+}
+
+func (api *apiServer) listTemplates(r *http.Request) (func() (any, error), error) {
+	return func() (any, error) {
+		return api.m.tmpl.listTemplates()
+	}, nil
+}
+
+func (api *apiServer) listTemplateFiles(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	return func() (any, error) {
+		files, err := api.m.tmpl.listFiles(name)
+		if err != nil {
+			return nil, newApiError(http.StatusNotFound, "%v", err)
+		}
+		return files, nil
+	}, nil
+}
+
+func (api *apiServer) listTemplateVersions(r *http.Request) (func() (any, error), error) {
+	name := r.PathValue("name")
+	return func() (any, error) {
+		infos, err := api.m.tmpl.versionInfos(name)
+		if err != nil {
+			return nil, newApiError(http.StatusNotFound, "%v", err)
+		}
+		if infos == nil {
+			infos = []templateVersionInfo{}
+		}
+		return infos, nil
+	}, nil
+}
+
+// streamEvents sends events as server-sent events until the client disconnects.
+// The optional type query parameter is a comma separated list of event type
+// patterns, e.g. "service.*,slave.connected".
+func (api *apiServer) streamEvents(w http.ResponseWriter, r *http.Request) {
+	if !api.authorized(r) {
+		writeApiError(w, newApiError(http.StatusUnauthorized, "invalid api token"))
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeApiError(w, fmt.Errorf("streaming not supported"))
+		return
+	}
+	var patterns []string
+	if types := r.URL.Query().Get("type"); types != "" {
+		patterns = strings.Split(types, ",")
+	}
+	sub := api.m.events.subscribe(patterns)
+	defer api.m.events.unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e, ok := <-sub.ch:
+			if !ok {
+				return
+			}
+			b, err := json.Marshal(e)
+			if err != nil {
+				log.Printf("failed to marshal event: %v", err)
+				continue
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, b)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func decodeJson(r *http.Request, v any) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err != nil {
+		return newApiError(http.StatusBadRequest, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Printf("failed to write api response: %v", err)
+	}
+}
+
+func writeApiError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		status = apiErr.status
+	}
+	writeJson(w, status, map[string]string{"error": err.Error()})
+}
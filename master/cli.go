@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/fatih/color"
 	"github.com/urfave/cli/v3"
 	"log"
+	"path/filepath"
 	"protocol"
 	"strings"
 )
@@ -37,9 +39,11 @@ func newCli(ch chan<- any, m *master) *cli.Command {
 				Usage:   "Manage groups",
 				Commands: []*cli.Command{
 					newGroupCreateCmd(m),
+					newGroupDeleteCmd(m),
 					newGroupListCmd(m),
 					newGroupReloadCmd(m),
 					newGroupRestartCmd(m),
+					newGroupResetCmd(m),
 				},
 			},
 			{
@@ -50,11 +54,264 @@ func newCli(ch chan<- any, m *master) *cli.Command {
 					newServiceStopCmd(m),
 					newServiceListCmd(m),
 					newServiceScreenCmd(m),
+					newServiceSaveCmd(m),
 				},
 			},
+			{
+				Name:    "template",
+				Aliases: []string{"templates"},
+				Usage:   "Manage template versions",
+				Commands: []*cli.Command{
+					newTemplateVersionsCmd(m),
+					newTemplateSnapshotCmd(m),
+					newTemplateDiffCmd(m),
+					newTemplateRollbackCmd(m),
+				},
+			},
+			{
+				Name:  "events",
+				Usage: "Follow cluster events",
+				Commands: []*cli.Command{
+					newEventsTailCmd(m),
+					newEventsStopCmd(m),
+				},
+			},
+			{
+				Name:    "slave",
+				Aliases: []string{"slaves"},
+				Usage:   "Manage slaves",
+				Commands: []*cli.Command{
+					newSlaveListCmd(m),
+					newSlaveAddCmd(m),
+					newSlaveRotateCmd(m),
+					newSlaveRemoveCmd(m),
+				},
+			},
+			{
+				Name:  "ca",
+				Usage: "Manage the certificate authority for tls",
+				Commands: []*cli.Command{
+					newCaInitCmd(),
+					newCaEnrollCmd(),
+				},
+			},
+		},
+	}
+
+	return cmd
+}
+
+func newEventsTailCmd(m *master) *cli.Command {
+	var patterns []string
+	cmd := &cli.Command{
+		Name:  "tail",
+		Usage: "Print events as they happen, optionally filtered by type (e.g. service.*)",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:   "[type]",
+				Values: &patterns,
+				Min:    0,
+				Max:    -1,
+			},
+		},
+		Action: func(ctx context.Context, command *cli.Command) error {
+			if m.tail != nil {
+				return fmt.Errorf("already following events, enter 'events stop' first")
+			}
+			m.tail = m.events.subscribe(patterns)
+			go func(sub *subscription) {
+				defer recoverPanic()
+				for e := range sub.ch {
+					log.Println(formatEvent(e))
+				}
+			}(m.tail)
+			log.Println("following events, enter 'events stop' to stop")
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newEventsStopCmd(m *master) *cli.Command {
+	cmd := &cli.Command{
+		Name:  "stop",
+		Usage: "Stop following events",
+		Action: func(ctx context.Context, command *cli.Command) error {
+			if m.tail == nil {
+				return fmt.Errorf("not following events")
+			}
+			m.events.unsubscribe(m.tail)
+			m.tail = nil
+			return nil
+		},
+	}
+	return cmd
+}
+
+func formatEvent(e event) string {
+	s := color.CyanString("[%s]", e.Type)
+	if e.Service != nil {
+		s += fmt.Sprintf(" service=%s state=%s", e.Service.Name, e.Service.State)
+	}
+	if e.Group != "" {
+		s += " group=" + e.Group
+	}
+	if e.Slave != "" {
+		s += " slave=" + e.Slave
+	}
+	if e.Message != "" {
+		s += fmt.Sprintf(" message=%q", e.Message)
+	}
+	return s
+}
+
+func newCaInitCmd() *cli.Command {
+	var hosts []string
+	cmd := &cli.Command{
+		Name:  "init",
+		Usage: "Create a certificate authority and a master certificate valid for the given hosts",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:   "<host>",
+				Values: &hosts,
+				Min:    1,
+				Max:    -1,
+			},
+		},
+		Action: func(ctx context.Context, command *cli.Command) error {
+			err := initCa(hosts)
+			if err != nil {
+				return fmt.Errorf("cannot create certificate authority: %w", err)
+			}
+			log.Printf("created certificate authority in %s, set tls.enabled in master.yaml and restart to use it", pkiDir)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newCaEnrollCmd() *cli.Command {
+	var slvName string
+	cmd := &cli.Command{
+		Name:  "enroll",
+		Usage: "Issue a client certificate for a slave",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<slave>",
+				Destination: &slvName,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, command *cli.Command) error {
+			certFile, keyFile, err := enrollSlave(slvName)
+			if err != nil {
+				return fmt.Errorf("cannot enroll slave: %w", err)
+			}
+			log.Printf("issued certificate for slave %q, copy %s, %s and %s to the slave",
+				slvName, certFile, keyFile, filepath.Join(pkiDir, caCertFile))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newSlaveListCmd(m *master) *cli.Command {
+	cmd := &cli.Command{
+		Name:  "list",
+		Usage: "List slaves with reserved and used memory",
+		Action: func(ctx context.Context, command *cli.Command) error {
+			log.Println("List of slaves:")
+			var slaves []slaveInfo
+			for _, slv := range m.sm.slaves {
+				if slv.authenticated {
+					slaves = append(slaves, slv.info())
+				}
+			}
+			err := common.EncodeYamlColorized(slaves, m.term)
+			if err != nil {
+				return fmt.Errorf("cannot marshal slaves: %w", err)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newSlaveAddCmd(m *master) *cli.Command {
+	var slvName string
+	cmd := &cli.Command{
+		Name:  "add",
+		Usage: "Issue a token for a slave",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<slave>",
+				Destination: &slvName,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, command *cli.Command) error {
+			token, err := m.sm.addCredential(slvName)
+			if err != nil {
+				return fmt.Errorf("cannot add slave: %w", err)
+			}
+			_, _ = fmt.Fprintf(m.console, "token for slave %q: %s\n", slvName, token)
+			log.Println("set it as secret_key in the slave.yaml of the slave, it will not be shown again")
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newSlaveRotateCmd(m *master) *cli.Command {
+	var slvName string
+	cmd := &cli.Command{
+		Name:  "rotate",
+		Usage: "Replace the token of a slave and disconnect it",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<slave>",
+				Destination: &slvName,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, command *cli.Command) error {
+			token, err := m.sm.rotateCredential(slvName)
+			if err != nil {
+				return fmt.Errorf("cannot rotate token: %w", err)
+			}
+			_, _ = fmt.Fprintf(m.console, "new token for slave %q: %s\n", slvName, token)
+			log.Println("set it as secret_key in the slave.yaml of the slave, it will not be shown again")
+			return nil
 		},
 	}
+	return cmd
+}
 
+func newSlaveRemoveCmd(m *master) *cli.Command {
+	var slvName string
+	cmd := &cli.Command{
+		Name:  "remove",
+		Usage: "Revoke the token of a slave and disconnect it",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<slave>",
+				Destination: &slvName,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, command *cli.Command) error {
+			err := m.sm.revokeCredential(slvName)
+			if err != nil {
+				return fmt.Errorf("cannot remove slave: %w", err)
+			}
+			log.Printf("slave %q has been revoked", slvName)
+			return nil
+		},
+	}
 	return cmd
 }
 
@@ -108,7 +365,13 @@ func newServiceStopCmd(m *master) *cli.Command {
 			if svc == nil {
 				return fmt.Errorf("unknown service: %s", svcName)
 			}
-			err := m.sched.stopService(svc)
+			err := m.sched.requestStop(svc, func(err error) {
+				if err != nil {
+					log.Printf("slave failed to stop service %q: %v", svc.Name, err)
+					return
+				}
+				log.Printf("slave confirmed stopping service %q", svc.Name)
+			})
 			if err != nil {
 				return fmt.Errorf("failed to stop service: %w", err)
 			}
@@ -118,6 +381,53 @@ func newServiceStopCmd(m *master) *cli.Command {
 	return cmd
 }
 
+func newServiceSaveCmd(m *master) *cli.Command {
+	var svcName string
+	cmd := &cli.Command{
+		Name:  "save",
+		Usage: "Save the directory of a running service into a template",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "template",
+				Usage: "Template to replace, defaults to the group of the service",
+			},
+			&cli.StringSliceFlag{
+				Name:  "exclude",
+				Usage: "Glob pattern of paths that are not saved, e.g. logs",
+			},
+			&cli.BoolFlag{
+				Name:  "save-all",
+				Usage: "Flush the worlds of a server before saving",
+				Value: true,
+			},
+		},
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<service>",
+				Destination: &svcName,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			svc := m.sched.getService(svcName)
+			if svc == nil {
+				return fmt.Errorf("unknown service: %s", svcName)
+			}
+			template := cmd.String("template")
+			if template == "" {
+				template = svc.Group
+			}
+			err := m.tmpl.saveService(svc, template, cmd.StringSlice("exclude"), cmd.Bool("save-all"))
+			if err != nil {
+				return fmt.Errorf("failed to save service: %w", err)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
 func newServiceListCmd(m *master) *cli.Command {
 	cmd := &cli.Command{
 		Name:  "list",
@@ -143,6 +453,26 @@ func newGroupRestartCmd(m *master) *cli.Command {
 	cmd := &cli.Command{
 		Name:  "restart",
 		Usage: "Restart group",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "rolling",
+				Usage: "Replace the services a few at a time and wait for the replacements to come online",
+			},
+			&cli.IntFlag{
+				Name:  "batch-size",
+				Usage: "Services replaced at a time in a rolling restart",
+				Value: 1,
+			},
+			&cli.IntFlag{
+				Name:  "max-unavailable",
+				Usage: "Services of the group that may be starting at the same time in a rolling restart",
+				Value: 1,
+			},
+			&cli.BoolFlag{
+				Name:  "abort",
+				Usage: "Abort the rolling restart of the group",
+			},
+		},
 		Arguments: []cli.Argument{
 			&cli.StringArg{
 				Name:        "<group>",
@@ -156,6 +486,12 @@ func newGroupRestartCmd(m *master) *cli.Command {
 			if g == nil {
 				return fmt.Errorf("unknown group: %s", groupName)
 			}
+			if command.Bool("abort") {
+				return m.sched.abortRollingRestart(g, "aborted by operator")
+			}
+			if command.Bool("rolling") {
+				return m.sched.startRollingRestart(g, int(command.Int("batch-size")), int(command.Int("max-unavailable")))
+			}
 			log.Printf("restarting group %q", g.Name)
 			var errs []error
 			for _, svc := range m.gm.services(g) {
@@ -189,15 +525,83 @@ func newGroupReloadCmd(m *master) *cli.Command {
 	return cmd
 }
 
+func newGroupResetCmd(m *master) *cli.Command {
+	var groupName string
+	cmd := &cli.Command{
+		Name:  "reset",
+		Usage: "Clear the failures of a group, so its services are started again immediately",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<group>",
+				Destination: &groupName,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			g := m.gm.getGroup(groupName)
+			if g == nil {
+				return fmt.Errorf("unknown group: %s", groupName)
+			}
+			m.gm.resetFailures(g)
+			log.Printf("reset failures of group %q", g.Name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newGroupDeleteCmd(m *master) *cli.Command {
+	var groupName string
+	cmd := &cli.Command{
+		Name:  "delete",
+		Usage: "Delete a group and stop its services",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "confirm",
+				Usage: "Confirm deleting a static group",
+			},
+		},
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<group>",
+				Destination: &groupName,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			g := m.gm.getGroup(groupName)
+			if g == nil {
+				return fmt.Errorf("unknown group: %s", groupName)
+			}
+			if g.Static && !cmd.Bool("confirm") {
+				return fmt.Errorf("group %q is static, enter 'group delete --confirm %s' to delete it", g.Name, g.Name)
+			}
+			err := m.gm.removeGroup(g)
+			if err != nil {
+				return fmt.Errorf("cannot delete group: %w", err)
+			}
+			if g.Static {
+				log.Printf("group %q deleted, the service directories on slave %q were kept", g.Name, g.PinnedSlave)
+			} else {
+				log.Printf("group %q deleted", g.Name)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
 func newGroupListCmd(m *master) *cli.Command {
 	cmd := &cli.Command{
 		Name:  "list",
 		Usage: "List all groups",
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			log.Println("List of groups:")
-			var groups []*protocol.Group
+			var groups []groupInfo
 			for _, g := range m.gm.groups {
-				groups = append(groups, g.Group)
+				groups = append(groups, g.info())
 			}
 			err := common.EncodeYamlColorized(groups, m.term)
 			if err != nil {
@@ -256,3 +660,166 @@ func newGroupCreateCmd(m *master) *cli.Command {
 	}
 	return cmd
 }
+
+func newTemplateVersionsCmd(m *master) *cli.Command {
+	var name string
+	cmd := &cli.Command{
+		Name:  "versions",
+		Usage: "List the versions of a template",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<template>",
+				Destination: &name,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			infos, err := m.tmpl.versionInfos(name)
+			if err != nil {
+				return fmt.Errorf("cannot list versions: %w", err)
+			}
+			log.Printf("List of versions of template %q:", name)
+			err = common.EncodeYamlColorized(infos, m.term)
+			if err != nil {
+				return fmt.Errorf("cannot marshal versions: %w", err)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newTemplateSnapshotCmd(m *master) *cli.Command {
+	var name, message string
+	cmd := &cli.Command{
+		Name:  "snapshot",
+		Usage: "Create a new version of a template if it changed",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<template>",
+				Destination: &name,
+				Min:         1,
+				Max:         1,
+			},
+			&cli.StringArg{
+				Name:        "[message]",
+				Destination: &message,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			err := protocol.ValidateTemplateName(name)
+			if err != nil {
+				return err
+			}
+			m.tmpl.submitVersionJob(&versionJob{
+				name:    name,
+				message: message,
+				done: func(v *templateVersion, err error) {
+					if err != nil {
+						log.Printf("cannot create version: %v", err)
+						return
+					}
+					log.Printf("template %q is at version %d (%s)", name, v.Version, v.Hash[:12])
+				},
+			})
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newTemplateDiffCmd(m *master) *cli.Command {
+	var name string
+	var from, to int64
+	cmd := &cli.Command{
+		Name:  "diff",
+		Usage: "List the files that changed between two versions, or since a version if no second version is given",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<template>",
+				Destination: &name,
+				Min:         1,
+				Max:         1,
+			},
+			&cli.IntArg{
+				Name:        "<from>",
+				Destination: &from,
+				Min:         1,
+				Max:         1,
+			},
+			&cli.IntArg{
+				Name:        "[to]",
+				Destination: &to,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			err := m.tmpl.diff(name, int(from), int(to), func(changes []templateChange, err error) {
+				if err != nil {
+					log.Printf("cannot diff template: %v", err)
+					return
+				}
+				if len(changes) == 0 {
+					log.Println("no changes")
+					return
+				}
+				for _, c := range changes {
+					switch c.Change {
+					case "added":
+						log.Println(color.GreenString("+ %s", c.Path))
+					case "removed":
+						log.Println(color.RedString("- %s", c.Path))
+					default:
+						log.Println(color.YellowString("~ %s", c.Path))
+					}
+				}
+			})
+			if err != nil {
+				return fmt.Errorf("cannot diff template: %w", err)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newTemplateRollbackCmd(m *master) *cli.Command {
+	var name string
+	var version int64
+	cmd := &cli.Command{
+		Name:  "rollback",
+		Usage: "Restore a template to an older version",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "<template>",
+				Destination: &name,
+				Min:         1,
+				Max:         1,
+			},
+			&cli.IntArg{
+				Name:        "<version>",
+				Destination: &version,
+				Min:         1,
+				Max:         1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			err := m.tmpl.rollback(name, int(version), func(v *templateVersion, err error) {
+				if err != nil {
+					log.Printf("cannot roll back template: %v", err)
+					return
+				}
+				log.Printf("rolled back template %q to version %d, now at version %d", name, version, v.Version)
+			})
+			if err != nil {
+				return fmt.Errorf("cannot roll back template: %w", err)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
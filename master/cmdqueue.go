@@ -23,6 +23,13 @@ type createSlaveCmd struct {
 	slvCh chan<- *slave
 }
 
+type heartbeatCmd struct{}
+
+type templateChangedCmd struct {
+	name        string
+	fingerprint string
+}
+
 type removeSlaveCmd struct {
 	slv *slave
 }
@@ -33,6 +40,25 @@ type handleSlavePacketCmd struct {
 	errCh chan<- error
 }
 
+type templateVersionedCmd struct {
+	job     *versionJob
+	v       *templateVersion
+	fp      templateFingerprint
+	created bool
+	err     error
+}
+
+type templateComparedCmd struct {
+	job     *versionJob
+	changes []templateChange
+	err     error
+}
+
+type apiCmd struct {
+	fn    func() (any, error)
+	resCh chan<- apiResult
+}
+
 func (m *master) runCommandQueue(ch <-chan any) {
 loop:
 	for {
@@ -41,7 +67,10 @@ loop:
 		case masterShutdownCmd:
 			break loop
 		case scheduleServicesCmd:
+			m.sm.requests.expire()
+			m.tmpl.expireSaves()
 			m.sched.scheduleServices()
+			m.metrics.update(m)
 		case runCliCmd:
 			if m.sc.svc != nil {
 				args := cmd.args[1:]
@@ -51,31 +80,48 @@ loop:
 						log.Printf("failed to detach service: %v", err)
 					}
 				} else {
-					err := m.sc.svc.s.sendPacket(&protocol.PacketExecuteServiceCommand{
+					m.sc.svc.s.sendRequest(&protocol.PacketExecuteServiceCommand{
 						ServiceName: m.sc.svc.Name,
 						Command:     strings.Join(args, " "),
+					}, func(err error) {
+						if err != nil {
+							log.Printf("failed to execute command: %v", err)
+						}
 					})
-					if err != nil {
-						log.Printf("failed to send command to service: %v", err)
-					}
 				}
-				continue
-			}
-			err := m.cli.Run(context.Background(), cmd.args)
-			if err != nil {
-				log.Printf("%v", err)
+			} else {
+				err := m.cli.Run(context.Background(), cmd.args)
+				if err != nil {
+					log.Printf("%v", err)
+				}
 			}
 		case createSlaveCmd:
 			slv := m.sm.newSlave(cmd.conn)
 			cmd.slvCh <- slv
 			close(cmd.slvCh)
+		case heartbeatCmd:
+			m.sm.sendHeartbeats()
+		case templateChangedCmd:
+			m.tmpl.templateChanged(cmd.name, cmd.fingerprint)
 		case removeSlaveCmd:
 			m.sm.removeSlave(cmd.slv)
 		case handleSlavePacketCmd:
 			cmd.errCh <- cmd.slv.handlePacket(cmd.p)
 			close(cmd.errCh)
+		case templateVersionedCmd:
+			m.tmpl.versioned(cmd)
+		case templateComparedCmd:
+			cmd.job.compared(cmd.changes, cmd.err)
+		case apiCmd:
+			res, err := cmd.fn()
+			cmd.resCh <- apiResult{res: res, err: err}
+			close(cmd.resCh)
 		default:
 			panic(fmt.Sprintf("unknown command type %T", cmd))
 		}
+		err := m.persistState()
+		if err != nil {
+			log.Printf("failed to persist state: %v", err)
+		}
 	}
 }
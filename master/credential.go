@@ -0,0 +1,109 @@
+package main
+
+import (
+	"common"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+// slaveCredential is the token of a single slave. Only the hash of the token
+// is stored. Removed slaves keep a revoked credential, so they cannot fall back
+// to the shared secret key.
+type slaveCredential struct {
+	Name      string    `json:"name"`
+	TokenHash string    `json:"token_hash,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Revoked   bool      `json:"revoked,omitempty"`
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *slaveCredential) verify(token string) bool {
+	return !c.Revoked && subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(c.TokenHash)) == 1
+}
+
+// addCredential issues a token for the given slave name and returns it. The
+// token is only known to the caller and cannot be recovered later.
+func (sm *slaveManager) addCredential(name string) (string, error) {
+	if c := sm.credentials[name]; c != nil && !c.Revoked {
+		return "", fmt.Errorf("slave %q already has a token, use 'slave rotate' to replace it", name)
+	}
+	return sm.issueToken(name), nil
+}
+
+// rotateCredential replaces the token of the given slave and disconnects the
+// slave if it is connected.
+func (sm *slaveManager) rotateCredential(name string) (string, error) {
+	if c := sm.credentials[name]; c == nil || c.Revoked {
+		return "", fmt.Errorf("slave %q has no token", name)
+	}
+	token := sm.issueToken(name)
+	sm.disconnect(name, "token rotated")
+	return token, nil
+}
+
+// revokeCredential removes the token of the given slave and disconnects the
+// slave if it is connected.
+func (sm *slaveManager) revokeCredential(name string) error {
+	if c := sm.credentials[name]; c != nil && c.Revoked {
+		return fmt.Errorf("slave %q is already revoked", name)
+	}
+	sm.credentials[name] = &slaveCredential{Name: name, CreatedAt: time.Now(), Revoked: true}
+	sm.disconnect(name, "revoked")
+	return nil
+}
+
+func (sm *slaveManager) issueToken(name string) string {
+	token := common.GenerateRandomHex(32)
+	sm.credentials[name] = &slaveCredential{
+		Name:      name,
+		TokenHash: hashToken(token),
+		CreatedAt: time.Now(),
+	}
+	return token
+}
+
+func (sm *slaveManager) disconnect(name, reason string) {
+	slv := sm.getSlave(name)
+	if slv == nil {
+		return
+	}
+	log.Printf("disconnecting slave %q: %s", name, reason)
+	_ = slv.conn.Close()
+}
+
+func (m *master) restoreCredentials() error {
+	credentials, err := m.store.load(credentialsBucket)
+	if err != nil {
+		return err
+	}
+	for name, b := range credentials {
+		var c slaveCredential
+		err = json.Unmarshal(b, &c)
+		if err != nil {
+			return fmt.Errorf("invalid credential for slave %q: %w", name, err)
+		}
+		m.sm.credentials[name] = &c
+	}
+	return nil
+}
+
+func (m *master) persistCredentials() error {
+	credentials := make(map[string][]byte)
+	for name, c := range m.sm.credentials {
+		b, err := json.Marshal(c)
+		if err != nil {
+			return fmt.Errorf("failed to marshal credential for slave %q: %w", name, err)
+		}
+		credentials[name] = b
+	}
+	return m.store.sync(credentialsBucket, credentials)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"protocol"
+	"slices"
+	"time"
+)
+
+const defaultDrainTimeout = 30 * time.Second
+
+// drainService asks a server to move its players to the fallback group before
+// it is stopped. The server is stopped once it is empty or the drain timeout
+// of its group expired.
+func (s *scheduler) drainService(svc *service, done func(err error)) {
+	timeout := defaultDrainTimeout
+	if svc.g.DrainTimeout > 0 {
+		timeout = time.Duration(svc.g.DrainTimeout) * time.Second
+	}
+	svc.State = protocol.Service_STATE_STOPPING
+	svc.draining = true
+	svc.drainDeadline = time.Now().Add(timeout)
+	svc.drainDone = done
+	// without player counts there is nothing to wait for
+	svc.drainCounted = !svc.s.caps[protocol.CapabilityPlayerCount]
+
+	fallbacks := s.fallbackServers(svc)
+	log.Printf("draining service %q with %d players to %d fallback servers", svc.Name, svc.Players, len(fallbacks))
+	if len(fallbacks) > 0 {
+		err := svc.sendPacket(&protocol.PacketServiceDrain{FallbackServers: fallbacks})
+		if err != nil {
+			log.Printf("failed to send drain packet to service %q: %v", svc.Name, err)
+		}
+	}
+	s.m.events.emitService(eventServiceStopping, svc, fmt.Sprintf("draining %d players", svc.Players))
+}
+
+// fallbackServers returns the online servers of the fallback group of the
+// service, the ones with the fewest players first.
+func (s *scheduler) fallbackServers(svc *service) []string {
+	if svc.g.FallbackGroup == "" {
+		return nil
+	}
+	g := s.m.gm.getGroup(svc.g.FallbackGroup)
+	if g == nil {
+		log.Printf("fallback group %q of group %q does not exist", svc.g.FallbackGroup, svc.g.Name)
+		return nil
+	}
+	var candidates []*service
+	for _, fb := range s.m.gm.activeServices(g) {
+		if fb != svc && fb.s != nil && fb.State == protocol.Service_STATE_ONLINE {
+			candidates = append(candidates, fb)
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b *service) int {
+		return int(a.Players - b.Players)
+	})
+	names := make([]string, len(candidates))
+	for i, fb := range candidates {
+		names[i] = fb.Name
+	}
+	return names
+}
+
+// checkDrains stops the draining services that reported to be empty or ran
+// out of time.
+func (s *scheduler) checkDrains() {
+	for _, svc := range s.services {
+		if !svc.draining || svc.s == nil {
+			continue
+		}
+		if svc.Players > 0 || !svc.drainCounted {
+			if time.Now().Before(svc.drainDeadline) {
+				continue
+			}
+			log.Printf("service %q did not drain in time, stopping it with %d players", svc.Name, svc.Players)
+		} else {
+			log.Printf("service %q is drained", svc.Name)
+		}
+		s.finishDrain(svc)
+	}
+}
+
+// finishDrain ends the drain of the service and asks the slave to stop it.
+func (s *scheduler) finishDrain(svc *service) {
+	done := svc.drainDone
+	svc.draining = false
+	svc.drainDone = nil
+	log.Printf("stopping service %q on slave %q", svc.Name, svc.Slave)
+	svc.s.sendRequest(&protocol.PacketStopService{
+		ServiceName: svc.Name,
+	}, done)
+}
+
+// abortDrain ends the drain of a service that stopped on its own or was lost.
+func (s *scheduler) abortDrain(svc *service, err error) {
+	if !svc.draining {
+		return
+	}
+	done := svc.drainDone
+	svc.draining = false
+	svc.drainDone = nil
+	if done != nil {
+		done(err)
+	}
+}
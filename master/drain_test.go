@@ -0,0 +1,75 @@
+package main
+
+import (
+	"protocol"
+	"testing"
+)
+
+func newDrainTestService(caps map[string]bool) (*scheduler, *service) {
+	m := &master{events: newEventBus(), sm: &slaveManager{requests: newRequestTable()}}
+	s := newScheduler(m)
+	m.sched = s
+	slv := &slave{m: m, name: "slave-1", authenticated: true, caps: caps}
+	svc := &service{
+		Service: &protocol.Service{
+			Name:  "lobby-01",
+			Group: "lobby",
+			Slave: slv.name,
+			Type:  protocol.Service_TYPE_SERVER,
+			State: protocol.Service_STATE_ONLINE,
+		},
+		g: &group{Group: &protocol.Group{Name: "lobby", Type: protocol.Service_TYPE_SERVER}},
+		s: slv,
+	}
+	s.services = []*service{svc}
+	return s, svc
+}
+
+func TestRequestStopDrainsServerWithoutReportedPlayers(t *testing.T) {
+	s, svc := newDrainTestService(map[string]bool{protocol.CapabilityPlayerCount: true})
+
+	// the last reported count is outdated, players may have joined since
+	err := s.requestStop(svc, nil)
+	if err != nil {
+		t.Fatalf("requestStop: %v", err)
+	}
+	if !svc.draining {
+		t.Fatalf("server was stopped without draining it")
+	}
+	s.checkDrains()
+	if !svc.draining {
+		t.Fatalf("drain finished before the service reported its players")
+	}
+
+	err = svc.s.handlePacket(&protocol.PacketServicePlayerCount{ServiceName: svc.Name, Players: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.checkDrains()
+	if !svc.draining {
+		t.Fatalf("drain finished with players on the server")
+	}
+
+	err = svc.s.handlePacket(&protocol.PacketServicePlayerCount{ServiceName: svc.Name, Players: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.checkDrains()
+	if svc.draining {
+		t.Fatalf("drain did not finish once the server was empty")
+	}
+}
+
+func TestRequestStopWithoutPlayerCounts(t *testing.T) {
+	s, svc := newDrainTestService(map[string]bool{})
+
+	err := s.requestStop(svc, nil)
+	if err != nil {
+		t.Fatalf("requestStop: %v", err)
+	}
+	// the service never reports its players, so there is nothing to wait for
+	s.checkDrains()
+	if svc.draining {
+		t.Fatalf("drain waits for player counts the slave does not send")
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"google.golang.org/protobuf/proto"
+	"path"
+	"protocol"
+	"sync"
+	"time"
+)
+
+type eventType string
+
+const (
+	eventServiceCreated       eventType = "service.created"
+	eventServiceScheduled     eventType = "service.scheduled"
+	eventServiceOnline        eventType = "service.online"
+	eventServiceWarm          eventType = "service.warm"
+	eventServiceStopping      eventType = "service.stopping"
+	eventServiceStopped       eventType = "service.stopped"
+	eventServiceStartFailed   eventType = "service.start-failed"
+	eventSlaveConnected       eventType = "slave.connected"
+	eventSlaveDisconnected    eventType = "slave.disconnected"
+	eventGroupCreated         eventType = "group.created"
+	eventGroupReloaded        eventType = "group.reloaded"
+	eventGroupDeleted         eventType = "group.deleted"
+	eventGroupFailing         eventType = "group.failing"
+	eventGroupRecovered       eventType = "group.recovered"
+	eventGroupRestartStarted  eventType = "group.restart-started"
+	eventGroupRestartProgress eventType = "group.restart-progress"
+	eventGroupRestartFinished eventType = "group.restart-finished"
+	eventGroupRestartAborted  eventType = "group.restart-aborted"
+	eventTemplateSaved        eventType = "template.saved"
+	eventTemplateChanged      eventType = "template.changed"
+)
+
+type event struct {
+	Type    eventType         `json:"type"`
+	Time    time.Time         `json:"time"`
+	Service *protocol.Service `json:"service,omitempty"`
+	Slave   string            `json:"slave,omitempty"`
+	Group   string            `json:"group,omitempty"`
+	Message string            `json:"message,omitempty"`
+}
+
+// eventBus distributes events emitted by the command queue to subscribers in
+// other goroutines. Subscribers that cannot keep up miss events.
+type eventBus struct {
+	mu   sync.Mutex
+	subs map[*subscription]struct{}
+}
+
+type subscription struct {
+	ch       chan event
+	patterns []string
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{subs: make(map[*subscription]struct{})}
+}
+
+// subscribe returns a subscription for all events whose type matches one of
+// the given patterns, e.g. "service.*". No patterns match every event.
+func (b *eventBus) subscribe(patterns []string) *subscription {
+	sub := &subscription{ch: make(chan event, 64), patterns: patterns}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+func (b *eventBus) unsubscribe(sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, exists := b.subs[sub]; exists {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+func (b *eventBus) emit(e event) {
+	e.Time = time.Now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if !sub.matches(e.Type) {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+		}
+	}
+}
+
+func (b *eventBus) emitService(t eventType, svc *service, msg string) {
+	b.emit(event{
+		Type:    t,
+		Service: proto.Clone(svc.Service).(*protocol.Service),
+		Slave:   svc.Slave,
+		Group:   svc.Group,
+		Message: msg,
+	})
+}
+
+func (sub *subscription) matches(t eventType) bool {
+	if len(sub.patterns) == 0 {
+		return true
+	}
+	for _, p := range sub.patterns {
+		if matched, _ := path.Match(p, string(t)); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -8,7 +8,11 @@ require (
 	github.com/fatih/color v1.18.0
 	github.com/goccy/go-yaml v1.15.13
 	github.com/gokrazy/rsync v0.1.0
+	github.com/prometheus/client_golang v1.20.5
+	github.com/prometheus/client_model v0.6.1
+	github.com/prometheus/common v0.55.0
 	github.com/urfave/cli/v3 v3.0.0-beta1
+	go.etcd.io/bbolt v1.3.11
 	google.golang.org/protobuf v1.36.0
 	protocol v0.0.0
 )
@@ -16,10 +20,15 @@ require (
 require (
 	github.com/DavidGamba/go-getoptions v0.23.0 // indirect
 	github.com/acarl005/stripansi v0.0.0-20180116102854-5a71ef0e047d // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
+	github.com/klauspost/compress v1.17.9 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mmcloughlin/md4 v0.1.1 // indirect
+	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
+	github.com/prometheus/procfs v0.15.1 // indirect
 	golang.org/x/crypto v0.27.0 // indirect
 	golang.org/x/sync v0.8.0 // indirect
 	golang.org/x/sys v0.28.0 // indirect
@@ -5,14 +5,38 @@ import (
 	"errors"
 	"fmt"
 	"github.com/goccy/go-yaml"
+	"log"
 	"os"
 	"path"
 	"protocol"
 	"strings"
+	"time"
+)
+
+const (
+	// crashLoopThreshold is the number of consecutive start failures within
+	// crashLoopWindow after which a group is marked as failing.
+	crashLoopThreshold = 5
+	crashLoopWindow    = 10 * time.Minute
+	minStartBackoff    = 5 * time.Second
+	maxStartBackoff    = 5 * time.Minute
 )
 
 type group struct {
 	*protocol.Group
+	// failures counts consecutive start failures, new services are not
+	// created before retryAt.
+	failures    int
+	lastFailure time.Time
+	retryAt     time.Time
+	failing     bool
+}
+
+type groupInfo struct {
+	*protocol.Group `yaml:",inline"`
+	Status          string `yaml:"status"`
+	Failures        int    `yaml:"failures,omitempty"`
+	RetryIn         string `yaml:"retry_in,omitempty"`
 }
 
 type groupManager struct {
@@ -58,17 +82,21 @@ func (gm *groupManager) reloadGroups() error {
 	for _, g := range gm.groups {
 		info, exists := m[g.Name]
 		delete(m, g.Name)
-		if !exists {
+		if !exists && g.Static {
+			log.Printf("the file of static group %q was removed, enter 'group delete --confirm %s' to delete it", g.Name, g.Name)
+		} else if !exists {
 			err = gm.deleteGroup(g)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("failed to delete group %q: %w", g.Name, err))
 			}
 		} else {
 			g.Group = info
+			gm.m.events.emit(event{Type: eventGroupReloaded, Group: g.Name})
 		}
 	}
 	for _, g := range m {
 		gm.groups = append(gm.groups, &group{Group: g})
+		gm.m.events.emit(event{Type: eventGroupCreated, Group: g.Name})
 		err = gm.m.tmpl.createTemplateDir(g.Name)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to create template directory: %w", err))
@@ -139,16 +167,15 @@ func (gm *groupManager) createGroup(g *protocol.Group) error {
 	if err != nil {
 		return fmt.Errorf("invalid group: %w", err)
 	}
-	for _, g := range gm.groups {
-		if g.Name == g.Name {
-			return fmt.Errorf("group %q already exists", g.Name)
-		}
+	if gm.getGroup(g.Name) != nil {
+		return fmt.Errorf("group %q already exists", g.Name)
 	}
 	err = gm.saveGroup(g)
 	if err != nil {
 		return fmt.Errorf("cannot save group: %w", err)
 	}
 	gm.groups = append(gm.groups, &group{Group: g})
+	gm.m.events.emit(event{Type: eventGroupCreated, Group: g.Name})
 	err = gm.m.tmpl.createTemplateDir(g.Name)
 	if err != nil {
 		return fmt.Errorf("failed to create template directory: %w", err)
@@ -156,11 +183,39 @@ func (gm *groupManager) createGroup(g *protocol.Group) error {
 	return nil
 }
 
+func (gm *groupManager) updateGroup(g *group, info *protocol.Group) error {
+	if info.Name != g.Name {
+		return fmt.Errorf("group name cannot be changed")
+	}
+	err := gm.saveGroup(info)
+	if err != nil {
+		return fmt.Errorf("cannot save group: %w", err)
+	}
+	g.Group = info
+	gm.m.events.emit(event{Type: eventGroupReloaded, Group: g.Name})
+	return nil
+}
+
+// removeGroup deletes the group file and stops all services of the group.
+func (gm *groupManager) removeGroup(g *group) error {
+	err := os.Remove(path.Join(gm.groupDir, g.Name+".yaml"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("cannot delete group file: %w", err)
+	}
+	return gm.deleteGroup(g)
+}
+
 func (gm *groupManager) deleteGroup(g *group) error {
 	gm.groups = common.DeleteItem(gm.groups, g)
+	gm.m.events.emit(event{Type: eventGroupDeleted, Group: g.Name})
 	var errs []error
 	for _, svc := range gm.services(g) {
-		err := gm.m.sched.stopService(svc)
+		var err error
+		if svc.s == nil && !svc.lost() {
+			err = gm.m.sched.deleteService(svc)
+		} else {
+			err = gm.m.sched.stopService(svc)
+		}
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -186,3 +241,67 @@ func (gm *groupManager) services(g *group) []*service {
 	}
 	return services
 }
+
+// activeServices returns the services of the group without its warm services.
+func (gm *groupManager) activeServices(g *group) []*service {
+	var services []*service
+	for _, s := range gm.m.sched.services {
+		if s.g == g && !s.Warm {
+			services = append(services, s)
+		}
+	}
+	return services
+}
+
+// recordFailure is called when a service of the group failed to start or
+// crashed before it came online. It delays the creation of new services with
+// exponential backoff and marks the group as failing after too many failures.
+func (gm *groupManager) recordFailure(g *group, reason string) {
+	now := time.Now()
+	if now.Sub(g.lastFailure) > crashLoopWindow {
+		g.failures = 0
+	}
+	g.failures++
+	g.lastFailure = now
+	backoff := min(minStartBackoff<<min(g.failures-1, 16), maxStartBackoff)
+	g.retryAt = now.Add(backoff)
+	log.Printf("service of group %q failed to start (%d consecutive failures), retrying in %s", g.Name, g.failures, backoff)
+	if !g.failing && g.failures >= crashLoopThreshold {
+		g.failing = true
+		log.Printf("group %q is failing: %s", g.Name, reason)
+		gm.m.events.emit(event{Type: eventGroupFailing, Group: g.Name, Message: reason})
+	}
+}
+
+// resetFailures clears the failure state of the group after a service came
+// online or when an operator resets the group.
+func (gm *groupManager) resetFailures(g *group) {
+	if g.failures == 0 {
+		return
+	}
+	wasFailing := g.failing
+	g.failures = 0
+	g.lastFailure = time.Time{}
+	g.retryAt = time.Time{}
+	g.failing = false
+	if wasFailing {
+		log.Printf("group %q recovered", g.Name)
+		gm.m.events.emit(event{Type: eventGroupRecovered, Group: g.Name})
+	}
+}
+
+// backingOff reports whether new services of the group must not be created yet.
+func (g *group) backingOff() bool {
+	return time.Now().Before(g.retryAt)
+}
+
+func (g *group) info() groupInfo {
+	info := groupInfo{Group: g.Group, Status: "ok", Failures: g.failures}
+	if g.failing {
+		info.Status = "failing"
+	}
+	if g.backingOff() {
+		info.RetryIn = time.Until(g.retryAt).Round(time.Second).String()
+	}
+	return info
+}
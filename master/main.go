@@ -1,6 +1,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"github.com/ergochat/readline"
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v3"
@@ -8,6 +9,8 @@ import (
 	"log"
 	"net"
 	"os"
+	"path/filepath"
+	"protocol"
 	"runtime/debug"
 	"strings"
 	"time"
@@ -16,20 +19,39 @@ import (
 )
 
 type master struct {
-	cfg   *config
-	gm    *groupManager
-	sm    *slaveManager
-	sched *scheduler
-	tmpl  *templateManager
-	term  io.Writer
-	cli   *cli.Command
-	sc    *screen
+	cfg     *config
+	tlsCfg  *tls.Config
+	gm      *groupManager
+	sm      *slaveManager
+	sched   *scheduler
+	tmpl    *templateManager
+	store   *store
+	events  *eventBus
+	metrics *metrics
+	tail    *subscription
+	term    io.Writer
+	// console writes to the terminal only, for output that must not end up
+	// in the log file.
+	console io.Writer
+	cli     *cli.Command
+	sc      *screen
+	ch      chan<- any
 }
 
 type config struct {
-	BindAddr           string `json:"bind_addr"`
-	FileServerBindAddr string `json:"file_server_bind_addr"`
-	SecretKey          string `json:"secret_key"`
+	BindAddr              string    `json:"bind_addr"`
+	FileServerBindAddr    string    `json:"file_server_bind_addr"`
+	SecretKey             string    `json:"secret_key"`
+	SlaveReconnectTimeout int       `json:"slave_reconnect_timeout"`
+	ApiBindAddr           string    `json:"api_bind_addr"`
+	ApiToken              string    `json:"api_token"`
+	MetricsBindAddr       string    `json:"metrics_bind_addr"`
+	Tls                   tlsConfig `json:"tls"`
+	HeartbeatInterval     int       `json:"heartbeat_interval"`
+	HeartbeatTimeout      int       `json:"heartbeat_timeout"`
+	MaxFrameSize          int       `json:"max_frame_size"`
+	MaxPreAuthFrameSize   int       `json:"max_pre_auth_frame_size"`
+	TemplateCheckInterval int       `json:"template_check_interval"`
 }
 
 func main() {
@@ -68,18 +90,49 @@ func main() {
 	log.Println(color.RedString(common.Header))
 	log.Printf("starting Athena-Master %s", common.Version)
 
-	m := master{term: outWriter}
-	ch := make(chan any)
+	m := master{term: outWriter, console: l.Stderr(), events: newEventBus()}
+	ch := make(chan any, 64)
+	m.ch = ch
+	m.metrics = newMetrics(ch)
 
 	m.cfg, err = common.ReadConfig("master.yaml", config{
-		BindAddr:           "0.0.0.0:5000",
-		FileServerBindAddr: "0.0.0.0:5001",
-		SecretKey:          common.GenerateRandomHex(32),
+		BindAddr:              "0.0.0.0:5000",
+		FileServerBindAddr:    "0.0.0.0:5001",
+		SecretKey:             common.GenerateRandomHex(32),
+		SlaveReconnectTimeout: 60,
+		ApiBindAddr:           "127.0.0.1:5002",
+		ApiToken:              common.GenerateRandomHex(32),
+		MetricsBindAddr:       "0.0.0.0:5003",
+		HeartbeatInterval:     5,
+		HeartbeatTimeout:      15,
+		MaxFrameSize:          protocol.DefaultMaxFrameSize,
+		MaxPreAuthFrameSize:   protocol.DefaultPreAuthMaxFrameSize,
+		TemplateCheckInterval: 5,
+		Tls: tlsConfig{
+			CertFile: filepath.Join(pkiDir, masterCertFile),
+			KeyFile:  filepath.Join(pkiDir, masterKeyFile),
+			CaFile:   filepath.Join(pkiDir, caCertFile),
+		},
 	})
 	if err != nil {
 		log.Fatalf("error loading config: %v", m.cfg)
 	}
 
+	if m.cfg.HeartbeatInterval <= 0 || m.cfg.HeartbeatTimeout <= m.cfg.HeartbeatInterval {
+		log.Fatalf("heartbeat_timeout must be greater than heartbeat_interval")
+	}
+
+	if m.cfg.MaxPreAuthFrameSize <= 0 || m.cfg.MaxFrameSize < m.cfg.MaxPreAuthFrameSize {
+		log.Fatalf("max_frame_size must be at least max_pre_auth_frame_size, which must be positive")
+	}
+
+	if m.cfg.Tls.Enabled {
+		m.tlsCfg, err = m.cfg.Tls.serverTlsConfig()
+		if err != nil {
+			log.Fatalf("failed to load tls config: %v", err)
+		}
+	}
+
 	m.gm, err = newGroupManager(&m)
 	if err != nil {
 		log.Fatalf("%v", err)
@@ -95,12 +148,39 @@ func main() {
 	m.sched = newScheduler(&m)
 	m.cli = newCli(ch, &m)
 
+	m.store, err = openStore("state.db")
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	defer func() {
+		_ = m.store.close()
+	}()
+	err = m.restoreState()
+	if err != nil {
+		log.Fatalf("failed to restore state: %v", err)
+	}
+
 	err = m.tmpl.startFileServer()
 	if err != nil {
 		log.Fatalf("failed starting file server: %v", err)
 	}
+	go m.tmpl.runVersionWorker()
+
+	if m.cfg.MetricsBindAddr != "" {
+		err = m.metrics.startServer(m.cfg.MetricsBindAddr)
+		if err != nil {
+			log.Fatalf("failed starting metrics server: %v", err)
+		}
+	}
 
-	lis, err := net.Listen("tcp", m.cfg.BindAddr)
+	if m.cfg.ApiBindAddr != "" {
+		err = m.startApiServer(ch)
+		if err != nil {
+			log.Fatalf("failed starting api server: %v", err)
+		}
+	}
+
+	lis, err := m.listen(m.cfg.BindAddr)
 	if err != nil {
 		log.Fatalf("failed starting server: %v", err)
 	}
@@ -108,7 +188,7 @@ func main() {
 		_ = lis.Close()
 	}()
 	log.Printf("listening on %s", m.cfg.BindAddr)
-	go handleSlaveConnection(ch, lis)
+	go handleSlaveConnection(ch, lis, m.cfg)
 
 	go func() {
 		defer recoverPanic()
@@ -118,6 +198,25 @@ func main() {
 		}
 	}()
 
+	go func() {
+		defer recoverPanic()
+		t := time.NewTicker(time.Duration(m.cfg.HeartbeatInterval) * time.Second)
+		for range t.C {
+			ch <- heartbeatCmd{}
+		}
+	}()
+
+	if m.cfg.TemplateCheckInterval > 0 {
+		w := newTemplateWatcher(m.tmpl, ch)
+		go func() {
+			defer recoverPanic()
+			t := time.NewTicker(time.Duration(m.cfg.TemplateCheckInterval) * time.Second)
+			for range t.C {
+				w.check()
+			}
+		}()
+	}
+
 	running := true
 	go func() {
 		defer recoverPanic()
@@ -139,6 +238,18 @@ func main() {
 	log.Printf("shutting down")
 }
 
+// listen opens a listener for slave connections, which uses tls if enabled.
+func (m *master) listen(addr string) (net.Listener, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if m.tlsCfg != nil {
+		lis = tls.NewListener(lis, m.tlsCfg)
+	}
+	return lis, nil
+}
+
 func recoverPanic() {
 	if r := recover(); r != nil {
 		stack := string(debug.Stack())
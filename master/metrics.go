@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"net"
+	"net/http"
+	"protocol"
+	"strings"
+	"time"
+)
+
+type metrics struct {
+	reg                 *prometheus.Registry
+	services            *gaugeVec
+	startDuration       *prometheus.HistogramVec
+	startFailures       *prometheus.CounterVec
+	slaveMemory         *gaugeVec
+	slaveMemoryReserved *gaugeVec
+	slaveMemoryUsed     *gaugeVec
+	packets             *prometheus.CounterVec
+}
+
+func newMetrics(ch chan any) *metrics {
+	mt := &metrics{
+		reg: prometheus.NewRegistry(),
+		services: newGaugeVec(prometheus.GaugeOpts{
+			Name: "athena_services",
+			Help: "Number of services per group and state.",
+		}, []string{"group", "state"}),
+		startDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "athena_service_start_duration_seconds",
+			Help:    "Time from creating or promoting a service until it is online.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		}, []string{"group"}),
+		startFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "athena_service_start_failures_total",
+			Help: "Number of services that failed to start.",
+		}, []string{"group"}),
+		slaveMemory: newGaugeVec(prometheus.GaugeOpts{
+			Name: "athena_slave_memory_mebibytes",
+			Help: "Total memory of a slave available for services.",
+		}, []string{"slave"}),
+		slaveMemoryReserved: newGaugeVec(prometheus.GaugeOpts{
+			Name: "athena_slave_memory_reserved_mebibytes",
+			Help: "Memory reserved by services scheduled on a slave.",
+		}, []string{"slave"}),
+		slaveMemoryUsed: newGaugeVec(prometheus.GaugeOpts{
+			Name: "athena_slave_memory_used_mebibytes",
+			Help: "Resident memory of all services on a slave as reported by the slave.",
+		}, []string{"slave"}),
+		packets: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "athena_packets_total",
+			Help: "Number of packets sent and received per packet type.",
+		}, []string{"direction", "type"}),
+	}
+	mt.reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		mt.services,
+		mt.startDuration,
+		mt.startFailures,
+		mt.slaveMemory,
+		mt.slaveMemoryReserved,
+		mt.slaveMemoryUsed,
+		mt.packets,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "athena_command_queue_depth",
+			Help: "Number of commands waiting in the command queue.",
+		}, func() float64 {
+			return float64(len(ch))
+		}),
+	)
+	protocol.PacketObserver = mt.observePacket
+	return mt
+}
+
+func (mt *metrics) observePacket(dir protocol.Direction, p proto.Message) {
+	name := string(proto.MessageName(p).Name())
+	mt.packets.WithLabelValues(string(dir), name).Inc()
+}
+
+func (mt *metrics) observeServiceOnline(svc *service) {
+	if svc.createdAt.IsZero() {
+		return
+	}
+	mt.startDuration.WithLabelValues(svc.Group).Observe(time.Since(svc.createdAt).Seconds())
+}
+
+// update refreshes all gauges that reflect the current state of the master.
+func (mt *metrics) update(m *master) {
+	counts := make(map[[2]string]float64)
+	for _, g := range m.gm.groups {
+		for state := range protocol.Service_State_name {
+			if state != int32(protocol.Service_STATE_UNKNOWN) {
+				counts[[2]string{g.Name, stateLabel(protocol.Service_State(state))}] = 0
+			}
+		}
+		counts[[2]string{g.Name, "warm"}] = 0
+	}
+	for _, svc := range m.sched.services {
+		state := stateLabel(svc.State)
+		// services of the warm pool are reported apart from the ones in use
+		if svc.Warm && svc.State != protocol.Service_STATE_STOPPING {
+			state = "warm"
+		}
+		counts[[2]string{svc.Group, state}]++
+	}
+	for labels, n := range counts {
+		mt.services.set(n, labels[0], labels[1])
+	}
+	mt.services.flush()
+
+	for _, slv := range m.sm.slaves {
+		if !slv.authenticated {
+			continue
+		}
+		mt.slaveMemory.set(float64(slv.memory), slv.name)
+		mt.slaveMemoryReserved.set(float64(slv.memory-slv.freeMemory), slv.name)
+		mt.slaveMemoryUsed.set(float64(slv.usedMemory), slv.name)
+	}
+	mt.slaveMemory.flush()
+	mt.slaveMemoryReserved.flush()
+	mt.slaveMemoryUsed.flush()
+}
+
+// gaugeVec is a gauge vec that deletes the label sets which were not set
+// since the last flush. Unlike resetting the vec before setting all values
+// again, a concurrent scrape never misses a series.
+type gaugeVec struct {
+	*prometheus.GaugeVec
+	last map[string][]string
+	cur  map[string][]string
+}
+
+func newGaugeVec(opts prometheus.GaugeOpts, labelNames []string) *gaugeVec {
+	return &gaugeVec{
+		GaugeVec: prometheus.NewGaugeVec(opts, labelNames),
+		cur:      make(map[string][]string),
+	}
+}
+
+func (gv *gaugeVec) set(v float64, labels ...string) {
+	gv.cur[strings.Join(labels, "\x00")] = labels
+	gv.WithLabelValues(labels...).Set(v)
+}
+
+func (gv *gaugeVec) flush() {
+	for key, labels := range gv.last {
+		if _, ok := gv.cur[key]; !ok {
+			gv.DeleteLabelValues(labels...)
+		}
+	}
+	gv.last, gv.cur = gv.cur, make(map[string][]string)
+}
+
+func (mt *metrics) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(mt.reg, promhttp.HandlerOpts{}))
+	return mux
+}
+
+func (mt *metrics) startServer(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	log.Printf("started metrics server on %s", addr)
+	go func() {
+		defer recoverPanic()
+		err := http.Serve(lis, mt.handler())
+		if err != nil {
+			log.Printf("failed to serve metrics: %v", err)
+		}
+	}()
+	return nil
+}
+
+func stateLabel(state protocol.Service_State) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "STATE_"))
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"net/http"
+	"net/http/httptest"
+	"protocol"
+	"testing"
+)
+
+func scrapeMetrics(t *testing.T, mt *metrics) map[string]*dto.MetricFamily {
+	t.Helper()
+	srv := httptest.NewServer(mt.handler())
+	defer srv.Close()
+	res, err := http.Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("failed to scrape metrics: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d", res.StatusCode)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(res.Body)
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	return families
+}
+
+func gaugeValue(families map[string]*dto.MetricFamily, name string, labels map[string]string) (float64, bool) {
+	f, ok := families[name]
+	if !ok {
+		return 0, false
+	}
+outer:
+	for _, metric := range f.Metric {
+		for _, l := range metric.Label {
+			if v, ok := labels[l.GetName()]; ok && v != l.GetValue() {
+				continue outer
+			}
+		}
+		return metric.GetGauge().GetValue(), true
+	}
+	return 0, false
+}
+
+func TestMetricsScrape(t *testing.T) {
+	mt := newMetrics(make(chan any, 1))
+	lobby := &group{Group: &protocol.Group{Name: "lobby"}}
+	slv := &slave{name: "slave-1", authenticated: true, memory: 4096, freeMemory: 3072, usedMemory: 700}
+	m := &master{
+		gm: &groupManager{groups: []*group{lobby}},
+		sm: &slaveManager{slaves: []*slave{slv}},
+		sched: &scheduler{services: []*service{
+			{Service: &protocol.Service{Name: "lobby-01", Group: "lobby", State: protocol.Service_STATE_ONLINE}},
+			{Service: &protocol.Service{Name: "lobby-02", Group: "lobby", State: protocol.Service_STATE_ONLINE}},
+			{Service: &protocol.Service{Name: "lobby-03", Group: "lobby", State: protocol.Service_STATE_ONLINE, Warm: true}},
+		}},
+	}
+	mt.update(m)
+
+	families := scrapeMetrics(t, mt)
+	for _, tc := range []struct {
+		name   string
+		labels map[string]string
+		want   float64
+	}{
+		{"athena_services", map[string]string{"group": "lobby", "state": "online"}, 2},
+		{"athena_services", map[string]string{"group": "lobby", "state": "warm"}, 1},
+		{"athena_services", map[string]string{"group": "lobby", "state": "pending"}, 0},
+		{"athena_slave_memory_mebibytes", map[string]string{"slave": "slave-1"}, 4096},
+		{"athena_slave_memory_reserved_mebibytes", map[string]string{"slave": "slave-1"}, 1024},
+		{"athena_slave_memory_used_mebibytes", map[string]string{"slave": "slave-1"}, 700},
+		{"athena_command_queue_depth", nil, 0},
+	} {
+		got, ok := gaugeValue(families, tc.name, tc.labels)
+		if !ok {
+			t.Errorf("%s%v is missing", tc.name, tc.labels)
+		} else if got != tc.want {
+			t.Errorf("%s%v = %v, want %v", tc.name, tc.labels, got, tc.want)
+		}
+	}
+
+	m.sm.slaves = nil
+	m.sched.services = m.sched.services[:1]
+	mt.update(m)
+
+	families = scrapeMetrics(t, mt)
+	if _, ok := gaugeValue(families, "athena_slave_memory_mebibytes", map[string]string{"slave": "slave-1"}); ok {
+		t.Errorf("series of removed slave is still exported")
+	}
+	got, _ := gaugeValue(families, "athena_services", map[string]string{"group": "lobby", "state": "online"})
+	if got != 1 {
+		t.Errorf("online services = %v, want 1", got)
+	}
+}
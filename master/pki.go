@@ -0,0 +1,202 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	pkiDir           = "pki"
+	caValidity       = 10 * 365 * 24 * time.Hour
+	certValidity     = 2 * 365 * 24 * time.Hour
+	caCertFile       = "ca.crt"
+	caKeyFile        = "ca.key"
+	masterCertFile   = "master.crt"
+	masterKeyFile    = "master.key"
+	slaveCertsSubdir = "slaves"
+)
+
+type tlsConfig struct {
+	Enabled           bool   `json:"enabled"`
+	CertFile          string `json:"cert_file"`
+	KeyFile           string `json:"key_file"`
+	CaFile            string `json:"ca_file"`
+	RequireClientCert bool   `json:"require_client_cert"`
+}
+
+// serverTlsConfig returns the tls config used for the slave listener and the
+// file server. Client certificates are verified against the CA. If they are
+// not required, slaves may still authenticate with the secret key.
+func (cfg *tlsConfig) serverTlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+	caPem, err := os.ReadFile(cfg.CaFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ca certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("no certificates found in %s", cfg.CaFile)
+	}
+	clientAuth := tls.VerifyClientCertIfGiven
+	if cfg.RequireClientCert {
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   clientAuth,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// initCa creates a new certificate authority along with a certificate for the
+// master that is valid for the given hosts.
+func initCa(hosts []string) error {
+	_, err := os.Stat(filepath.Join(pkiDir, caCertFile))
+	if err == nil {
+		return fmt.Errorf("certificate authority already exists in %s", pkiDir)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to check for existing ca: %w", err)
+	}
+	err = os.MkdirAll(filepath.Join(pkiDir, slaveCertsSubdir), 0700)
+	if err != nil {
+		return fmt.Errorf("failed to create pki directory: %w", err)
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate ca key: %w", err)
+	}
+	tmpl, err := newCertTemplate("Athena CA", caValidity)
+	if err != nil {
+		return err
+	}
+	tmpl.IsCA = true
+	tmpl.BasicConstraintsValid = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		return fmt.Errorf("failed to create ca certificate: %w", err)
+	}
+	err = writeKeyPair(filepath.Join(pkiDir, caCertFile), filepath.Join(pkiDir, caKeyFile), der, caKey)
+	if err != nil {
+		return err
+	}
+	caCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return fmt.Errorf("failed to parse ca certificate: %w", err)
+	}
+
+	tmpl, err = newCertTemplate("athena-master", certValidity)
+	if err != nil {
+		return err
+	}
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+	return issueCert(caCert, caKey, tmpl, filepath.Join(pkiDir, masterCertFile), filepath.Join(pkiDir, masterKeyFile))
+}
+
+// enrollSlave issues a client certificate for the slave with the given name.
+// The name is stored as the common name and checked when the slave authenticates.
+func enrollSlave(name string) (certFile, keyFile string, err error) {
+	if !filepath.IsLocal(name) || filepath.Base(name) != name {
+		return "", "", fmt.Errorf("invalid slave name %q", name)
+	}
+	caCert, caKey, err := loadCa()
+	if err != nil {
+		return "", "", err
+	}
+	tmpl, err := newCertTemplate(name, certValidity)
+	if err != nil {
+		return "", "", err
+	}
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	certFile = filepath.Join(pkiDir, slaveCertsSubdir, name+".crt")
+	keyFile = filepath.Join(pkiDir, slaveCertsSubdir, name+".key")
+	err = issueCert(caCert, caKey, tmpl, certFile, keyFile)
+	if err != nil {
+		return "", "", err
+	}
+	return certFile, keyFile, nil
+}
+
+func loadCa() (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	pair, err := tls.LoadX509KeyPair(filepath.Join(pkiDir, caCertFile), filepath.Join(pkiDir, caKeyFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load ca, run 'ca init' first: %w", err)
+	}
+	key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported ca key type %T", pair.PrivateKey)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse ca certificate: %w", err)
+	}
+	return cert, key, nil
+}
+
+func newCertTemplate(commonName string, validity time.Duration) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, nil
+}
+
+func issueCert(caCert *x509.Certificate, caKey *ecdsa.PrivateKey, tmpl *x509.Certificate, certFile, keyFile string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate key: %w", err)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate: %w", err)
+	}
+	return writeKeyPair(certFile, keyFile, der, key)
+}
+
+func writeKeyPair(certFile, keyFile string, der []byte, key *ecdsa.PrivateKey) error {
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal key: %w", err)
+	}
+	err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write certificate: %w", err)
+	}
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	return nil
+}
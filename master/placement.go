@@ -0,0 +1,122 @@
+package main
+
+import (
+	"protocol"
+)
+
+// placementStrategy decides which slave a service is scheduled on.
+type placementStrategy interface {
+	// selectSlave returns one of the candidates for svc or nil if none fits.
+	// All candidates are authenticated and have enough free memory for the service.
+	selectSlave(svc *service, candidates []*slave) *slave
+}
+
+// placementCandidates returns the slaves svc may be scheduled on. Slaves must not
+// be draining, have enough free memory, carry all required labels of the group and must not run a
+// service of a group listed in its anti affinity rules. If the group has preferred
+// labels, only the slaves matching the most of them are returned.
+func (s *scheduler) placementCandidates(svc *service) []*slave {
+	var candidates []*slave
+	bestScore := 0
+	for _, slv := range s.m.sm.slaves {
+		if !slv.authenticated || slv.draining || slv.freeMemory < svc.g.Memory {
+			continue
+		}
+		if !slv.hasLabels(svc.g.RequiredLabels) || s.violatesAntiAffinity(svc, slv) {
+			continue
+		}
+		score := 0
+		for k, v := range svc.g.PreferredLabels {
+			if slv.labels[k] == v {
+				score++
+			}
+		}
+		if score > bestScore {
+			bestScore = score
+			candidates = nil
+		}
+		if score == bestScore {
+			candidates = append(candidates, slv)
+		}
+	}
+	return candidates
+}
+
+func (s *scheduler) violatesAntiAffinity(svc *service, slv *slave) bool {
+	for _, other := range slv.services() {
+		if other == svc {
+			continue
+		}
+		for _, name := range svc.g.AntiAffinity {
+			if other.Group == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newPlacementStrategies() map[string]placementStrategy {
+	bestFit := &bestFitPlacement{}
+	worstFit := &worstFitPlacement{}
+	return map[string]placementStrategy{
+		"":                           bestFit,
+		protocol.PlacementBestFit:    bestFit,
+		protocol.PlacementWorstFit:   worstFit,
+		protocol.PlacementSpread:     worstFit,
+		protocol.PlacementRoundRobin: &roundRobinPlacement{next: make(map[string]int)},
+		protocol.PlacementPin:        &pinnedPlacement{},
+	}
+}
+
+// bestFitPlacement packs services tightly by picking the slave with the least free memory.
+type bestFitPlacement struct{}
+
+func (p *bestFitPlacement) selectSlave(svc *service, candidates []*slave) *slave {
+	var selected *slave
+	for _, slv := range candidates {
+		if selected == nil || slv.freeMemory < selected.freeMemory {
+			selected = slv
+		}
+	}
+	return selected
+}
+
+// worstFitPlacement spreads services across slaves by picking the slave with the most free memory.
+type worstFitPlacement struct{}
+
+func (p *worstFitPlacement) selectSlave(svc *service, candidates []*slave) *slave {
+	var selected *slave
+	for _, slv := range candidates {
+		if selected == nil || slv.freeMemory > selected.freeMemory {
+			selected = slv
+		}
+	}
+	return selected
+}
+
+// roundRobinPlacement cycles through the candidates separately for every group.
+type roundRobinPlacement struct {
+	next map[string]int
+}
+
+func (p *roundRobinPlacement) selectSlave(svc *service, candidates []*slave) *slave {
+	if len(candidates) == 0 {
+		return nil
+	}
+	i := p.next[svc.g.Name]
+	p.next[svc.g.Name] = i + 1
+	return candidates[i%len(candidates)]
+}
+
+// pinnedPlacement only schedules services on the group's pinned slave.
+type pinnedPlacement struct{}
+
+func (p *pinnedPlacement) selectSlave(svc *service, candidates []*slave) *slave {
+	for _, slv := range candidates {
+		if slv.name == svc.g.PinnedSlave {
+			return slv
+		}
+	}
+	return nil
+}
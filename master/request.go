@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"protocol"
+	"time"
+)
+
+const requestTimeout = 10 * time.Second
+
+var (
+	errRequestTimeout    = errors.New("slave did not answer in time")
+	errSlaveDisconnected = errors.New("slave disconnected")
+)
+
+// pendingRequest is a request sent to a slave that has not been answered yet.
+// done is called exactly once on the command queue with the outcome.
+type pendingRequest struct {
+	slv      *slave
+	deadline time.Time
+	done     func(err error)
+}
+
+type requestTable struct {
+	nextId  uint64
+	pending map[uint64]*pendingRequest
+}
+
+func newRequestTable() *requestTable {
+	return &requestTable{pending: make(map[uint64]*pendingRequest)}
+}
+
+// sendRequest sends a packet to the slave and calls done once the slave
+// answered, the request timed out or the slave disconnected. Slaves that cannot
+// reply are assumed to succeed as soon as the packet was sent. If done is nil,
+// failures are logged.
+func (s *slave) sendRequest(p proto.Message, done func(err error)) {
+	if done == nil {
+		done = func(err error) {
+			if err != nil {
+				log.Printf("slave %q failed to handle %s: %v", s.name, proto.MessageName(p).Name(), err)
+			}
+		}
+	}
+	if !s.caps[protocol.CapabilityReply] {
+		done(s.sendPacket(p))
+		return
+	}
+	reqs := s.m.sm.requests
+	reqs.nextId++
+	id := reqs.nextId
+	err := s.sendPacketWithRequestId(p, id)
+	if err != nil {
+		done(err)
+		return
+	}
+	reqs.pending[id] = &pendingRequest{
+		slv:      s,
+		deadline: time.Now().Add(requestTimeout),
+		done:     done,
+	}
+}
+
+func (reqs *requestTable) resolve(slv *slave, p *protocol.PacketReply) {
+	req, exists := reqs.pending[p.RequestId]
+	if !exists || req.slv != slv {
+		log.Printf("slave %q answered unknown request %d", slv.name, p.RequestId)
+		return
+	}
+	delete(reqs.pending, p.RequestId)
+	if p.Error != "" {
+		req.done(errors.New(p.Error))
+		return
+	}
+	req.done(nil)
+}
+
+// expire fails all requests whose deadline has passed.
+func (reqs *requestTable) expire() {
+	now := time.Now()
+	for id, req := range reqs.pending {
+		if now.After(req.deadline) {
+			delete(reqs.pending, id)
+			req.done(fmt.Errorf("%w (request %d)", errRequestTimeout, id))
+		}
+	}
+}
+
+// failSlave fails all pending requests of a slave that disconnected.
+func (reqs *requestTable) failSlave(slv *slave) {
+	for id, req := range reqs.pending {
+		if req.slv == slv {
+			delete(reqs.pending, id)
+			req.done(errSlaveDisconnected)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"protocol"
+	"slices"
+)
+
+// rollingRestart replaces the services of a group a few at a time, so the
+// group keeps serving players while it restarts.
+type rollingRestart struct {
+	g              *group
+	batchSize      int
+	maxUnavailable int
+	// remaining are the services that still have to be restarted.
+	remaining []*service
+	// replacements are the services started for the current batch that did
+	// not come online yet.
+	replacements []*service
+	// stopping are the static services of the current batch that are started
+	// again under the same name once they stopped.
+	stopping []*service
+	total    int
+	// restarted counts the services whose replacement came online.
+	restarted int
+}
+
+// startRollingRestart begins a rolling restart of the group. Warm services
+// are stopped right away, the pool is refilled with fresh ones. Static
+// services are restarted in place, as a replacement would not find their
+// data.
+func (s *scheduler) startRollingRestart(g *group, batchSize, maxUnavailable int) error {
+	if batchSize < 1 {
+		return fmt.Errorf("batch size must be at least 1")
+	}
+	if maxUnavailable < 1 {
+		return fmt.Errorf("max unavailable must be at least 1")
+	}
+	if s.restarts[g] != nil {
+		return fmt.Errorf("group %q is already restarting", g.Name)
+	}
+	r := &rollingRestart{g: g, batchSize: batchSize, maxUnavailable: maxUnavailable}
+	var warm []*service
+	for _, svc := range s.m.gm.services(g) {
+		if svc.State == protocol.Service_STATE_STOPPING || svc.lost() {
+			continue
+		}
+		if svc.Warm {
+			warm = append(warm, svc)
+		} else if svc.s != nil && (svc.State == protocol.Service_STATE_ONLINE || svc.State == protocol.Service_STATE_SCHEDULED) {
+			r.remaining = append(r.remaining, svc)
+		}
+	}
+	r.total = len(r.remaining)
+	s.removeServices(warm)
+	s.restarts[g] = r
+
+	msg := fmt.Sprintf("restarting %d services, %d at a time with at most %d unavailable", r.total, batchSize, maxUnavailable)
+	log.Printf("rolling restart of group %q: %s", g.Name, msg)
+	s.m.events.emit(event{Type: eventGroupRestartStarted, Group: g.Name, Message: msg})
+	s.checkRollingRestart(r)
+	return nil
+}
+
+// abortRollingRestart stops the rolling restart of the group. Services that
+// were not restarted yet keep running.
+func (s *scheduler) abortRollingRestart(g *group, reason string) error {
+	r := s.restarts[g]
+	if r == nil {
+		return fmt.Errorf("group %q is not restarting", g.Name)
+	}
+	delete(s.restarts, g)
+	log.Printf("rolling restart of group %q aborted after %d/%d services: %s", g.Name, r.restarted, r.total, reason)
+	s.m.events.emit(event{
+		Type:    eventGroupRestartAborted,
+		Group:   g.Name,
+		Message: fmt.Sprintf("aborted after %d/%d services: %s", r.restarted, r.total, reason),
+	})
+	return nil
+}
+
+// checkRollingRestarts advances all rolling restarts.
+func (s *scheduler) checkRollingRestarts() {
+	for _, r := range s.restarts {
+		s.checkRollingRestart(r)
+	}
+}
+
+// checkRollingRestart waits until the replacements of the current batch are
+// online and then stops the next batch. The restart is aborted if a
+// replacement fails to start. Static services are only started again once
+// they stopped.
+func (s *scheduler) checkRollingRestart(r *rollingRestart) {
+	g := r.g
+	if s.m.gm.getGroup(g.Name) != g {
+		delete(s.restarts, g)
+		log.Printf("rolling restart of group %q aborted: group was deleted", g.Name)
+		return
+	}
+	if g.failing {
+		s.abortRollingRestart(g, "group is failing")
+		return
+	}
+
+	if len(r.stopping) > 0 {
+		var stopping []*service
+		for _, svc := range r.stopping {
+			if s.getService(svc.Name) == svc {
+				stopping = append(stopping, svc)
+				continue
+			}
+			replacement := s.getService(svc.Name)
+			if replacement == nil {
+				log.Printf("rolling restart of group %q: starting service %q again", g.Name, svc.Name)
+				replacement = s.createNamedService(g, svc.Name, false)
+			}
+			r.replacements = append(r.replacements, replacement)
+		}
+		r.stopping = stopping
+	}
+
+	if len(r.replacements) > 0 || len(r.stopping) > 0 {
+		var starting []*service
+		for _, svc := range r.replacements {
+			if s.getService(svc.Name) != svc {
+				s.abortRollingRestart(g, fmt.Sprintf("service %q failed to start", svc.Name))
+				return
+			}
+			if svc.State == protocol.Service_STATE_ONLINE {
+				r.restarted++
+			} else {
+				starting = append(starting, svc)
+			}
+		}
+		r.replacements = starting
+		if len(r.replacements) > 0 || len(r.stopping) > 0 {
+			return
+		}
+		msg := fmt.Sprintf("%d/%d services restarted", r.restarted, r.total)
+		log.Printf("rolling restart of group %q: %s", g.Name, msg)
+		s.m.events.emit(event{Type: eventGroupRestartProgress, Group: g.Name, Message: msg})
+	}
+
+	// services that were stopped or scaled down in the meantime need no restart
+	r.remaining = slices.DeleteFunc(r.remaining, func(svc *service) bool {
+		return s.getService(svc.Name) != svc || svc.State == protocol.Service_STATE_STOPPING
+	})
+	if len(r.remaining) == 0 {
+		delete(s.restarts, g)
+		log.Printf("rolling restart of group %q finished, %d services restarted", g.Name, r.restarted)
+		s.m.events.emit(event{
+			Type:    eventGroupRestartFinished,
+			Group:   g.Name,
+			Message: fmt.Sprintf("%d services restarted", r.restarted),
+		})
+		return
+	}
+
+	unavailable := 0
+	for _, svc := range s.m.gm.activeServices(g) {
+		if svc.State != protocol.Service_STATE_STOPPING && (svc.State != protocol.Service_STATE_ONLINE || svc.lost()) {
+			unavailable++
+		}
+	}
+	n := min(r.batchSize, r.maxUnavailable-unavailable, len(r.remaining))
+	if n <= 0 {
+		return
+	}
+	if g.backingOff() {
+		return
+	}
+
+	batch := r.remaining[:n]
+	r.remaining = r.remaining[n:]
+	for _, svc := range batch {
+		if g.Static {
+			log.Printf("rolling restart of group %q: restarting service %q in place", g.Name, svc.Name)
+		} else {
+			log.Printf("rolling restart of group %q: replacing service %q", g.Name, svc.Name)
+		}
+		err := s.stopService(svc)
+		if err != nil {
+			log.Printf("failed to stop service %q: %v", svc.Name, err)
+			continue
+		}
+		if g.Static {
+			r.stopping = append(r.stopping, svc)
+			continue
+		}
+		replacement := s.promoteWarmService(g)
+		if replacement == nil {
+			replacement = s.createService(g, false)
+		}
+		r.replacements = append(r.replacements, replacement)
+	}
+}
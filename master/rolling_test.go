@@ -0,0 +1,70 @@
+package main
+
+import (
+	"protocol"
+	"testing"
+)
+
+func TestRollingRestartOfStaticGroupRestartsInPlace(t *testing.T) {
+	m := &master{events: newEventBus(), sm: &slaveManager{requests: newRequestTable()}, sc: newScreen()}
+	s := newScheduler(m)
+	m.sched = s
+	g := &group{Group: &protocol.Group{
+		Name:        "survival",
+		Type:        protocol.Service_TYPE_SERVER,
+		MinServices: 2,
+		MaxServices: 2,
+		Static:      true,
+		Placement:   protocol.PlacementPin,
+		PinnedSlave: "slave-1",
+	}}
+	m.gm = &groupManager{m: m, groups: []*group{g}}
+	slv := &slave{m: m, name: "slave-1", authenticated: true, caps: map[string]bool{}}
+	for _, name := range []string{"survival-01", "survival-02"} {
+		s.services = append(s.services, &service{
+			Service: &protocol.Service{
+				Name:  name,
+				Group: g.Name,
+				Slave: slv.name,
+				Type:  protocol.Service_TYPE_SERVER,
+				State: protocol.Service_STATE_ONLINE,
+			},
+			g: g,
+			s: slv,
+		})
+	}
+	old := s.getService("survival-01")
+
+	err := s.startRollingRestart(g, 1, 1)
+	if err != nil {
+		t.Fatalf("startRollingRestart: %v", err)
+	}
+	s.checkDrains()
+	s.checkRollingRestarts()
+	if len(s.services) != 2 {
+		t.Fatalf("replacement was created before the static service stopped")
+	}
+
+	err = slv.handlePacket(&protocol.PacketServiceStopped{ServiceName: old.Name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.checkRollingRestarts()
+	svc := s.getService(old.Name)
+	if svc == nil || svc == old {
+		t.Fatalf("static service was not started again under its name")
+	}
+	if len(s.services) != 2 {
+		t.Fatalf("group has %d services, want 2", len(s.services))
+	}
+	if s.getService("survival-02").State != protocol.Service_STATE_ONLINE {
+		t.Fatalf("next service was stopped before the restarted one came online")
+	}
+
+	svc.s = slv
+	svc.State = protocol.Service_STATE_ONLINE
+	s.checkRollingRestarts()
+	if s.getService("survival-02").State != protocol.Service_STATE_STOPPING {
+		t.Fatalf("next service was not stopped once the restarted one came online")
+	}
+}
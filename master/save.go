@@ -0,0 +1,272 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"protocol"
+	"time"
+)
+
+const (
+	// saveTimeout is the time a slave may take between two chunks of a save.
+	saveTimeout = time.Minute
+	// saveProgressInterval is the amount of bytes after which the progress of
+	// a save is logged.
+	saveProgressInterval = 16 << 20
+)
+
+// templateSave is a service directory that is streamed from a slave into a
+// template.
+type templateSave struct {
+	id       uint64
+	slv      *slave
+	svcName  string
+	template string
+	file     *os.File
+	size     int64
+	reported int64
+	deadline time.Time
+}
+
+// saveService asks the slave of the service to stream its directory to the
+// master, which then replaces the template with it.
+func (tmpl *templateManager) saveService(svc *service, template string, excludes []string, saveAll bool) error {
+	err := protocol.ValidateTemplateName(template)
+	if err != nil {
+		return err
+	}
+	if svc.s == nil {
+		return fmt.Errorf("service %q is not running on a slave", svc.Name)
+	}
+	if !svc.s.caps[protocol.CapabilityServiceSave] {
+		return fmt.Errorf("slave %q does not support saving services", svc.s.name)
+	}
+	for _, pattern := range excludes {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("invalid exclude pattern %q: %w", pattern, err)
+		}
+	}
+
+	tmpl.nextSaveId++
+	save := &templateSave{
+		id:       tmpl.nextSaveId,
+		slv:      svc.s,
+		svcName:  svc.Name,
+		template: template,
+		deadline: time.Now().Add(saveTimeout),
+	}
+	save.file, err = os.Create(save.archivePath(tmpl))
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	tmpl.saves[save.id] = save
+
+	log.Printf("saving service %q into template %q", svc.Name, template)
+	svc.s.sendRequest(&protocol.PacketSaveService{
+		SaveId:      save.id,
+		ServiceName: svc.Name,
+		Excludes:    excludes,
+		SaveAll:     saveAll,
+	}, func(err error) {
+		if err != nil {
+			tmpl.failSave(save, err)
+		}
+	})
+	return nil
+}
+
+func (save *templateSave) archivePath(tmpl *templateManager) string {
+	return path.Join(tmpl.saveDir, fmt.Sprintf("%d.tar.gz", save.id))
+}
+
+func (tmpl *templateManager) handleSaveChunk(slv *slave, p *protocol.PacketServiceSaveChunk) {
+	save, exists := tmpl.saves[p.SaveId]
+	if !exists || save.slv != slv {
+		log.Printf("slave %q sent data of unknown save %d", slv.name, p.SaveId)
+		return
+	}
+	if p.Error != "" {
+		tmpl.failSave(save, errors.New(p.Error))
+		return
+	}
+	_, err := save.file.Write(p.Data)
+	if err != nil {
+		tmpl.failSave(save, fmt.Errorf("failed to write archive: %w", err))
+		return
+	}
+	save.size += int64(len(p.Data))
+	save.deadline = time.Now().Add(saveTimeout)
+	if save.size-save.reported >= saveProgressInterval {
+		save.reported = save.size
+		log.Printf("received %d MiB of service %q", save.size>>20, save.svcName)
+	}
+	if !p.Last {
+		return
+	}
+
+	err = save.file.Close()
+	if err != nil {
+		tmpl.failSave(save, fmt.Errorf("failed to write archive: %w", err))
+		return
+	}
+	delete(tmpl.saves, save.id)
+	tmpl.submitVersionJob(&versionJob{
+		name:    save.template,
+		message: fmt.Sprintf("saved from service %q", save.svcName),
+		prepare: func() error {
+			return tmpl.installSave(save)
+		},
+		done: func(v *templateVersion, err error) {
+			tmpl.saveFinished(save, v, err)
+		},
+	})
+}
+
+// installSave extracts the archive of the save and swaps it with the template.
+// It runs on the version worker, as large services take a while to extract.
+func (tmpl *templateManager) installSave(save *templateSave) error {
+	archive := save.archivePath(tmpl)
+	defer func() {
+		_ = os.Remove(archive)
+	}()
+	dir := path.Join(tmpl.saveDir, fmt.Sprintf("%d", save.id))
+	err := extractArchive(archive, dir)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return err
+	}
+	return tmpl.replaceTemplate(save.template, dir)
+}
+
+// saveFinished reports a save once it was installed and versioned.
+func (tmpl *templateManager) saveFinished(save *templateSave, v *templateVersion, err error) {
+	if err != nil {
+		log.Printf("failed to save service %q into template %q: %v", save.svcName, save.template, err)
+		return
+	}
+	log.Printf("saved service %q into template %q as version %d (%d KiB)", save.svcName, save.template, v.Version, save.size>>10)
+	tmpl.m.events.emit(event{
+		Type:    eventTemplateSaved,
+		Slave:   save.slv.name,
+		Message: fmt.Sprintf("saved service %q into template %q", save.svcName, save.template),
+	})
+}
+
+// replaceTemplate moves dir to the template, the previous content of the
+// template is removed.
+func (tmpl *templateManager) replaceTemplate(name, dir string) error {
+	target := path.Join(tmpl.templateDir, name)
+	old := dir + ".old"
+	err := os.Rename(target, old)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to move old template: %w", err)
+	}
+	err = os.Rename(dir, target)
+	if err != nil {
+		_ = os.Rename(old, target)
+		return fmt.Errorf("failed to move saved template: %w", err)
+	}
+	err = os.RemoveAll(old)
+	if err != nil {
+		return fmt.Errorf("failed to remove old template: %w", err)
+	}
+	return nil
+}
+
+func extractArchive(archive, dir string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+		if !filepath.IsLocal(hdr.Name) {
+			return fmt.Errorf("archive contains invalid path %q", hdr.Name)
+		}
+		target := filepath.Join(dir, hdr.Name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0755)
+			if err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+		case tar.TypeReg:
+			err = extractFile(tr, target, hdr.FileInfo().Mode().Perm())
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func extractFile(r io.Reader, target string, perm os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	_, err = io.Copy(f, r)
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to extract file: %w", err)
+	}
+	return f.Close()
+}
+
+func (tmpl *templateManager) failSave(save *templateSave, err error) {
+	if _, exists := tmpl.saves[save.id]; !exists {
+		return
+	}
+	delete(tmpl.saves, save.id)
+	_ = save.file.Close()
+	_ = os.Remove(save.archivePath(tmpl))
+	log.Printf("failed to save service %q into template %q: %v", save.svcName, save.template, err)
+}
+
+// expireSaves fails all saves whose slave stopped sending data.
+func (tmpl *templateManager) expireSaves() {
+	now := time.Now()
+	for _, save := range tmpl.saves {
+		if now.After(save.deadline) {
+			tmpl.failSave(save, errors.New("slave stopped sending data"))
+		}
+	}
+}
+
+// failSlaveSaves fails all saves of a slave that disconnected.
+func (tmpl *templateManager) failSlaveSaves(slv *slave) {
+	for _, save := range tmpl.saves {
+		if save.slv == slv {
+			tmpl.failSave(save, errSlaveDisconnected)
+		}
+	}
+}
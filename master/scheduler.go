@@ -2,58 +2,78 @@ package main
 
 import (
 	"common"
+	"errors"
 	"fmt"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"log"
 	"protocol"
+	"slices"
+	"time"
 )
 
+const defaultScaleDownDelay = 60 * time.Second
+
 type service struct {
 	*protocol.Service
-	g *group
-	s *slave
+	g          *group
+	s          *slave
+	emptySince time.Time
+	usedMemory int32
+	lostAt     time.Time
+	createdAt  time.Time
+	// draining services are stopped once their players moved to the fallback
+	// group or the drain deadline passed.
+	draining      bool
+	drainDeadline time.Time
+	drainDone     func(err error)
+	// drainCounted is set once the service reported its players after the
+	// drain started, the count from before may be outdated.
+	drainCounted bool
 }
 
 type scheduler struct {
-	m        *master
-	services []*service
+	m          *master
+	services   []*service
+	placements map[string]placementStrategy
+	restarts   map[*group]*rollingRestart
 }
 
 func newScheduler(m *master) *scheduler {
-	return &scheduler{m: m}
+	return &scheduler{m: m, placements: newPlacementStrategies(), restarts: make(map[*group]*rollingRestart)}
 }
 
 func (s *scheduler) scheduleServices() {
+	s.expireLostServices()
+	s.checkDrains()
+	// static services restarted in place take their names back before new
+	// services are created
+	s.checkRollingRestarts()
+
 	for _, g := range s.m.gm.groups {
-		nSvcs := int32(len(s.m.gm.services(g)))
-		if nSvcs < g.MinServices {
-			for i := int32(0); i < g.MinServices-nSvcs; i++ {
-				s.createService(g)
+		nSvcs := int32(len(s.m.gm.activeServices(g)))
+		for i := nSvcs; i < g.MinServices; i++ {
+			if s.promoteWarmService(g) != nil {
+				continue
 			}
+			if g.backingOff() {
+				break
+			}
+			s.createService(g, false)
 		}
 		if nSvcs > g.MaxServices {
 			var svcs []*service
-			for _, svc := range s.m.gm.services(g) {
+			for _, svc := range s.m.gm.activeServices(g) {
 				if svc.State != protocol.Service_STATE_STOPPING {
 					svcs = append(svcs, svc)
 				}
 			}
-			toStop := svcs[g.MaxServices:]
-			for _, svc := range toStop {
-				if svc.State == protocol.Service_STATE_PENDING || svc.State == protocol.Service_STATE_ONLINE {
-					err := s.stopService(svc)
-					if err != nil {
-						log.Printf("failed to stop service %q: %v", svc.Name, err)
-					}
-				} else {
-					err := s.deleteService(svc)
-					if err != nil {
-						log.Printf("failed to delete service %q: %v", svc.Name, err)
-					}
-				}
+			if int32(len(svcs)) > g.MaxServices {
+				s.removeServices(svcs[g.MaxServices:])
 			}
 		}
+		s.autoscale(g)
+		s.refillWarmPool(g)
 	}
 
 	for _, svc := range s.services {
@@ -61,8 +81,157 @@ func (s *scheduler) scheduleServices() {
 	}
 }
 
-func (s *scheduler) createService(g *group) {
-	name := s.getNextServiceName(g.Name)
+// removeServices stops the services that run on a slave and deletes the ones
+// that were not scheduled yet. Lost services are left alone until their slave
+// reconnects or they expire.
+func (s *scheduler) removeServices(svcs []*service) {
+	for _, svc := range svcs {
+		if svc.lost() {
+			continue
+		}
+		if svc.s != nil {
+			err := s.stopService(svc)
+			if err != nil {
+				log.Printf("failed to stop service %q: %v", svc.Name, err)
+			}
+		} else {
+			err := s.deleteService(svc)
+			if err != nil {
+				log.Printf("failed to delete service %q: %v", svc.Name, err)
+			}
+		}
+	}
+}
+
+// refillWarmPool starts warm services until the group has as many as
+// configured and stops the ones it no longer needs.
+func (s *scheduler) refillWarmPool(g *group) {
+	var warm []*service
+	for _, svc := range s.m.gm.services(g) {
+		if svc.Warm && svc.State != protocol.Service_STATE_STOPPING {
+			warm = append(warm, svc)
+		}
+	}
+	if int32(len(warm)) > g.WarmServices {
+		s.removeServices(warm[g.WarmServices:])
+		return
+	}
+	for i := int32(len(warm)); i < g.WarmServices && !g.backingOff(); i++ {
+		s.createService(g, true)
+	}
+}
+
+// promoteWarmService puts a warm service of the group into use, preferring
+// services that already started. It returns nil if the group has no warm
+// service.
+func (s *scheduler) promoteWarmService(g *group) *service {
+	var best *service
+	for _, svc := range s.m.gm.services(g) {
+		if !svc.Warm || svc.lost() || svc.State == protocol.Service_STATE_STOPPING {
+			continue
+		}
+		if best == nil || warmRank(svc) > warmRank(best) {
+			best = svc
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	best.Warm = false
+	// the start duration is measured from the promotion, not the time the
+	// service spent in the pool
+	best.createdAt = time.Now()
+	if best.State != protocol.Service_STATE_ONLINE {
+		log.Printf("promoting starting warm service %q", best.Name)
+		return best
+	}
+	log.Printf("promoting warm service %q", best.Name)
+	best.emptySince = time.Now()
+	s.m.metrics.observeServiceOnline(best)
+	s.registerWithProxies(best)
+	s.m.events.emitService(eventServiceOnline, best, "promoted from the warm pool")
+	return best
+}
+
+func warmRank(svc *service) int {
+	switch svc.State {
+	case protocol.Service_STATE_ONLINE:
+		return 2
+	case protocol.Service_STATE_SCHEDULED:
+		return 1
+	}
+	return 0
+}
+
+// autoscale starts a new service when the average player fill of the online
+// services in g exceeds the group's scale threshold, and stops surplus services
+// that have been empty for longer than the scale down delay.
+func (s *scheduler) autoscale(g *group) {
+	if g.MaxPlayers <= 0 || g.ScaleThreshold <= 0 {
+		return
+	}
+
+	var online []*service
+	var nSvcs, players int32
+	for _, svc := range s.m.gm.activeServices(g) {
+		switch svc.State {
+		case protocol.Service_STATE_PENDING, protocol.Service_STATE_SCHEDULED:
+			// wait for starting services before scaling any further
+			return
+		case protocol.Service_STATE_ONLINE:
+			online = append(online, svc)
+			players += svc.Players
+		}
+		if svc.State != protocol.Service_STATE_STOPPING {
+			nSvcs++
+		}
+	}
+	if len(online) == 0 {
+		return
+	}
+
+	capacity := int32(len(online)) * g.MaxPlayers
+	if players*100 > capacity*g.ScaleThreshold {
+		if nSvcs < g.MaxServices && !g.backingOff() {
+			log.Printf("group %q is above %d%% fill (%d/%d players), scaling up", g.Name, g.ScaleThreshold, players, capacity)
+			if s.promoteWarmService(g) == nil {
+				s.createService(g, false)
+			}
+		}
+		return
+	}
+
+	if nSvcs <= g.MinServices || len(online) < 2 {
+		return
+	}
+	delay := defaultScaleDownDelay
+	if g.ScaleDownDelay > 0 {
+		delay = time.Duration(g.ScaleDownDelay) * time.Second
+	}
+	// stopping a service must not push the remaining services over the threshold
+	if players*100 > (capacity-g.MaxPlayers)*g.ScaleThreshold {
+		return
+	}
+	for _, svc := range online {
+		if svc.Players > 0 || svc.emptySince.IsZero() || time.Since(svc.emptySince) < delay {
+			continue
+		}
+		log.Printf("service %q has been empty for %s, scaling down group %q", svc.Name, delay, g.Name)
+		err := s.stopService(svc)
+		if err != nil {
+			log.Printf("failed to stop service %q: %v", svc.Name, err)
+		}
+		return
+	}
+}
+
+func (s *scheduler) createService(g *group, warm bool) *service {
+	return s.createNamedService(g, s.getNextServiceName(g), warm)
+}
+
+// createNamedService creates a service with the given name. Static services
+// are restarted under their old name, so they keep their working directory.
+func (s *scheduler) createNamedService(g *group, name string, warm bool) *service {
 	svc := &service{
 		Service: &protocol.Service{
 			Name:   name,
@@ -72,45 +241,166 @@ func (s *scheduler) createService(g *group) {
 			Slave:  "",
 			Port:   0,
 			Memory: 0,
+			Warm:   warm,
 		},
-		g: g,
+		g:         g,
+		createdAt: time.Now(),
 	}
 	s.services = append(s.services, svc)
-	log.Printf("service %q created", svc.Name)
+	if warm {
+		log.Printf("warm service %q created", svc.Name)
+	} else {
+		log.Printf("service %q created", svc.Name)
+	}
+	s.m.events.emitService(eventServiceCreated, svc, "")
+	return svc
 }
 
 func (s *scheduler) scheduleService(svc *service) {
-	if svc.s != nil {
+	if svc.s != nil || svc.lost() || svc.g.backingOff() {
 		return
 	}
-
-	for _, slv := range s.m.sm.slaves {
-		if slv.authenticated && slv.freeMemory >= svc.g.Memory && (svc.s == nil || slv.freeMemory < svc.s.freeMemory) {
-			svc.s = slv
-		}
+	// services that cannot be placed do not need their templates yet
+	candidates := s.placementCandidates(svc)
+	slv := s.placements[svc.g.Placement].selectSlave(svc, candidates)
+	if slv == nil {
+		return
 	}
-	if svc.s == nil {
+	templates, hashes, err := s.m.tmpl.serviceTemplates(svc.g)
+	if errors.Is(err, errVersionPending) {
+		return
+	}
+	if err != nil {
+		log.Printf("cannot schedule service %q: %v", svc.Name, err)
+		s.m.gm.recordFailure(svc.g, err.Error())
 		return
 	}
 
+	svc.s = slv
 	svc.State = protocol.Service_STATE_SCHEDULED
 	svc.Slave = svc.s.name
+	svc.Templates = templates
+	svc.TemplateHashes = hashes
+	svc.s.reserveMemory(svc)
 	log.Printf("scheduling service %q on slave %q", svc.Name, svc.Slave)
 	svc.s.schedule(svc)
+	s.m.events.emitService(eventServiceScheduled, svc, "")
+}
+
+// expireLostServices deletes services whose slave did not reconnect within the
+// configured reconnect timeout.
+func (s *scheduler) expireLostServices() {
+	timeout := time.Duration(s.m.cfg.SlaveReconnectTimeout) * time.Second
+	for _, svc := range slices.Clone(s.services) {
+		if !svc.lost() || time.Since(svc.lostAt) < timeout {
+			continue
+		}
+		log.Printf("slave %q did not reconnect in time, giving up service %q", svc.Slave, svc.Name)
+		s.removeLostService(svc)
+	}
+}
+
+func (s *scheduler) removeLostService(svc *service) {
+	svc.lostAt = time.Time{}
+	svc.State = protocol.Service_STATE_OFFLINE
+	svc.Port = 0
+	s.abortDrain(svc, errSlaveDisconnected)
+	err := s.deleteService(svc)
+	if err != nil {
+		log.Printf("failed to delete service %q: %v", svc.Name, err)
+	}
+	s.unregisterFromProxies(svc)
+	s.m.events.emitService(eventServiceStopped, svc, "slave did not report the service after reconnecting")
+}
+
+// registerWithProxies makes an online server known to all online proxies, or
+// registers all online servers with an online proxy.
+func (s *scheduler) registerWithProxies(svc *service) {
+	if svc.Type == protocol.Service_TYPE_PROXY {
+		for _, srv := range s.services {
+			if srv.Type != protocol.Service_TYPE_SERVER || srv.s == nil || srv.State != protocol.Service_STATE_ONLINE || srv.Warm {
+				continue
+			}
+			err := svc.sendPacket(&protocol.PacketProxyRegisterServer{
+				ServerName: srv.Name,
+				Host:       srv.s.host,
+				Port:       srv.Port,
+			})
+			if err != nil {
+				log.Printf("failed to send proxy register server packet: %v", err)
+			}
+		}
+	} else if svc.Type == protocol.Service_TYPE_SERVER {
+		for _, prx := range s.services {
+			if prx.Type != protocol.Service_TYPE_PROXY || prx.s == nil || prx.State != protocol.Service_STATE_ONLINE {
+				continue
+			}
+			err := prx.sendPacket(&protocol.PacketProxyRegisterServer{
+				ServerName: svc.Name,
+				Host:       svc.s.host,
+				Port:       svc.Port,
+			})
+			if err != nil {
+				log.Printf("failed to send proxy register server packet: %v", err)
+			}
+		}
+	}
+}
+
+func (s *scheduler) unregisterFromProxies(svc *service) {
+	if svc.Type != protocol.Service_TYPE_SERVER {
+		return
+	}
+	for _, prx := range s.services {
+		if prx.Type != protocol.Service_TYPE_PROXY || prx.s == nil || prx.State != protocol.Service_STATE_ONLINE {
+			continue
+		}
+		err := prx.sendPacket(&protocol.PacketProxyUnregisterServer{
+			ServerName: svc.Name,
+		})
+		if err != nil {
+			log.Printf("failed to send proxy unregister server packet: %v", err)
+		}
+	}
 }
 
 func (s *scheduler) stopService(svc *service) error {
-	log.Printf("stopping service %q on slave %q", svc.Name, svc.Slave)
+	return s.requestStop(svc, nil)
+}
+
+// requestStop asks the slave to stop the service and calls done once the slave
+// confirmed it. The service is reported as stopped separately. Online servers
+// are drained first, as players may have joined since they last reported
+// their count. Stopping a draining server again skips the rest of its drain.
+func (s *scheduler) requestStop(svc *service, done func(err error)) error {
 	if svc.s == nil {
 		return fmt.Errorf("service %q is not running", svc.Name)
 	}
+	if svc.draining {
+		prev := svc.drainDone
+		svc.drainDone = func(err error) {
+			if prev != nil {
+				prev(err)
+			}
+			if done != nil {
+				done(err)
+			}
+		}
+		s.finishDrain(svc)
+		return nil
+	}
+	if svc.Type == protocol.Service_TYPE_SERVER && svc.State == protocol.Service_STATE_ONLINE && !svc.Warm {
+		// players must not join the server while it stops
+		s.unregisterFromProxies(svc)
+		s.drainService(svc, done)
+		return nil
+	}
+	log.Printf("stopping service %q on slave %q", svc.Name, svc.Slave)
 	svc.State = protocol.Service_STATE_STOPPING
-	err := svc.s.sendPacket(&protocol.PacketStopService{
+	svc.s.sendRequest(&protocol.PacketStopService{
 		ServiceName: svc.Name,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to stop service %q on slave %q: %v", svc.Name, svc.Slave, err)
-	}
+	}, done)
+	s.m.events.emitService(eventServiceStopping, svc, "")
 	return nil
 }
 
@@ -121,6 +411,9 @@ func (s *scheduler) deleteService(svc *service) error {
 		return fmt.Errorf("service %q is in state %s", svc.Name, svc.State)
 	}
 	svc.State = protocol.Service_STATE_OFFLINE
+	if svc.s != nil {
+		svc.s.releaseMemory(svc)
+	}
 	s.services = common.DeleteItem(s.services, svc)
 	if s.m.sc.svc == svc {
 		err := s.m.sc.detach()
@@ -132,9 +425,24 @@ func (s *scheduler) deleteService(svc *service) error {
 	return nil
 }
 
-func (s *scheduler) getNextServiceName(prefix string) string {
+// getNextServiceName returns the name of a new service of the group. Services
+// are numbered by a persistent counter, so a name is not reused while an old
+// instance may still run on a disconnected slave. Static services take the
+// lowest free number instead, so they find their working directories again.
+func (s *scheduler) getNextServiceName(g *group) string {
+	for !g.Static {
+		seq, err := s.m.store.nextSequence("services/" + g.Name)
+		if err != nil {
+			log.Printf("failed to number service of group %q: %v", g.Name, err)
+			break
+		}
+		name := fmt.Sprintf("%s-%02d", g.Name, seq)
+		if s.getService(name) == nil {
+			return name
+		}
+	}
 	for i := 1; ; i++ {
-		name := fmt.Sprintf("%s-%02d", prefix, i)
+		name := fmt.Sprintf("%s-%02d", g.Name, i)
 		if s.getService(name) == nil {
 			return name
 		}
@@ -150,6 +458,12 @@ func (s *scheduler) getService(name string) *service {
 	return nil
 }
 
+// lost reports whether the slave of the service disconnected and the service is
+// waiting for the slave to reconnect.
+func (svc *service) lost() bool {
+	return !svc.lostAt.IsZero()
+}
+
 func (svc *service) sendPacket(p proto.Message) error {
 	if svc.s == nil {
 		return fmt.Errorf("service %q is not running", svc.Name)
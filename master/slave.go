@@ -2,18 +2,26 @@ package main
 
 import (
 	"common"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"github.com/fatih/color"
 	"google.golang.org/protobuf/proto"
 	"log"
 	"net"
 	"protocol"
+	"slices"
 	"strings"
+	"time"
 )
 
 type slaveManager struct {
-	m      *master
-	slaves []*slave
+	m             *master
+	slaves        []*slave
+	registrations map[string]*slaveRecord
+	credentials   map[string]*slaveCredential
+	requests      *requestTable
 }
 
 type slave struct {
@@ -24,10 +32,36 @@ type slave struct {
 	authenticated bool
 	memory        int32
 	freeMemory    int32
+	usedMemory    int32
+	labels        map[string]string
+	draining      bool
+	caps          map[string]bool
+}
+
+type slaveInfo struct {
+	Name           string              `yaml:"name" json:"name"`
+	Host           string              `yaml:"host" json:"host"`
+	Labels         map[string]string   `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Draining       bool                `yaml:"draining,omitempty" json:"draining,omitempty"`
+	Memory         int32               `yaml:"memory" json:"memory"`
+	ReservedMemory int32               `yaml:"reserved_memory" json:"reserved_memory"`
+	UsedMemory     int32               `yaml:"used_memory" json:"used_memory"`
+	Services       []serviceMemoryInfo `yaml:"services,omitempty" json:"services,omitempty"`
+}
+
+type serviceMemoryInfo struct {
+	Name           string `yaml:"name" json:"name"`
+	ReservedMemory int32  `yaml:"reserved_memory" json:"reserved_memory"`
+	UsedMemory     int32  `yaml:"used_memory" json:"used_memory"`
 }
 
 func newSlaveManager(m *master) *slaveManager {
-	return &slaveManager{m: m}
+	return &slaveManager{
+		m:             m,
+		registrations: make(map[string]*slaveRecord),
+		credentials:   make(map[string]*slaveCredential),
+		requests:      newRequestTable(),
+	}
 }
 
 func (sm *slaveManager) newSlave(conn net.Conn) *slave {
@@ -40,9 +74,15 @@ func (sm *slaveManager) newSlave(conn net.Conn) *slave {
 func (s *slave) handlePacketPreAuth(p proto.Message) error {
 	switch p := p.(type) {
 	case *protocol.PacketAuthenticate:
-		if p.SecretKey != s.m.cfg.SecretKey {
-			_ = s.sendPacket(&protocol.PacketAuthFailed{Message: "invalid secret key"})
-			return fmt.Errorf("invalid secret key")
+		err := protocol.CheckVersion(p.ProtocolVersion)
+		if err != nil {
+			_ = s.sendPacket(&protocol.PacketAuthFailed{Message: fmt.Sprintf("incompatible slave: %v", err)})
+			return err
+		}
+		err = s.verifyCredentials(p)
+		if err != nil {
+			_ = s.sendPacket(&protocol.PacketAuthFailed{Message: err.Error()})
+			return err
 		}
 		slv := s.m.sm.getSlave(p.SlaveName)
 		if slv != nil {
@@ -52,94 +92,135 @@ func (s *slave) handlePacketPreAuth(p proto.Message) error {
 		s.name = p.SlaveName
 		s.memory = p.Memory
 		s.freeMemory = p.Memory
+		s.labels = p.Labels
+		s.caps = protocol.NegotiateCapabilities(p.Capabilities)
 		s.authenticated = true
+		s.m.sm.register(s)
 		log.Printf("slave %q successfully authenticated", s.name)
-		err := s.sendPacket(&protocol.PacketAuthSuccess{})
+		err = s.sendPacket(&protocol.PacketAuthSuccess{
+			HeartbeatInterval: int32(s.m.cfg.HeartbeatInterval),
+			HeartbeatTimeout:  int32(s.m.cfg.HeartbeatTimeout),
+			ProtocolVersion:   protocol.Version,
+			Capabilities:      protocol.Capabilities,
+		})
 		if err != nil {
 			return fmt.Errorf("failed to send auth success packet: %v", err)
 		}
+		s.m.events.emit(event{Type: eventSlaveConnected, Slave: s.name})
+		s.reconcile(p.Services)
 	default:
 		return fmt.Errorf("slave not authenticated")
 	}
 	return nil
 }
 
+// verifyCredentials checks the client certificate of the connection if one was
+// presented. Otherwise the secret key must match the token of the slave, or the
+// shared secret key if no token was issued for the slave.
+func (s *slave) verifyCredentials(p *protocol.PacketAuthenticate) error {
+	c := s.m.sm.credentials[p.SlaveName]
+	if c != nil && c.Revoked {
+		return fmt.Errorf("slave %q has been revoked", p.SlaveName)
+	}
+	if tlsConn, ok := s.conn.(*tls.Conn); ok {
+		certs := tlsConn.ConnectionState().PeerCertificates
+		if len(certs) > 0 {
+			if certs[0].Subject.CommonName != p.SlaveName {
+				return fmt.Errorf("certificate was not issued for slave %q", p.SlaveName)
+			}
+			return nil
+		}
+	}
+	if c != nil {
+		if !c.verify(p.SecretKey) {
+			return fmt.Errorf("invalid token for slave %q", p.SlaveName)
+		}
+		return nil
+	}
+	if subtle.ConstantTimeCompare([]byte(p.SecretKey), []byte(s.m.cfg.SecretKey)) != 1 {
+		return fmt.Errorf("invalid secret key")
+	}
+	return nil
+}
+
 func (s *slave) handlePacket(p proto.Message) error {
 	if !s.authenticated {
 		return s.handlePacketPreAuth(p)
 	}
 
+	// packets about services are ignored unless the service runs on this slave,
+	// a stopped instance of a lost service may share its name with a new one
 	switch p := p.(type) {
 	case *protocol.PacketServiceStartFailed:
 		log.Printf("slave %q failed to start service %q: %s", s.name, p.ServiceName, p.Message)
 		svc := s.m.sched.getService(p.ServiceName)
-		if svc != nil {
+		if svc != nil && svc.s == s {
 			svc.State = protocol.Service_STATE_OFFLINE
 			err := s.m.sched.deleteService(svc)
 			if err != nil {
 				log.Printf("failed to delete service %q: %v", svc.Service.Name, err)
 			}
+			s.m.events.emitService(eventServiceStartFailed, svc, p.Message)
+			s.m.gm.recordFailure(svc.g, p.Message)
+			s.m.metrics.startFailures.WithLabelValues(svc.Group).Inc()
 		}
 	case *protocol.PacketServiceStopped:
 		log.Printf("service %q on slave %q stopped", p.ServiceName, s.name)
 		svc := s.m.sched.getService(p.ServiceName)
-		if svc != nil {
+		if svc != nil && svc.s == s {
+			if svc.State == protocol.Service_STATE_SCHEDULED {
+				s.m.gm.recordFailure(svc.g, fmt.Sprintf("service %q stopped before it came online", svc.Name))
+			}
 			svc.State = protocol.Service_STATE_OFFLINE
 			svc.Port = 0
+			s.m.sched.abortDrain(svc, nil)
 			err := s.m.sched.deleteService(svc)
 			if err != nil {
 				fmt.Printf("failed to delete service %q: %v", svc.Service.Name, err)
 			}
-			if svc.Type == protocol.Service_TYPE_SERVER {
-				for _, prx := range s.m.sched.services {
-					if prx.Type != protocol.Service_TYPE_PROXY || prx.s == nil || prx.State != protocol.Service_STATE_ONLINE {
-						continue
-					}
-					err = prx.sendPacket(&protocol.PacketProxyUnregisterServer{
-						ServerName: svc.Name,
-					})
-					if err != nil {
-						log.Printf("failed to send proxy unregister server packet: %v", err)
-					}
-				}
-			}
+			s.m.sched.unregisterFromProxies(svc)
+			s.m.events.emitService(eventServiceStopped, svc, "")
 		}
 	case *protocol.PacketServiceOnline:
 		svc := s.m.sched.getService(p.ServiceName)
-		if svc != nil {
+		if svc != nil && svc.s == s {
 			svc.State = protocol.Service_STATE_ONLINE
 			svc.Port = p.Port
+			svc.Players = 0
+			svc.emptySince = time.Now()
+			s.m.gm.resetFailures(svc.g)
+			if svc.Warm {
+				log.Printf("warm service %q on slave %q is ready", p.ServiceName, s.name)
+				s.m.events.emitService(eventServiceWarm, svc, "")
+				return nil
+			}
 			log.Printf("service %q on slave %q is now online", p.ServiceName, s.name)
-			if svc.Type == protocol.Service_TYPE_PROXY {
-				for _, srv := range s.m.sched.services {
-					if srv.Type != protocol.Service_TYPE_SERVER || srv.s == nil || srv.State != protocol.Service_STATE_ONLINE {
-						continue
-					}
-					err := svc.sendPacket(&protocol.PacketProxyRegisterServer{
-						ServerName: srv.Name,
-						Host:       srv.s.host,
-						Port:       srv.Port,
-					})
-					if err != nil {
-						log.Printf("failed to send proxy register server packet: %v", err)
-					}
-				}
-			} else if svc.Type == protocol.Service_TYPE_SERVER {
-				for _, prx := range s.m.sched.services {
-					if prx.Type != protocol.Service_TYPE_PROXY || prx.s == nil || prx.State != protocol.Service_STATE_ONLINE {
-						continue
-					}
-					err := prx.sendPacket(&protocol.PacketProxyRegisterServer{
-						ServerName: svc.Name,
-						Host:       svc.s.host,
-						Port:       svc.Port,
-					})
-					if err != nil {
-						log.Printf("failed to send proxy register server packet: %v", err)
-					}
-				}
+			s.m.metrics.observeServiceOnline(svc)
+			s.m.sched.registerWithProxies(svc)
+			s.m.events.emitService(eventServiceOnline, svc, "")
+		}
+	case *protocol.PacketServicePlayerCount:
+		svc := s.m.sched.getService(p.ServiceName)
+		if svc != nil && svc.s == s {
+			if p.Players == 0 && svc.emptySince.IsZero() {
+				svc.emptySince = time.Now()
+			} else if p.Players > 0 {
+				svc.emptySince = time.Time{}
 			}
+			svc.Players = p.Players
+			svc.drainCounted = true
+		}
+	case *protocol.PacketSlaveMemoryUsage:
+		s.usedMemory = p.UsedMemory
+		for _, svc := range s.services() {
+			svc.usedMemory = p.Services[svc.Name]
 		}
+	case *protocol.PacketReply:
+		s.m.sm.requests.resolve(s, p)
+	case *protocol.PacketServiceSaveChunk:
+		s.m.tmpl.handleSaveChunk(s, p)
+	case *protocol.PacketPong:
+		// the read deadline of the connection was already extended
 	case *protocol.PacketScreenLine:
 		if s.m.sc.svc == nil {
 			return nil
@@ -154,12 +235,31 @@ func (s *slave) handlePacket(p proto.Message) error {
 }
 
 func (s *slave) schedule(svc *service) {
-	_ = s.sendPacket(&protocol.PacketScheduleServiceRequest{
+	s.sendRequest(&protocol.PacketScheduleServiceRequest{
 		Service: svc.Service,
 		Group:   svc.g.Group,
+	}, func(err error) {
+		// start failures are reported with PacketServiceStartFailed
+		if errors.Is(err, errRequestTimeout) {
+			log.Printf("slave %q did not confirm scheduling service %q in time", s.name, svc.Name)
+		}
 	})
 }
 
+// sendHeartbeats pings all authenticated slaves. Slaves that do not answer
+// within the heartbeat timeout are disconnected by the read deadline.
+func (sm *slaveManager) sendHeartbeats() {
+	for _, slv := range sm.slaves {
+		if !slv.authenticated || !slv.caps[protocol.CapabilityHeartbeat] {
+			continue
+		}
+		err := slv.sendPacket(&protocol.PacketPing{})
+		if err != nil {
+			log.Printf("failed to ping slave %q: %v", slv.name, err)
+		}
+	}
+}
+
 func (sm *slaveManager) removeSlave(slv *slave) {
 	if slv.authenticated {
 		log.Printf("slave %q disconnected", slv.name)
@@ -167,15 +267,148 @@ func (sm *slaveManager) removeSlave(slv *slave) {
 		log.Printf("authentication with slave %q failed", slv.conn.RemoteAddr())
 	}
 	sm.slaves = common.DeleteItem(sm.slaves, slv)
+	sm.requests.failSlave(slv)
+	sm.m.tmpl.failSlaveSaves(slv)
+	if slv.authenticated {
+		sm.register(slv)
+		sm.m.events.emit(event{Type: eventSlaveDisconnected, Slave: slv.name})
+	}
 	for _, svc := range slv.services() {
+		if sm.m.sc.svc == svc {
+			err := sm.m.sc.detach()
+			if err != nil {
+				log.Printf("failed to detach screen: %v", err)
+			}
+		}
+		slv.releaseMemory(svc)
 		svc.s = nil
-		svc.Port = 0
-		svc.State = protocol.Service_STATE_OFFLINE
-		err := slv.m.sched.deleteService(svc)
+		svc.lostAt = time.Now()
+	}
+	if slv.authenticated {
+		log.Printf("keeping services of slave %q for %ds in case it reconnects", slv.name, sm.m.cfg.SlaveReconnectTimeout)
+	}
+}
+
+// register records the slave as known to the master.
+func (sm *slaveManager) register(slv *slave) {
+	sm.registrations[slv.name] = &slaveRecord{
+		Name:     slv.name,
+		Host:     slv.host,
+		Memory:   slv.memory,
+		Labels:   slv.labels,
+		LastSeen: time.Now(),
+	}
+}
+
+// reconcile compares the services reported by a (re)connecting slave with the
+// services the master knows about. Services that were lost when the slave
+// disconnected are adopted again, unknown services are adopted if their group
+// still has room for them, and all remaining services are stopped. Lost
+// services the slave did not report anymore have stopped in the meantime.
+func (s *slave) reconcile(reported []*protocol.Service) {
+	seen := make(map[string]bool)
+	for _, rs := range reported {
+		seen[rs.Name] = true
+		svc := s.m.sched.getService(rs.Name)
+		g := s.m.gm.getGroup(rs.Group)
+		if svc == nil && g != nil && rs.State != protocol.Service_STATE_STOPPING &&
+			int32(len(s.m.gm.services(g))) < g.MaxServices+g.WarmServices {
+			rs.Slave = s.name
+			svc = &service{Service: rs, g: g, lostAt: time.Now()}
+			s.m.sched.services = append(s.m.sched.services, svc)
+		}
+		if svc == nil || !svc.lost() || svc.Slave != s.name || svc.g != g {
+			log.Printf("stopping unwanted service %q on slave %q", rs.Name, s.name)
+			err := s.sendPacket(&protocol.PacketStopService{ServiceName: rs.Name})
+			if err != nil {
+				log.Printf("failed to stop service %q: %v", rs.Name, err)
+			}
+			continue
+		}
+		svc.s = s
+		svc.lostAt = time.Time{}
+		svc.State = rs.State
+		svc.Port = rs.Port
+		svc.Players = rs.Players
+		if svc.Players == 0 {
+			svc.emptySince = time.Now()
+		}
+		s.reserveMemory(svc)
+		log.Printf("adopted service %q on slave %q", svc.Name, s.name)
+		if svc.draining {
+			// the slave reconnected while the service was draining
+			svc.State = protocol.Service_STATE_STOPPING
+			s.m.sched.finishDrain(svc)
+		} else if svc.State == protocol.Service_STATE_ONLINE && !svc.Warm {
+			s.m.sched.registerWithProxies(svc)
+		}
+	}
+	for _, svc := range slices.Clone(s.m.sched.services) {
+		if svc.lost() && svc.Slave == s.name && !seen[svc.Name] {
+			log.Printf("service %q stopped while slave %q was disconnected", svc.Name, s.name)
+			s.m.sched.removeLostService(svc)
+		}
+	}
+}
+
+// reserveMemory subtracts the memory of the service's group from the free memory
+// of the slave until it is released again.
+func (s *slave) reserveMemory(svc *service) {
+	svc.Memory = svc.g.Memory
+	s.freeMemory -= svc.Memory
+}
+
+func (s *slave) releaseMemory(svc *service) {
+	s.freeMemory += svc.Memory
+	svc.Memory = 0
+	svc.usedMemory = 0
+}
+
+// drain stops scheduling new services on the slave and stops all of its
+// services, so they are scheduled on other slaves.
+func (s *slave) drain() error {
+	s.draining = true
+	log.Printf("draining slave %q", s.name)
+	var errs []error
+	for _, svc := range s.services() {
+		if svc.State == protocol.Service_STATE_STOPPING {
+			continue
+		}
+		err := s.m.sched.stopService(svc)
 		if err != nil {
-			log.Printf("failed to delete service %q: %v", svc.Service.Name, err)
+			errs = append(errs, err)
 		}
 	}
+	return errors.Join(errs...)
+}
+
+func (s *slave) hasLabels(labels map[string]string) bool {
+	for k, v := range labels {
+		if s.labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *slave) info() slaveInfo {
+	info := slaveInfo{
+		Name:           s.name,
+		Host:           s.host,
+		Labels:         s.labels,
+		Draining:       s.draining,
+		Memory:         s.memory,
+		ReservedMemory: s.memory - s.freeMemory,
+		UsedMemory:     s.usedMemory,
+	}
+	for _, svc := range s.services() {
+		info.Services = append(info.Services, serviceMemoryInfo{
+			Name:           svc.Name,
+			ReservedMemory: svc.Memory,
+			UsedMemory:     svc.usedMemory,
+		})
+	}
+	return info
 }
 
 func (sm *slaveManager) getSlave(name string) *slave {
@@ -187,7 +420,7 @@ func (sm *slaveManager) getSlave(name string) *slave {
 	return nil
 }
 
-func handleSlaveConnection(ch chan<- any, lis net.Listener) {
+func handleSlaveConnection(ch chan<- any, lis net.Listener, cfg *config) {
 	defer recoverPanic()
 
 	for {
@@ -202,8 +435,12 @@ func handleSlaveConnection(ch chan<- any, lis net.Listener) {
 		slv := <-slvCh
 		go func() {
 			defer recoverPanic()
+			opts := protocol.ReadOptions{
+				MaxFrameSize: uint32(cfg.MaxPreAuthFrameSize),
+				Timeout:      time.Duration(cfg.HeartbeatTimeout) * time.Second,
+			}
 			for {
-				p, err := protocol.ReadPacket(conn)
+				p, err := protocol.ReadPacketWithOptions(conn, opts)
 				if err != nil {
 					log.Printf("failed reading packet: %v", err)
 					break
@@ -215,6 +452,13 @@ func handleSlaveConnection(ch chan<- any, lis net.Listener) {
 					log.Printf("failed handling packet: %v", err)
 					break
 				}
+				if p, ok := p.(*protocol.PacketAuthenticate); ok {
+					// the slave is authenticated, otherwise handling the packet failed
+					opts.MaxFrameSize = uint32(cfg.MaxFrameSize)
+					if !slices.Contains(p.Capabilities, protocol.CapabilityHeartbeat) {
+						opts.Timeout = 0
+					}
+				}
 			}
 			_ = conn.Close()
 			ch <- removeSlaveCmd{slv}
@@ -233,8 +477,14 @@ func (s *slave) services() []*service {
 }
 
 func (s *slave) sendPacket(p proto.Message) error {
+	return s.sendPacketWithRequestId(p, 0)
+}
+
+func (s *slave) sendPacketWithRequestId(p proto.Message, requestId uint64) error {
 	if s.conn == nil {
 		return fmt.Errorf("not connected")
 	}
-	return protocol.SendPacket(s.conn, p)
+	// a slave that stopped reading must not block the command queue
+	_ = s.conn.SetWriteDeadline(time.Now().Add(time.Duration(s.m.cfg.HeartbeatTimeout) * time.Second))
+	return protocol.SendRequest(s.conn, p, requestId)
 }
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"protocol"
+	"time"
+)
+
+var (
+	servicesBucket    = []byte("services")
+	slavesBucket      = []byte("slaves")
+	countersBucket    = []byte("counters")
+	credentialsBucket = []byte("credentials")
+)
+
+// store persists the state of the master in an embedded bolt database, so it
+// survives restarts of the master.
+type store struct {
+	db *bbolt.DB
+	// written caches the last value written for every key, so unchanged
+	// entries are not written again.
+	written map[string]map[string][]byte
+}
+
+type slaveRecord struct {
+	Name     string            `json:"name"`
+	Host     string            `json:"host"`
+	Memory   int32             `json:"memory"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	LastSeen time.Time         `json:"last_seen"`
+}
+
+func openStore(file string) (*store, error) {
+	db, err := bbolt.Open(file, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open state database: %w", err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, b := range [][]byte{servicesBucket, slavesBucket, countersBucket, credentialsBucket} {
+			_, err := tx.CreateBucketIfNotExists(b)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create buckets: %w", err)
+	}
+	return &store{db: db, written: make(map[string]map[string][]byte)}, nil
+}
+
+func (st *store) close() error {
+	return st.db.Close()
+}
+
+func (st *store) load(bucket []byte) (map[string][]byte, error) {
+	values := make(map[string][]byte)
+	err := st.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucket).ForEach(func(k, v []byte) error {
+			values[string(k)] = bytes.Clone(v)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bucket %q: %w", bucket, err)
+	}
+	st.written[string(bucket)] = values
+	return values, nil
+}
+
+// sync makes the bucket contain exactly the given values.
+func (st *store) sync(bucket []byte, values map[string][]byte) error {
+	written := st.written[string(bucket)]
+	changed := len(written) != len(values)
+	for k, v := range values {
+		if !bytes.Equal(written[k], v) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+	err := st.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(bucket)
+		for k := range written {
+			if _, exists := values[k]; !exists {
+				err := b.Delete([]byte(k))
+				if err != nil {
+					return err
+				}
+			}
+		}
+		for k, v := range values {
+			if bytes.Equal(written[k], v) {
+				continue
+			}
+			err := b.Put([]byte(k), v)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write bucket %q: %w", bucket, err)
+	}
+	st.written[string(bucket)] = values
+	return nil
+}
+
+// nextSequence increments the named counter and returns its new value.
+func (st *store) nextSequence(name string) (uint64, error) {
+	var seq uint64
+	err := st.db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.Bucket(countersBucket).CreateBucketIfNotExists([]byte(name))
+		if err != nil {
+			return err
+		}
+		seq, err = b.NextSequence()
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment counter %q: %w", name, err)
+	}
+	return seq, nil
+}
+
+// restoreState loads the services and slave registrations of the previous run.
+// Services that were running on a slave are marked as lost until their slave
+// reconnects and reports them again.
+func (m *master) restoreState() error {
+	boot, err := m.store.nextSequence("boot")
+	if err != nil {
+		return err
+	}
+
+	err = m.restoreCredentials()
+	if err != nil {
+		return err
+	}
+
+	slaves, err := m.store.load(slavesBucket)
+	if err != nil {
+		return err
+	}
+	for name, b := range slaves {
+		var rec slaveRecord
+		err = json.Unmarshal(b, &rec)
+		if err != nil {
+			return fmt.Errorf("invalid record for slave %q: %w", name, err)
+		}
+		m.sm.registrations[name] = &rec
+	}
+
+	services, err := m.store.load(servicesBucket)
+	if err != nil {
+		return err
+	}
+	for name, b := range services {
+		svc := &service{Service: &protocol.Service{}}
+		err = proto.Unmarshal(b, svc.Service)
+		if err != nil {
+			return fmt.Errorf("invalid record for service %q: %w", name, err)
+		}
+		svc.g = m.gm.getGroup(svc.Group)
+		if svc.g == nil {
+			log.Printf("dropping service %q of unknown group %q", svc.Name, svc.Group)
+			continue
+		}
+		svc.Memory = 0
+		switch svc.State {
+		case protocol.Service_STATE_PENDING:
+		case protocol.Service_STATE_SCHEDULED, protocol.Service_STATE_ONLINE, protocol.Service_STATE_STOPPING:
+			svc.lostAt = time.Now()
+		default:
+			continue
+		}
+		m.sched.services = append(m.sched.services, svc)
+	}
+
+	if boot > 1 {
+		log.Printf("restored %d services and %d slave registrations (boot #%d)", len(m.sched.services), len(m.sm.registrations), boot)
+	}
+	return nil
+}
+
+// persistState writes the current services, slave registrations and slave
+// credentials to the store.
+func (m *master) persistState() error {
+	services := make(map[string][]byte)
+	for _, svc := range m.sched.services {
+		// map entries are marshalled in random order otherwise, which defeats
+		// the write cache of the store
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(svc.Service)
+		if err != nil {
+			return fmt.Errorf("failed to marshal service %q: %w", svc.Name, err)
+		}
+		services[svc.Name] = b
+	}
+	err := m.store.sync(servicesBucket, services)
+	if err != nil {
+		return err
+	}
+
+	slaves := make(map[string][]byte)
+	for name, rec := range m.sm.registrations {
+		b, err := json.Marshal(rec)
+		if err != nil {
+			return fmt.Errorf("failed to marshal slave %q: %w", name, err)
+		}
+		slaves[name] = b
+	}
+	err = m.store.sync(slavesBucket, slaves)
+	if err != nil {
+		return err
+	}
+	return m.persistCredentials()
+}
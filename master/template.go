@@ -3,13 +3,20 @@ package main
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
+	"github.com/goccy/go-yaml"
 	"github.com/gokrazy/rsync/rsyncd"
 	"io"
+	"io/fs"
 	"log"
-	"net"
+	"math/rand/v2"
 	"os"
 	"path"
+	"path/filepath"
+	"protocol"
+	"slices"
+	"strings"
 )
 
 var (
@@ -24,12 +31,33 @@ var (
 type templateManager struct {
 	m           *master
 	templateDir string
+	// saveDir holds the archives and directories of running saves. It lives
+	// next to the template directory, so saved templates can be renamed into
+	// place.
+	saveDir    string
+	saves      map[uint64]*templateSave
+	nextSaveId uint64
+	// fingerprints caches the content hashes of the templates by name.
+	fingerprints map[string]templateFingerprint
+	// versions indexes the versions of all templates, so the command queue
+	// does not read them from disk.
+	versions map[string][]templateVersionInfo
+	// refreshing holds the templates that are being versioned for new
+	// services.
+	refreshing map[string]bool
+	worker     versionWorker
 }
 
 func newTemplateManager(m *master) (*templateManager, error) {
 	tmpl := &templateManager{
-		m:           m,
-		templateDir: "templates",
+		m:            m,
+		templateDir:  "templates",
+		saveDir:      "template_saves",
+		saves:        make(map[uint64]*templateSave),
+		fingerprints: make(map[string]templateFingerprint),
+		versions:     make(map[string][]templateVersionInfo),
+		refreshing:   make(map[string]bool),
+		worker:       versionWorker{wake: make(chan struct{}, 1)},
 	}
 	err := tmpl.init()
 	if err != nil {
@@ -43,6 +71,14 @@ func (tmpl *templateManager) init() error {
 	if err != nil {
 		return fmt.Errorf("failed to create template directory: %w", err)
 	}
+	err = os.RemoveAll(tmpl.saveDir)
+	if err != nil {
+		return fmt.Errorf("failed to remove save directory: %w", err)
+	}
+	err = os.MkdirAll(tmpl.saveDir, 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create save directory: %w", err)
+	}
 	templates := []string{"global_all/plugins", "global_proxy/plugins", "global_server/plugins"}
 	for _, g := range tmpl.m.gm.groups {
 		templates = append(templates, g.Name)
@@ -69,6 +105,10 @@ func (tmpl *templateManager) init() error {
 	if err != nil {
 		return fmt.Errorf("failed to write eula.txt: %w", err)
 	}
+	err = tmpl.loadVersionIndex()
+	if err != nil {
+		return fmt.Errorf("failed to load template versions: %w", err)
+	}
 	return nil
 }
 
@@ -80,6 +120,188 @@ func (tmpl *templateManager) createTemplateDir(name string) error {
 	return nil
 }
 
+// templateInfo is read from an optional <name>.yaml file next to the template
+// directory. It lives outside the directory, so it is neither copied into
+// services nor lost when a service is saved into the template.
+type templateInfo struct {
+	// Parents are applied before the template, in order.
+	Parents []string `json:"parents"`
+}
+
+func (tmpl *templateManager) readTemplateInfo(name string) (*templateInfo, error) {
+	info := &templateInfo{}
+	b, err := os.ReadFile(path.Join(tmpl.templateDir, name+".yaml"))
+	if errors.Is(err, os.ErrNotExist) {
+		return info, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read template file: %w", err)
+	}
+	err = yaml.Unmarshal(b, info)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse template file of %q: %w", name, err)
+	}
+	return info, nil
+}
+
+// serviceTemplates returns references to the template versions to apply to a
+// new service of the group in order, along with their content hashes. The
+// random template of the group is picked here, so each service may get a
+// different one. errVersionPending is returned while a template has no version
+// yet.
+func (tmpl *templateManager) serviceTemplates(g *group) ([]string, map[string]string, error) {
+	names := []string{"global_all"}
+	switch g.Type {
+	case protocol.Service_TYPE_PROXY:
+		names = append(names, "global_proxy")
+	case protocol.Service_TYPE_SERVER:
+		names = append(names, "global_server")
+	}
+	if len(g.Templates) > 0 {
+		names = append(names, g.Templates...)
+	} else {
+		names = append(names, g.Name)
+	}
+	if len(g.RandomTemplates) > 0 {
+		names = append(names, pickWeightedTemplate(g.RandomTemplates))
+	}
+	names, err := tmpl.resolveTemplates(names)
+	if err != nil {
+		return nil, nil, err
+	}
+	refs := make([]string, len(names))
+	hashes := make(map[string]string, len(names))
+	var pending error
+	for i, name := range names {
+		v, err := tmpl.serviceVersion(name, g.TemplateVersions[name])
+		if errors.Is(err, errVersionPending) {
+			// request the versions of the other templates as well
+			pending = err
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		refs[i] = protocol.TemplateRef(name, v.Version)
+		hashes[refs[i]] = v.Hash
+	}
+	if pending != nil {
+		return nil, nil, pending
+	}
+	return refs, hashes, nil
+}
+
+func pickWeightedTemplate(templates []*protocol.WeightedTemplate) string {
+	var total int32
+	for _, t := range templates {
+		total += t.Weight
+	}
+	n := rand.Int32N(total)
+	for _, t := range templates {
+		n -= t.Weight
+		if n < 0 {
+			return t.Name
+		}
+	}
+	return templates[len(templates)-1].Name
+}
+
+// resolveTemplates expands the parents of the templates, so every template is
+// preceded by its parents. Templates that are reached more than once are only
+// applied at their first position.
+func (tmpl *templateManager) resolveTemplates(names []string) ([]string, error) {
+	var resolved []string
+	done := make(map[string]bool)
+	var visit func(name string, chain []string) error
+	visit = func(name string, chain []string) error {
+		if done[name] {
+			return nil
+		}
+		if slices.Contains(chain, name) {
+			return fmt.Errorf("template cycle: %s", strings.Join(append(chain, name), " -> "))
+		}
+		err := protocol.ValidateTemplateName(name)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stat(path.Join(tmpl.templateDir, name))
+		if err != nil {
+			return fmt.Errorf("template %q does not exist", name)
+		}
+		info, err := tmpl.readTemplateInfo(name)
+		if err != nil {
+			return err
+		}
+		chain = append(chain, name)
+		for _, parent := range info.Parents {
+			err = visit(parent, chain)
+			if err != nil {
+				return err
+			}
+		}
+		done[name] = true
+		resolved = append(resolved, name)
+		return nil
+	}
+	for _, name := range names {
+		err := visit(name, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+type templateFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+func (tmpl *templateManager) listTemplates() ([]string, error) {
+	entries, err := os.ReadDir(tmpl.templateDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template directory: %w", err)
+	}
+	var templates []string
+	for _, e := range entries {
+		if e.IsDir() && !strings.HasPrefix(e.Name(), ".") {
+			templates = append(templates, e.Name())
+		}
+	}
+	return templates, nil
+}
+
+func (tmpl *templateManager) listFiles(name string) ([]templateFile, error) {
+	err := protocol.ValidateTemplateName(name)
+	if err != nil {
+		return nil, err
+	}
+	root := path.Join(tmpl.templateDir, name)
+	files := []templateFile{}
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, templateFile{Path: filepath.ToSlash(rel), Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template files: %w", err)
+	}
+	return files, nil
+}
+
 func (tmpl *templateManager) startFileServer() error {
 	srv, err := rsyncd.NewServer([]rsyncd.Module{
 		{
@@ -91,7 +313,7 @@ func (tmpl *templateManager) startFileServer() error {
 		return fmt.Errorf("failed to create rsync server: %w", err)
 	}
 
-	lis, err := net.Listen("tcp", tmpl.m.cfg.FileServerBindAddr)
+	lis, err := tmpl.m.listen(tmpl.m.cfg.FileServerBindAddr)
 	if err != nil {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
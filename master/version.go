@@ -0,0 +1,600 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"protocol"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// templateVersion is an immutable snapshot of a template. The files of version
+// n of a template are stored in templates/.versions/<template>/<n>, its
+// metadata in <n>.json next to it.
+type templateVersion struct {
+	Version   int       `json:"version"`
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+	Message   string    `json:"message,omitempty"`
+	// Files maps the slash separated path of every file to its sha256 hash.
+	Files map[string]string `json:"files"`
+}
+
+type templateVersionInfo struct {
+	Version   int       `json:"version"`
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+	Message   string    `json:"message,omitempty"`
+	Files     int       `json:"files"`
+}
+
+// errVersionPending is returned while the first version of a template is
+// being created.
+var errVersionPending = errors.New("template is being versioned")
+
+type templateChange struct {
+	Path   string `json:"path"`
+	Change string `json:"change"`
+}
+
+// templateFingerprint caches the content hash of a template directory. The
+// fingerprint only covers the names, sizes and modification times of the
+// files, so unchanged templates are not read again.
+type templateFingerprint struct {
+	fingerprint string
+	hash        string
+}
+
+func (v *templateVersion) info() templateVersionInfo {
+	return templateVersionInfo{
+		Version:   v.Version,
+		Hash:      v.Hash,
+		CreatedAt: v.CreatedAt,
+		Message:   v.Message,
+		Files:     len(v.Files),
+	}
+}
+
+func (tmpl *templateManager) versionDir(name string) string {
+	return path.Join(tmpl.templateDir, protocol.TemplateVersionsDir, name)
+}
+
+func (tmpl *templateManager) listVersions(name string) ([]*templateVersion, error) {
+	err := protocol.ValidateTemplateName(name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(tmpl.versionDir(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read versions: %w", err)
+	}
+	var versions []*templateVersion
+	for _, e := range entries {
+		n, found := strings.CutSuffix(e.Name(), ".json")
+		if e.IsDir() || !found {
+			continue
+		}
+		version, err := strconv.Atoi(n)
+		if err != nil {
+			continue
+		}
+		v, err := tmpl.getVersion(name, version)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	slices.SortFunc(versions, func(a, b *templateVersion) int {
+		return a.Version - b.Version
+	})
+	return versions, nil
+}
+
+func (tmpl *templateManager) getVersion(name string, version int) (*templateVersion, error) {
+	err := protocol.ValidateTemplateName(name)
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path.Join(tmpl.versionDir(name), fmt.Sprintf("%d.json", version)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("template %q has no version %d", name, version)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version %d of template %q: %w", version, name, err)
+	}
+	var v templateVersion
+	err = json.Unmarshal(b, &v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %d of template %q: %w", version, name, err)
+	}
+	return &v, nil
+}
+
+// latestVersion reads the newest version of the template from disk. Only the
+// version worker uses it, the command queue uses the version index.
+func (tmpl *templateManager) latestVersion(name string) (*templateVersion, error) {
+	entries, err := os.ReadDir(tmpl.versionDir(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read versions: %w", err)
+	}
+	latest := 0
+	for _, e := range entries {
+		n, found := strings.CutSuffix(e.Name(), ".json")
+		if e.IsDir() || !found {
+			continue
+		}
+		version, err := strconv.Atoi(n)
+		if err == nil && version > latest {
+			latest = version
+		}
+	}
+	if latest == 0 {
+		return nil, nil
+	}
+	return tmpl.getVersion(name, latest)
+}
+
+// loadVersionIndex reads the versions of all templates into the version index.
+func (tmpl *templateManager) loadVersionIndex() error {
+	names, err := tmpl.listTemplates()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if protocol.ValidateTemplateName(name) != nil {
+			continue
+		}
+		versions, err := tmpl.listVersions(name)
+		if err != nil {
+			return err
+		}
+		for _, v := range versions {
+			tmpl.versions[name] = append(tmpl.versions[name], v.info())
+		}
+	}
+	return nil
+}
+
+// versionInfos returns the versions of the template from the version index.
+func (tmpl *templateManager) versionInfos(name string) ([]templateVersionInfo, error) {
+	err := protocol.ValidateTemplateName(name)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(tmpl.versions[name]), nil
+}
+
+func (tmpl *templateManager) versionInfo(name string, version int) (templateVersionInfo, bool) {
+	for _, v := range tmpl.versions[name] {
+		if v.Version == version {
+			return v, true
+		}
+	}
+	return templateVersionInfo{}, false
+}
+
+// snapshot creates a new version of the template if its content changed since
+// the latest version and returns the latest version. It runs on the version
+// worker, cached is the fingerprint the command queue knew when the job was
+// submitted.
+func (tmpl *templateManager) snapshot(name, message string, cached templateFingerprint) (*templateVersion, templateFingerprint, bool, error) {
+	latest, err := tmpl.latestVersion(name)
+	if err != nil {
+		return nil, cached, false, err
+	}
+	dir := path.Join(tmpl.templateDir, name)
+	fp, err := fingerprintDir(dir)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to fingerprint template %q: %w", name, err)
+	}
+	if latest != nil && cached.fingerprint == fp && cached.hash == latest.Hash {
+		return latest, cached, false, nil
+	}
+
+	// the copy is hashed, so the version matches its hash even if the
+	// template is changed while it is copied
+	tmp, err := os.MkdirTemp(tmpl.saveDir, "version-")
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to create directory: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+	err = copyDir(tmp, dir)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to copy template %q: %w", name, err)
+	}
+	files, err := hashFiles(tmp)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to hash template %q: %w", name, err)
+	}
+	hash := contentHash(files)
+	cached = templateFingerprint{fingerprint: fp, hash: hash}
+	if latest != nil && latest.Hash == hash {
+		return latest, cached, false, nil
+	}
+
+	v := &templateVersion{
+		Version:   1,
+		Hash:      hash,
+		CreatedAt: time.Now(),
+		Message:   message,
+		Files:     files,
+	}
+	if latest != nil {
+		v.Version = latest.Version + 1
+	}
+	target := path.Join(tmpl.versionDir(name), strconv.Itoa(v.Version))
+	err = os.MkdirAll(tmpl.versionDir(name), 0755)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to create directory: %w", err)
+	}
+	// a directory without metadata is left over from an interrupted snapshot
+	err = os.RemoveAll(target)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to remove incomplete version: %w", err)
+	}
+	err = os.Rename(tmp, target)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to move version: %w", err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to marshal version: %w", err)
+	}
+	err = os.WriteFile(target+".json", b, 0644)
+	if err != nil {
+		return nil, cached, false, fmt.Errorf("failed to write version: %w", err)
+	}
+	return v, cached, true, nil
+}
+
+// versionJob versions a template on the version worker. prepare optionally
+// changes the template first, done is called on the command queue. Jobs with
+// compare only compare versions and pass the changes to compared instead.
+type versionJob struct {
+	name     string
+	message  string
+	prepare  func() error
+	cached   templateFingerprint
+	done     func(v *templateVersion, err error)
+	compare  func() ([]templateChange, error)
+	compared func(changes []templateChange, err error)
+}
+
+// versionWorker runs version jobs one after another outside the command queue,
+// as copying and hashing large templates takes a while. Jobs are queued
+// without blocking, so the worker can always report back to the command queue.
+type versionWorker struct {
+	mu   sync.Mutex
+	jobs []*versionJob
+	wake chan struct{}
+}
+
+func (tmpl *templateManager) submitVersionJob(job *versionJob) {
+	job.cached = tmpl.fingerprints[job.name]
+	w := &tmpl.worker
+	w.mu.Lock()
+	w.jobs = append(w.jobs, job)
+	w.mu.Unlock()
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (w *versionWorker) next() *versionJob {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.jobs) == 0 {
+		return nil
+	}
+	job := w.jobs[0]
+	w.jobs = w.jobs[1:]
+	return job
+}
+
+func (tmpl *templateManager) runVersionWorker() {
+	defer recoverPanic()
+	for range tmpl.worker.wake {
+		for job := tmpl.worker.next(); job != nil; job = tmpl.worker.next() {
+			if job.compare != nil {
+				changes, err := job.compare()
+				tmpl.m.ch <- templateComparedCmd{job: job, changes: changes, err: err}
+				continue
+			}
+			cmd := templateVersionedCmd{job: job, fp: job.cached}
+			if job.prepare != nil {
+				cmd.err = job.prepare()
+			}
+			if cmd.err == nil {
+				cmd.v, cmd.fp, cmd.created, cmd.err = tmpl.snapshot(job.name, job.message, job.cached)
+			}
+			tmpl.m.ch <- cmd
+		}
+	}
+}
+
+// versioned records the outcome of a version job in the version index.
+func (tmpl *templateManager) versioned(cmd templateVersionedCmd) {
+	name := cmd.job.name
+	if cmd.err == nil {
+		tmpl.fingerprints[name] = cmd.fp
+		if cmd.created {
+			tmpl.versions[name] = append(tmpl.versions[name], cmd.v.info())
+			tmpl.versionCreated(name, cmd.v)
+		}
+	}
+	if cmd.job.done != nil {
+		cmd.job.done(cmd.v, cmd.err)
+	}
+}
+
+// refreshVersion versions changes of the template in the background, unless
+// that is already happening.
+func (tmpl *templateManager) refreshVersion(name string) {
+	if tmpl.refreshing[name] {
+		return
+	}
+	tmpl.refreshing[name] = true
+	tmpl.submitVersionJob(&versionJob{
+		name: name,
+		done: func(v *templateVersion, err error) {
+			delete(tmpl.refreshing, name)
+			if err != nil {
+				log.Printf("failed to create version of template %q: %v", name, err)
+			}
+		},
+	})
+}
+
+// serviceVersion returns the version of the template that new services use,
+// either the pinned or the latest version. Changes on disk are versioned in
+// the background and used by later services. errVersionPending is returned
+// until the first version of the template exists.
+func (tmpl *templateManager) serviceVersion(name string, pinned int32) (templateVersionInfo, error) {
+	if pinned > 0 {
+		v, exists := tmpl.versionInfo(name, int(pinned))
+		if !exists {
+			return v, fmt.Errorf("template %q has no version %d", name, pinned)
+		}
+		return v, nil
+	}
+	tmpl.refreshVersion(name)
+	versions := tmpl.versions[name]
+	if len(versions) == 0 {
+		return templateVersionInfo{}, errVersionPending
+	}
+	return versions[len(versions)-1], nil
+}
+
+// rollback replaces the template with the content of an older version on the
+// version worker. The content is recorded as a new version.
+func (tmpl *templateManager) rollback(name string, version int, done func(v *templateVersion, err error)) error {
+	err := protocol.ValidateTemplateName(name)
+	if err != nil {
+		return err
+	}
+	if _, exists := tmpl.versionInfo(name, version); !exists {
+		return fmt.Errorf("template %q has no version %d", name, version)
+	}
+	tmpl.submitVersionJob(&versionJob{
+		name:    name,
+		message: fmt.Sprintf("rollback to version %d", version),
+		prepare: func() error {
+			return tmpl.restoreVersion(name, version)
+		},
+		done: done,
+	})
+	return nil
+}
+
+// restoreVersion replaces the template with the files of a version.
+func (tmpl *templateManager) restoreVersion(name string, version int) error {
+	tmp, err := os.MkdirTemp(tmpl.saveDir, "rollback-")
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	err = copyDir(tmp, path.Join(tmpl.versionDir(name), strconv.Itoa(version)))
+	if err != nil {
+		_ = os.RemoveAll(tmp)
+		return fmt.Errorf("failed to copy version: %w", err)
+	}
+	err = tmpl.replaceTemplate(name, tmp)
+	if err != nil {
+		_ = os.RemoveAll(tmp)
+		return err
+	}
+	return nil
+}
+
+// diff lists the files that differ between two versions of a template on the
+// version worker, as hashing the current content of a large template takes a
+// while. A to version of 0 compares with the current content of the template.
+// done is called on the command queue.
+func (tmpl *templateManager) diff(name string, from, to int, done func(changes []templateChange, err error)) error {
+	err := protocol.ValidateTemplateName(name)
+	if err != nil {
+		return err
+	}
+	if _, exists := tmpl.versionInfo(name, from); !exists {
+		return fmt.Errorf("template %q has no version %d", name, from)
+	}
+	if _, exists := tmpl.versionInfo(name, to); to > 0 && !exists {
+		return fmt.Errorf("template %q has no version %d", name, to)
+	}
+	tmpl.submitVersionJob(&versionJob{
+		name:     name,
+		compare:  func() ([]templateChange, error) { return tmpl.compareVersions(name, from, to) },
+		compared: done,
+	})
+	return nil
+}
+
+// compareVersions lists the files that differ between two versions of a
+// template. Only the version worker uses it.
+func (tmpl *templateManager) compareVersions(name string, from, to int) ([]templateChange, error) {
+	a, err := tmpl.getVersion(name, from)
+	if err != nil {
+		return nil, err
+	}
+	var files map[string]string
+	if to > 0 {
+		b, err := tmpl.getVersion(name, to)
+		if err != nil {
+			return nil, err
+		}
+		files = b.Files
+	} else {
+		files, err = hashFiles(path.Join(tmpl.templateDir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash template %q: %w", name, err)
+		}
+	}
+	changes := []templateChange{}
+	for p, hash := range files {
+		old, exists := a.Files[p]
+		if !exists {
+			changes = append(changes, templateChange{Path: p, Change: "added"})
+		} else if old != hash {
+			changes = append(changes, templateChange{Path: p, Change: "modified"})
+		}
+	}
+	for p := range a.Files {
+		if _, exists := files[p]; !exists {
+			changes = append(changes, templateChange{Path: p, Change: "removed"})
+		}
+	}
+	slices.SortFunc(changes, func(a, b templateChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return changes, nil
+}
+
+func fingerprintDir(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00%d\x00%d\n", p, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		hash, err := hashFile(p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func hashFile(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// contentHash combines the hashes of all files of a template into one.
+func contentHash(files map[string]string) string {
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	h := sha256.New()
+	for _, p := range paths {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\n", p, files[p])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// copyDir copies the directories and regular files of src into dst.
+func copyDir(dst, src string) error {
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		in, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = in.Close()
+		}()
+		return extractFile(in, target, info.Mode().Perm())
+	})
+}
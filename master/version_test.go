@@ -0,0 +1,194 @@
+package main
+
+import (
+	"os"
+	"path"
+	"slices"
+	"testing"
+)
+
+func newTestTemplateManager(t *testing.T) (*templateManager, chan any) {
+	t.Helper()
+	dir := t.TempDir()
+	ch := make(chan any, 16)
+	m := &master{events: newEventBus(), sm: &slaveManager{}, ch: ch}
+	tmpl := &templateManager{
+		m:            m,
+		templateDir:  path.Join(dir, "templates"),
+		saveDir:      path.Join(dir, "template_saves"),
+		fingerprints: make(map[string]templateFingerprint),
+		versions:     make(map[string][]templateVersionInfo),
+		refreshing:   make(map[string]bool),
+		worker:       versionWorker{wake: make(chan struct{}, 1)},
+	}
+	m.tmpl = tmpl
+	for _, d := range []string{tmpl.templateDir, tmpl.saveDir} {
+		err := os.MkdirAll(d, 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	go tmpl.runVersionWorker()
+	return tmpl, ch
+}
+
+func writeTemplateFile(t *testing.T, tmpl *templateManager, name, file, content string) {
+	t.Helper()
+	p := path.Join(tmpl.templateDir, name, file)
+	err := os.MkdirAll(path.Dir(p), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(p, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// runVersioned waits for the next finished version job and records it like
+// the command queue does.
+func runVersioned(t *testing.T, tmpl *templateManager, ch chan any) {
+	t.Helper()
+	cmd, ok := (<-ch).(templateVersionedCmd)
+	if !ok {
+		t.Fatalf("unexpected command %T", cmd)
+	}
+	tmpl.versioned(cmd)
+}
+
+func TestServiceVersionIsCreatedByWorker(t *testing.T) {
+	tmpl, ch := newTestTemplateManager(t)
+	writeTemplateFile(t, tmpl, "lobby", "server.properties", "motd=a")
+
+	_, err := tmpl.serviceVersion("lobby", 0)
+	if err != errVersionPending {
+		t.Fatalf("serviceVersion = %v, want errVersionPending", err)
+	}
+	// the version is only requested once while it is being created
+	_, _ = tmpl.serviceVersion("lobby", 0)
+	runVersioned(t, tmpl, ch)
+	select {
+	case cmd := <-ch:
+		t.Fatalf("unexpected second job %T", cmd)
+	default:
+	}
+
+	v, err := tmpl.serviceVersion("lobby", 0)
+	if err != nil {
+		t.Fatalf("serviceVersion: %v", err)
+	}
+	if v.Version != 1 || v.Files != 1 {
+		t.Fatalf("got version %d with %d files, want version 1 with 1 file", v.Version, v.Files)
+	}
+	runVersioned(t, tmpl, ch)
+
+	// changes are versioned in the background and used by later services
+	writeTemplateFile(t, tmpl, "lobby", "server.properties", "motd=b")
+	_, _ = tmpl.serviceVersion("lobby", 0)
+	runVersioned(t, tmpl, ch)
+	v, err = tmpl.serviceVersion("lobby", 0)
+	if err != nil {
+		t.Fatalf("serviceVersion: %v", err)
+	}
+	if v.Version != 2 {
+		t.Fatalf("got version %d, want 2", v.Version)
+	}
+	runVersioned(t, tmpl, ch)
+
+	pinned, err := tmpl.serviceVersion("lobby", 1)
+	if err != nil || pinned.Version != 1 {
+		t.Fatalf("pinned version = %d, %v, want 1", pinned.Version, err)
+	}
+	_, err = tmpl.serviceVersion("lobby", 3)
+	if err == nil {
+		t.Fatalf("missing pinned version did not fail")
+	}
+}
+
+func TestRollbackRunsOnWorker(t *testing.T) {
+	tmpl, ch := newTestTemplateManager(t)
+	writeTemplateFile(t, tmpl, "lobby", "a.txt", "1")
+	tmpl.refreshVersion("lobby")
+	runVersioned(t, tmpl, ch)
+	writeTemplateFile(t, tmpl, "lobby", "b.txt", "2")
+	tmpl.refreshVersion("lobby")
+	runVersioned(t, tmpl, ch)
+
+	err := tmpl.rollback("lobby", 5, nil)
+	if err == nil {
+		t.Fatalf("rollback to a missing version did not fail")
+	}
+	var rolledBack *templateVersion
+	err = tmpl.rollback("lobby", 1, func(v *templateVersion, err error) {
+		if err != nil {
+			t.Errorf("rollback failed: %v", err)
+		}
+		rolledBack = v
+	})
+	if err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	runVersioned(t, tmpl, ch)
+	if rolledBack == nil || rolledBack.Version != 3 {
+		t.Fatalf("rollback did not create version 3")
+	}
+	if _, err := os.Stat(path.Join(tmpl.templateDir, "lobby", "b.txt")); !os.IsNotExist(err) {
+		t.Fatalf("file of the newer version still exists")
+	}
+	infos, _ := tmpl.versionInfos("lobby")
+	if len(infos) != 3 || infos[2].Hash != infos[0].Hash {
+		t.Fatalf("version index does not match the rollback: %+v", infos)
+	}
+
+	// a new manager finds the versions on disk
+	tmpl.versions = make(map[string][]templateVersionInfo)
+	err = tmpl.loadVersionIndex()
+	if err != nil {
+		t.Fatalf("loadVersionIndex: %v", err)
+	}
+	if len(tmpl.versions["lobby"]) != 3 {
+		t.Fatalf("loaded %d versions, want 3", len(tmpl.versions["lobby"]))
+	}
+}
+
+func TestDiffRunsOnWorker(t *testing.T) {
+	tmpl, ch := newTestTemplateManager(t)
+	writeTemplateFile(t, tmpl, "lobby", "a.txt", "1")
+	writeTemplateFile(t, tmpl, "lobby", "b.txt", "2")
+	tmpl.refreshVersion("lobby")
+	runVersioned(t, tmpl, ch)
+
+	err := tmpl.diff("lobby", 1, 2, nil)
+	if err == nil {
+		t.Fatalf("diff with a missing version did not fail")
+	}
+	writeTemplateFile(t, tmpl, "lobby", "a.txt", "3")
+	writeTemplateFile(t, tmpl, "lobby", "c.txt", "4")
+	err = os.Remove(path.Join(tmpl.templateDir, "lobby", "b.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var changes []templateChange
+	err = tmpl.diff("lobby", 1, 0, func(c []templateChange, err error) {
+		if err != nil {
+			t.Errorf("diff failed: %v", err)
+		}
+		changes = c
+	})
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	cmd, ok := (<-ch).(templateComparedCmd)
+	if !ok {
+		t.Fatalf("unexpected command %T", cmd)
+	}
+	cmd.job.compared(cmd.changes, cmd.err)
+	want := []templateChange{
+		{Path: "a.txt", Change: "modified"},
+		{Path: "b.txt", Change: "removed"},
+		{Path: "c.txt", Change: "added"},
+	}
+	if !slices.Equal(changes, want) {
+		t.Fatalf("got changes %+v, want %+v", changes, want)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"protocol"
+)
+
+// templateWatcher looks for templates that were changed on disk. It runs
+// outside the command queue, as it reads the metadata of every template file,
+// and only sends the changed templates into the queue. A template is only
+// reported once it stayed the same for a whole check interval, so files that
+// are still being copied into it do not end up in a version.
+type templateWatcher struct {
+	tmpl *templateManager
+	ch   chan<- any
+	// pending holds the fingerprints of changed templates that are reported
+	// on the next check if they stay the same.
+	pending map[string]string
+	// reported holds the last reported fingerprint of every template.
+	reported map[string]string
+}
+
+func newTemplateWatcher(tmpl *templateManager, ch chan<- any) *templateWatcher {
+	return &templateWatcher{
+		tmpl:     tmpl,
+		ch:       ch,
+		pending:  make(map[string]string),
+		reported: make(map[string]string),
+	}
+}
+
+func (w *templateWatcher) check() {
+	// only the template directory of the manager is used, it never changes
+	names, err := w.tmpl.listTemplates()
+	if err != nil {
+		log.Printf("failed to check templates: %v", err)
+		return
+	}
+	exists := make(map[string]bool, len(names))
+	for _, name := range names {
+		exists[name] = true
+		fp, err := fingerprintDir(path.Join(w.tmpl.templateDir, name))
+		if err != nil {
+			log.Printf("failed to fingerprint template %q: %v", name, err)
+			continue
+		}
+		if w.reported[name] == fp {
+			delete(w.pending, name)
+			continue
+		}
+		if w.pending[name] != fp {
+			w.pending[name] = fp
+			continue
+		}
+		delete(w.pending, name)
+		w.reported[name] = fp
+		w.ch <- templateChangedCmd{name: name, fingerprint: fp}
+	}
+	for name := range w.reported {
+		if !exists[name] {
+			delete(w.reported, name)
+			delete(w.pending, name)
+		}
+	}
+}
+
+// templateChanged creates a new version of a template the watcher found to be
+// changed on disk, unless the change was already versioned.
+func (tmpl *templateManager) templateChanged(name, fingerprint string) {
+	if tmpl.fingerprints[name].fingerprint == fingerprint {
+		return
+	}
+	tmpl.submitVersionJob(&versionJob{
+		name:    name,
+		message: "changed on disk",
+		done: func(v *templateVersion, err error) {
+			if err != nil {
+				log.Printf("failed to create version of template %q: %v", name, err)
+			}
+		},
+	})
+}
+
+// versionCreated tells all slaves about a new version of a template, so they
+// can drop older versions from their cache and fetch the new one ahead of
+// time.
+func (tmpl *templateManager) versionCreated(name string, v *templateVersion) {
+	log.Printf("created version %d of template %q", v.Version, name)
+	tmpl.m.events.emit(event{
+		Type:    eventTemplateChanged,
+		Message: fmt.Sprintf("created version %d of template %q", v.Version, name),
+	})
+	for _, slv := range tmpl.m.sm.slaves {
+		if !slv.authenticated || !slv.caps[protocol.CapabilityTemplatePush] {
+			continue
+		}
+		err := slv.sendPacket(&protocol.PacketTemplateChanged{
+			Template: name,
+			Version:  int32(v.Version),
+			Hash:     v.Hash,
+		})
+		if err != nil {
+			log.Printf("failed to notify slave %q of template change: %v", slv.name, err)
+		}
+	}
+}
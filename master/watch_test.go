@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestTemplateWatcherReportsStableChanges(t *testing.T) {
+	tmpl, ch := newTestTemplateManager(t)
+	w := newTemplateWatcher(tmpl, ch)
+	changed := func() (templateChangedCmd, bool) {
+		select {
+		case cmd := <-ch:
+			return cmd.(templateChangedCmd), true
+		default:
+			return templateChangedCmd{}, false
+		}
+	}
+
+	writeTemplateFile(t, tmpl, "lobby", "server.properties", "motd=a")
+	w.check()
+	if _, ok := changed(); ok {
+		t.Fatalf("template was reported before it stayed the same for a check")
+	}
+	w.check()
+	cmd, ok := changed()
+	if !ok || cmd.name != "lobby" {
+		t.Fatalf("changed template was not reported")
+	}
+	w.check()
+	if _, ok := changed(); ok {
+		t.Fatalf("unchanged template was reported again")
+	}
+
+	// the command queue versions the change once
+	tmpl.templateChanged(cmd.name, cmd.fingerprint)
+	runVersioned(t, tmpl, ch)
+	if len(tmpl.versions["lobby"]) != 1 {
+		t.Fatalf("template has %d versions, want 1", len(tmpl.versions["lobby"]))
+	}
+	tmpl.templateChanged(cmd.name, cmd.fingerprint)
+	select {
+	case cmd := <-ch:
+		t.Fatalf("versioned change was versioned again: %T", cmd)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	writeTemplateFile(t, tmpl, "lobby", "server.properties", "motd=bb")
+	w.check()
+	w.check()
+	if cmd, ok := changed(); !ok || cmd.name != "lobby" {
+		t.Fatalf("second change was not reported")
+	}
+
+	err := os.RemoveAll(path.Join(tmpl.templateDir, "lobby"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.check()
+	if len(w.reported) != 0 || len(w.pending) != 0 {
+		t.Fatalf("removed template is still tracked")
+	}
+}
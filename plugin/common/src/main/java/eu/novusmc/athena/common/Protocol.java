@@ -98,6 +98,127 @@ public final class Protocol {
      */
     com.google.protobuf.ByteString
         getSlaveBytes();
+
+    /**
+     * <code>int32 players = 8;</code>
+     * @return The players.
+     */
+    int getPlayers();
+
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @return A list containing the templates.
+     */
+    java.util.List<java.lang.String>
+        getTemplatesList();
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @return The count of templates.
+     */
+    int getTemplatesCount();
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @param index The index of the element to return.
+     * @return The templates at the given index.
+     */
+    java.lang.String getTemplates(int index);
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the templates at the given index.
+     */
+    com.google.protobuf.ByteString
+        getTemplatesBytes(int index);
+
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    int getTemplateHashesCount();
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    boolean containsTemplateHashes(
+        java.lang.String key);
+    /**
+     * Use {@link #getTemplateHashesMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.String>
+    getTemplateHashes();
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.String>
+    getTemplateHashesMap();
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    /* nullable */
+java.lang.String getTemplateHashesOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue);
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    java.lang.String getTemplateHashesOrThrow(
+        java.lang.String key);
+
+    /**
+     * <pre>
+     * warm services are started ahead of time and only registered with the
+     * proxies once their group needs them.
+     * </pre>
+     *
+     * <code>bool warm = 11;</code>
+     * @return The warm.
+     */
+    boolean getWarm();
   }
   /**
    * Protobuf type {@code protocol.Service}
@@ -126,6 +247,8 @@ public final class Protocol {
       state_ = 0;
       group_ = "";
       slave_ = "";
+      templates_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
@@ -133,6 +256,18 @@ public final class Protocol {
       return eu.novusmc.athena.common.Protocol.internal_static_protocol_Service_descriptor;
     }
 
+    @SuppressWarnings({"rawtypes"})
+    @java.lang.Override
+    protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+        int number) {
+      switch (number) {
+        case 10:
+          return internalGetTemplateHashes();
+        default:
+          throw new RuntimeException(
+              "Invalid map field number: " + number);
+      }
+    }
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
@@ -595,6 +730,189 @@ public final class Protocol {
       }
     }
 
+    public static final int PLAYERS_FIELD_NUMBER = 8;
+    private int players_ = 0;
+    /**
+     * <code>int32 players = 8;</code>
+     * @return The players.
+     */
+    @java.lang.Override
+    public int getPlayers() {
+      return players_;
+    }
+
+    public static final int TEMPLATES_FIELD_NUMBER = 9;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList templates_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @return A list containing the templates.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getTemplatesList() {
+      return templates_;
+    }
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @return The count of templates.
+     */
+    public int getTemplatesCount() {
+      return templates_.size();
+    }
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @param index The index of the element to return.
+     * @return The templates at the given index.
+     */
+    public java.lang.String getTemplates(int index) {
+      return templates_.get(index);
+    }
+    /**
+     * <pre>
+     * templates are applied to the service directory in this order. Versions
+     * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+     * </pre>
+     *
+     * <code>repeated string templates = 9;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the templates at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getTemplatesBytes(int index) {
+      return templates_.getByteString(index);
+    }
+
+    public static final int TEMPLATE_HASHES_FIELD_NUMBER = 10;
+    private static final class TemplateHashesDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.String, java.lang.String> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.String, java.lang.String>newDefaultInstance(
+                  eu.novusmc.athena.common.Protocol.internal_static_protocol_Service_TemplateHashesEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "",
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "");
+    }
+    @SuppressWarnings("serial")
+    private com.google.protobuf.MapField<
+        java.lang.String, java.lang.String> templateHashes_;
+    private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+    internalGetTemplateHashes() {
+      if (templateHashes_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            TemplateHashesDefaultEntryHolder.defaultEntry);
+      }
+      return templateHashes_;
+    }
+    public int getTemplateHashesCount() {
+      return internalGetTemplateHashes().getMap().size();
+    }
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    @java.lang.Override
+    public boolean containsTemplateHashes(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      return internalGetTemplateHashes().getMap().containsKey(key);
+    }
+    /**
+     * Use {@link #getTemplateHashesMap()} instead.
+     */
+    @java.lang.Override
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.String, java.lang.String> getTemplateHashes() {
+      return getTemplateHashesMap();
+    }
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    @java.lang.Override
+    public java.util.Map<java.lang.String, java.lang.String> getTemplateHashesMap() {
+      return internalGetTemplateHashes().getMap();
+    }
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    @java.lang.Override
+    public /* nullable */
+java.lang.String getTemplateHashesOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetTemplateHashes().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
+    }
+    /**
+     * <pre>
+     * template_hashes maps the template references to the content hashes of
+     * the versions, so slaves can tell whether their cached copy is current.
+     * </pre>
+     *
+     * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+     */
+    @java.lang.Override
+    public java.lang.String getTemplateHashesOrThrow(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetTemplateHashes().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
+      }
+      return map.get(key);
+    }
+
+    public static final int WARM_FIELD_NUMBER = 11;
+    private boolean warm_ = false;
+    /**
+     * <pre>
+     * warm services are started ahead of time and only registered with the
+     * proxies once their group needs them.
+     * </pre>
+     *
+     * <code>bool warm = 11;</code>
+     * @return The warm.
+     */
+    @java.lang.Override
+    public boolean getWarm() {
+      return warm_;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -630,6 +948,21 @@ public final class Protocol {
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(slave_)) {
         com.google.protobuf.GeneratedMessage.writeString(output, 7, slave_);
       }
+      if (players_ != 0) {
+        output.writeInt32(8, players_);
+      }
+      for (int i = 0; i < templates_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 9, templates_.getRaw(i));
+      }
+      com.google.protobuf.GeneratedMessage
+        .serializeStringMapTo(
+          output,
+          internalGetTemplateHashes(),
+          TemplateHashesDefaultEntryHolder.defaultEntry,
+          10);
+      if (warm_ != false) {
+        output.writeBool(11, warm_);
+      }
       getUnknownFields().writeTo(output);
     }
 
@@ -664,6 +997,32 @@ public final class Protocol {
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(slave_)) {
         size += com.google.protobuf.GeneratedMessage.computeStringSize(7, slave_);
       }
+      if (players_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(8, players_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < templates_.size(); i++) {
+          dataSize += computeStringSizeNoTag(templates_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getTemplatesList().size();
+      }
+      for (java.util.Map.Entry<java.lang.String, java.lang.String> entry
+           : internalGetTemplateHashes().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+        templateHashes__ = TemplateHashesDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
+        size += com.google.protobuf.CodedOutputStream
+            .computeMessageSize(10, templateHashes__);
+      }
+      if (warm_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(11, warm_);
+      }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
       return size;
@@ -691,6 +1050,14 @@ public final class Protocol {
           .equals(other.getGroup())) return false;
       if (!getSlave()
           .equals(other.getSlave())) return false;
+      if (getPlayers()
+          != other.getPlayers()) return false;
+      if (!getTemplatesList()
+          .equals(other.getTemplatesList())) return false;
+      if (!internalGetTemplateHashes().equals(
+          other.internalGetTemplateHashes())) return false;
+      if (getWarm()
+          != other.getWarm()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -716,6 +1083,19 @@ public final class Protocol {
       hash = (53 * hash) + getGroup().hashCode();
       hash = (37 * hash) + SLAVE_FIELD_NUMBER;
       hash = (53 * hash) + getSlave().hashCode();
+      hash = (37 * hash) + PLAYERS_FIELD_NUMBER;
+      hash = (53 * hash) + getPlayers();
+      if (getTemplatesCount() > 0) {
+        hash = (37 * hash) + TEMPLATES_FIELD_NUMBER;
+        hash = (53 * hash) + getTemplatesList().hashCode();
+      }
+      if (!internalGetTemplateHashes().getMap().isEmpty()) {
+        hash = (37 * hash) + TEMPLATE_HASHES_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetTemplateHashes().hashCode();
+      }
+      hash = (37 * hash) + WARM_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getWarm());
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -825,6 +1205,28 @@ public final class Protocol {
         return eu.novusmc.athena.common.Protocol.internal_static_protocol_Service_descriptor;
       }
 
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 10:
+            return internalGetTemplateHashes();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMutableMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 10:
+            return internalGetMutableTemplateHashes();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
@@ -854,6 +1256,11 @@ public final class Protocol {
         port_ = 0;
         group_ = "";
         slave_ = "";
+        players_ = 0;
+        templates_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
+        internalGetMutableTemplateHashes().clear();
+        warm_ = false;
         return this;
       }
 
@@ -908,6 +1315,20 @@ public final class Protocol {
         if (((from_bitField0_ & 0x00000040) != 0)) {
           result.slave_ = slave_;
         }
+        if (((from_bitField0_ & 0x00000080) != 0)) {
+          result.players_ = players_;
+        }
+        if (((from_bitField0_ & 0x00000100) != 0)) {
+          templates_.makeImmutable();
+          result.templates_ = templates_;
+        }
+        if (((from_bitField0_ & 0x00000200) != 0)) {
+          result.templateHashes_ = internalGetTemplateHashes();
+          result.templateHashes_.makeImmutable();
+        }
+        if (((from_bitField0_ & 0x00000400) != 0)) {
+          result.warm_ = warm_;
+        }
       }
 
       @java.lang.Override
@@ -949,6 +1370,25 @@ public final class Protocol {
           bitField0_ |= 0x00000040;
           onChanged();
         }
+        if (other.getPlayers() != 0) {
+          setPlayers(other.getPlayers());
+        }
+        if (!other.templates_.isEmpty()) {
+          if (templates_.isEmpty()) {
+            templates_ = other.templates_;
+            bitField0_ |= 0x00000100;
+          } else {
+            ensureTemplatesIsMutable();
+            templates_.addAll(other.templates_);
+          }
+          onChanged();
+        }
+        internalGetMutableTemplateHashes().mergeFrom(
+            other.internalGetTemplateHashes());
+        bitField0_ |= 0x00000200;
+        if (other.getWarm() != false) {
+          setWarm(other.getWarm());
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -1010,6 +1450,31 @@ public final class Protocol {
                 bitField0_ |= 0x00000040;
                 break;
               } // case 58
+              case 64: {
+                players_ = input.readInt32();
+                bitField0_ |= 0x00000080;
+                break;
+              } // case 64
+              case 74: {
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureTemplatesIsMutable();
+                templates_.add(s);
+                break;
+              } // case 74
+              case 82: {
+                com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+                templateHashes__ = input.readMessage(
+                    TemplateHashesDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+                internalGetMutableTemplateHashes().getMutableMap().put(
+                    templateHashes__.getKey(), templateHashes__.getValue());
+                bitField0_ |= 0x00000200;
+                break;
+              } // case 82
+              case 88: {
+                warm_ = input.readBool();
+                bitField0_ |= 0x00000400;
+                break;
+              } // case 88
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -1413,6 +1878,403 @@ public final class Protocol {
         return this;
       }
 
+      private int players_ ;
+      /**
+       * <code>int32 players = 8;</code>
+       * @return The players.
+       */
+      @java.lang.Override
+      public int getPlayers() {
+        return players_;
+      }
+      /**
+       * <code>int32 players = 8;</code>
+       * @param value The players to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPlayers(int value) {
+
+        players_ = value;
+        bitField0_ |= 0x00000080;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 players = 8;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPlayers() {
+        bitField0_ = (bitField0_ & ~0x00000080);
+        players_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.LazyStringArrayList templates_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureTemplatesIsMutable() {
+        if (!templates_.isModifiable()) {
+          templates_ = new com.google.protobuf.LazyStringArrayList(templates_);
+        }
+        bitField0_ |= 0x00000100;
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @return A list containing the templates.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getTemplatesList() {
+        templates_.makeImmutable();
+        return templates_;
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @return The count of templates.
+       */
+      public int getTemplatesCount() {
+        return templates_.size();
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @param index The index of the element to return.
+       * @return The templates at the given index.
+       */
+      public java.lang.String getTemplates(int index) {
+        return templates_.get(index);
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the templates at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getTemplatesBytes(int index) {
+        return templates_.getByteString(index);
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @param index The index to set the value at.
+       * @param value The templates to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTemplates(
+          int index, java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureTemplatesIsMutable();
+        templates_.set(index, value);
+        bitField0_ |= 0x00000100;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @param value The templates to add.
+       * @return This builder for chaining.
+       */
+      public Builder addTemplates(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureTemplatesIsMutable();
+        templates_.add(value);
+        bitField0_ |= 0x00000100;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @param values The templates to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllTemplates(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureTemplatesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, templates_);
+        bitField0_ |= 0x00000100;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTemplates() {
+        templates_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000100);;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied to the service directory in this order. Versions
+       * are referenced as &lt;template&gt;&#64;&lt;version&gt;.
+       * </pre>
+       *
+       * <code>repeated string templates = 9;</code>
+       * @param value The bytes of the templates to add.
+       * @return This builder for chaining.
+       */
+      public Builder addTemplatesBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureTemplatesIsMutable();
+        templates_.add(value);
+        bitField0_ |= 0x00000100;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.MapField<
+          java.lang.String, java.lang.String> templateHashes_;
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetTemplateHashes() {
+        if (templateHashes_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              TemplateHashesDefaultEntryHolder.defaultEntry);
+        }
+        return templateHashes_;
+      }
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetMutableTemplateHashes() {
+        if (templateHashes_ == null) {
+          templateHashes_ = com.google.protobuf.MapField.newMapField(
+              TemplateHashesDefaultEntryHolder.defaultEntry);
+        }
+        if (!templateHashes_.isMutable()) {
+          templateHashes_ = templateHashes_.copy();
+        }
+        bitField0_ |= 0x00000200;
+        onChanged();
+        return templateHashes_;
+      }
+      public int getTemplateHashesCount() {
+        return internalGetTemplateHashes().getMap().size();
+      }
+      /**
+       * <pre>
+       * template_hashes maps the template references to the content hashes of
+       * the versions, so slaves can tell whether their cached copy is current.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+       */
+      @java.lang.Override
+      public boolean containsTemplateHashes(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        return internalGetTemplateHashes().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getTemplateHashesMap()} instead.
+       */
+      @java.lang.Override
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String> getTemplateHashes() {
+        return getTemplateHashesMap();
+      }
+      /**
+       * <pre>
+       * template_hashes maps the template references to the content hashes of
+       * the versions, so slaves can tell whether their cached copy is current.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+       */
+      @java.lang.Override
+      public java.util.Map<java.lang.String, java.lang.String> getTemplateHashesMap() {
+        return internalGetTemplateHashes().getMap();
+      }
+      /**
+       * <pre>
+       * template_hashes maps the template references to the content hashes of
+       * the versions, so slaves can tell whether their cached copy is current.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+       */
+      @java.lang.Override
+      public /* nullable */
+java.lang.String getTemplateHashesOrDefault(
+          java.lang.String key,
+          /* nullable */
+java.lang.String defaultValue) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetTemplateHashes().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <pre>
+       * template_hashes maps the template references to the content hashes of
+       * the versions, so slaves can tell whether their cached copy is current.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+       */
+      @java.lang.Override
+      public java.lang.String getTemplateHashesOrThrow(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetTemplateHashes().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+      public Builder clearTemplateHashes() {
+        bitField0_ = (bitField0_ & ~0x00000200);
+        internalGetMutableTemplateHashes().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <pre>
+       * template_hashes maps the template references to the content hashes of
+       * the versions, so slaves can tell whether their cached copy is current.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+       */
+      public Builder removeTemplateHashes(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        internalGetMutableTemplateHashes().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String>
+          getMutableTemplateHashes() {
+        bitField0_ |= 0x00000200;
+        return internalGetMutableTemplateHashes().getMutableMap();
+      }
+      /**
+       * <pre>
+       * template_hashes maps the template references to the content hashes of
+       * the versions, so slaves can tell whether their cached copy is current.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+       */
+      public Builder putTemplateHashes(
+          java.lang.String key,
+          java.lang.String value) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        if (value == null) { throw new NullPointerException("map value"); }
+        internalGetMutableTemplateHashes().getMutableMap()
+            .put(key, value);
+        bitField0_ |= 0x00000200;
+        return this;
+      }
+      /**
+       * <pre>
+       * template_hashes maps the template references to the content hashes of
+       * the versions, so slaves can tell whether their cached copy is current.
+       * </pre>
+       *
+       * <code>map&lt;string, string&gt; template_hashes = 10;</code>
+       */
+      public Builder putAllTemplateHashes(
+          java.util.Map<java.lang.String, java.lang.String> values) {
+        internalGetMutableTemplateHashes().getMutableMap()
+            .putAll(values);
+        bitField0_ |= 0x00000200;
+        return this;
+      }
+
+      private boolean warm_ ;
+      /**
+       * <pre>
+       * warm services are started ahead of time and only registered with the
+       * proxies once their group needs them.
+       * </pre>
+       *
+       * <code>bool warm = 11;</code>
+       * @return The warm.
+       */
+      @java.lang.Override
+      public boolean getWarm() {
+        return warm_;
+      }
+      /**
+       * <pre>
+       * warm services are started ahead of time and only registered with the
+       * proxies once their group needs them.
+       * </pre>
+       *
+       * <code>bool warm = 11;</code>
+       * @param value The warm to set.
+       * @return This builder for chaining.
+       */
+      public Builder setWarm(boolean value) {
+
+        warm_ = value;
+        bitField0_ |= 0x00000400;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * warm services are started ahead of time and only registered with the
+       * proxies once their group needs them.
+       * </pre>
+       *
+       * <code>bool warm = 11;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearWarm() {
+        bitField0_ = (bitField0_ & ~0x00000400);
+        warm_ = false;
+        onChanged();
+        return this;
+      }
+
       // @@protoc_insertion_point(builder_scope:protocol.Service)
     }
 
@@ -1514,15 +2376,349 @@ public final class Protocol {
      * @return The startPort.
      */
     int getStartPort();
-  }
-  /**
-   * Protobuf type {@code protocol.Group}
-   */
-  public static final class Group extends
-      com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.Group)
-      GroupOrBuilder {
-  private static final long serialVersionUID = 0L;
+
+    /**
+     * <code>int32 max_players = 7;</code>
+     * @return The maxPlayers.
+     */
+    int getMaxPlayers();
+
+    /**
+     * <code>int32 scale_threshold = 8;</code>
+     * @return The scaleThreshold.
+     */
+    int getScaleThreshold();
+
+    /**
+     * <code>int32 scale_down_delay = 9;</code>
+     * @return The scaleDownDelay.
+     */
+    int getScaleDownDelay();
+
+    /**
+     * <code>string placement = 10;</code>
+     * @return The placement.
+     */
+    java.lang.String getPlacement();
+    /**
+     * <code>string placement = 10;</code>
+     * @return The bytes for placement.
+     */
+    com.google.protobuf.ByteString
+        getPlacementBytes();
+
+    /**
+     * <code>string pinned_slave = 11;</code>
+     * @return The pinnedSlave.
+     */
+    java.lang.String getPinnedSlave();
+    /**
+     * <code>string pinned_slave = 11;</code>
+     * @return The bytes for pinnedSlave.
+     */
+    com.google.protobuf.ByteString
+        getPinnedSlaveBytes();
+
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    int getRequiredLabelsCount();
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    boolean containsRequiredLabels(
+        java.lang.String key);
+    /**
+     * Use {@link #getRequiredLabelsMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.String>
+    getRequiredLabels();
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.String>
+    getRequiredLabelsMap();
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    /* nullable */
+java.lang.String getRequiredLabelsOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue);
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    java.lang.String getRequiredLabelsOrThrow(
+        java.lang.String key);
+
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    int getPreferredLabelsCount();
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    boolean containsPreferredLabels(
+        java.lang.String key);
+    /**
+     * Use {@link #getPreferredLabelsMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.String>
+    getPreferredLabels();
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.String>
+    getPreferredLabelsMap();
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    /* nullable */
+java.lang.String getPreferredLabelsOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue);
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    java.lang.String getPreferredLabelsOrThrow(
+        java.lang.String key);
+
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @return A list containing the antiAffinity.
+     */
+    java.util.List<java.lang.String>
+        getAntiAffinityList();
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @return The count of antiAffinity.
+     */
+    int getAntiAffinityCount();
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @param index The index of the element to return.
+     * @return The antiAffinity at the given index.
+     */
+    java.lang.String getAntiAffinity(int index);
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the antiAffinity at the given index.
+     */
+    com.google.protobuf.ByteString
+        getAntiAffinityBytes(int index);
+
+    /**
+     * <pre>
+     * static services keep their working directory on the pinned slave.
+     * </pre>
+     *
+     * <code>bool static = 15;</code>
+     * @return The static.
+     */
+    boolean getStatic();
+
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @return A list containing the templates.
+     */
+    java.util.List<java.lang.String>
+        getTemplatesList();
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @return The count of templates.
+     */
+    int getTemplatesCount();
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @param index The index of the element to return.
+     * @return The templates at the given index.
+     */
+    java.lang.String getTemplates(int index);
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the templates at the given index.
+     */
+    com.google.protobuf.ByteString
+        getTemplatesBytes(int index);
+
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    java.util.List<eu.novusmc.athena.common.Protocol.WeightedTemplate> 
+        getRandomTemplatesList();
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    eu.novusmc.athena.common.Protocol.WeightedTemplate getRandomTemplates(int index);
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    int getRandomTemplatesCount();
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    java.util.List<? extends eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder> 
+        getRandomTemplatesOrBuilderList();
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder getRandomTemplatesOrBuilder(
+        int index);
+
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    int getTemplateVersionsCount();
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    boolean containsTemplateVersions(
+        java.lang.String key);
+    /**
+     * Use {@link #getTemplateVersionsMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.Integer>
+    getTemplateVersions();
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.Integer>
+    getTemplateVersionsMap();
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    int getTemplateVersionsOrDefault(
+        java.lang.String key,
+        int defaultValue);
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    int getTemplateVersionsOrThrow(
+        java.lang.String key);
+
+    /**
+     * <pre>
+     * warm_services is the number of services that are kept started in
+     * addition to the services of the group, so they can be put online
+     * instantly.
+     * </pre>
+     *
+     * <code>int32 warm_services = 19;</code>
+     * @return The warmServices.
+     */
+    int getWarmServices();
+
+    /**
+     * <pre>
+     * players of stopped servers are moved to the servers of fallback_group.
+     * </pre>
+     *
+     * <code>string fallback_group = 20;</code>
+     * @return The fallbackGroup.
+     */
+    java.lang.String getFallbackGroup();
+    /**
+     * <pre>
+     * players of stopped servers are moved to the servers of fallback_group.
+     * </pre>
+     *
+     * <code>string fallback_group = 20;</code>
+     * @return The bytes for fallbackGroup.
+     */
+    com.google.protobuf.ByteString
+        getFallbackGroupBytes();
+
+    /**
+     * <pre>
+     * drain_timeout is the number of seconds a server may take to get rid of
+     * its players before it is stopped.
+     * </pre>
+     *
+     * <code>int32 drain_timeout = 21;</code>
+     * @return The drainTimeout.
+     */
+    int getDrainTimeout();
+  }
+  /**
+   * Protobuf type {@code protocol.Group}
+   */
+  public static final class Group extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.Group)
+      GroupOrBuilder {
+  private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
         com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
@@ -1539,6 +2735,14 @@ public final class Protocol {
     private Group() {
       name_ = "";
       type_ = 0;
+      placement_ = "";
+      pinnedSlave_ = "";
+      antiAffinity_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      templates_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      randomTemplates_ = java.util.Collections.emptyList();
+      fallbackGroup_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
@@ -1546,6 +2750,22 @@ public final class Protocol {
       return eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_descriptor;
     }
 
+    @SuppressWarnings({"rawtypes"})
+    @java.lang.Override
+    protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+        int number) {
+      switch (number) {
+        case 12:
+          return internalGetRequiredLabels();
+        case 13:
+          return internalGetPreferredLabels();
+        case 18:
+          return internalGetTemplateVersions();
+        default:
+          throw new RuntimeException(
+              "Invalid map field number: " + number);
+      }
+    }
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
@@ -1655,162 +2875,9869 @@ public final class Protocol {
       return startPort_;
     }
 
-    private byte memoizedIsInitialized = -1;
+    public static final int MAX_PLAYERS_FIELD_NUMBER = 7;
+    private int maxPlayers_ = 0;
+    /**
+     * <code>int32 max_players = 7;</code>
+     * @return The maxPlayers.
+     */
     @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
+    public int getMaxPlayers() {
+      return maxPlayers_;
     }
 
+    public static final int SCALE_THRESHOLD_FIELD_NUMBER = 8;
+    private int scaleThreshold_ = 0;
+    /**
+     * <code>int32 scale_threshold = 8;</code>
+     * @return The scaleThreshold.
+     */
     @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(name_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, name_);
-      }
-      if (type_ != eu.novusmc.athena.common.Protocol.Service.Type.TYPE_UNKNOWN.getNumber()) {
-        output.writeEnum(2, type_);
-      }
-      if (minServices_ != 0) {
-        output.writeInt32(3, minServices_);
-      }
-      if (maxServices_ != 0) {
-        output.writeInt32(4, maxServices_);
-      }
-      if (memory_ != 0) {
-        output.writeInt32(5, memory_);
-      }
-      if (startPort_ != 0) {
-        output.writeInt32(6, startPort_);
-      }
-      getUnknownFields().writeTo(output);
+    public int getScaleThreshold() {
+      return scaleThreshold_;
     }
 
+    public static final int SCALE_DOWN_DELAY_FIELD_NUMBER = 9;
+    private int scaleDownDelay_ = 0;
+    /**
+     * <code>int32 scale_down_delay = 9;</code>
+     * @return The scaleDownDelay.
+     */
     @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
+    public int getScaleDownDelay() {
+      return scaleDownDelay_;
+    }
 
-      size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(name_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, name_);
-      }
-      if (type_ != eu.novusmc.athena.common.Protocol.Service.Type.TYPE_UNKNOWN.getNumber()) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeEnumSize(2, type_);
-      }
-      if (minServices_ != 0) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeInt32Size(3, minServices_);
-      }
-      if (maxServices_ != 0) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeInt32Size(4, maxServices_);
-      }
-      if (memory_ != 0) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeInt32Size(5, memory_);
+    public static final int PLACEMENT_FIELD_NUMBER = 10;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object placement_ = "";
+    /**
+     * <code>string placement = 10;</code>
+     * @return The placement.
+     */
+    @java.lang.Override
+    public java.lang.String getPlacement() {
+      java.lang.Object ref = placement_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        placement_ = s;
+        return s;
       }
-      if (startPort_ != 0) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeInt32Size(6, startPort_);
+    }
+    /**
+     * <code>string placement = 10;</code>
+     * @return The bytes for placement.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getPlacementBytes() {
+      java.lang.Object ref = placement_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        placement_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
       }
-      size += getUnknownFields().getSerializedSize();
-      memoizedSize = size;
-      return size;
     }
 
+    public static final int PINNED_SLAVE_FIELD_NUMBER = 11;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object pinnedSlave_ = "";
+    /**
+     * <code>string pinned_slave = 11;</code>
+     * @return The pinnedSlave.
+     */
     @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
+    public java.lang.String getPinnedSlave() {
+      java.lang.Object ref = pinnedSlave_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        pinnedSlave_ = s;
+        return s;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.Group)) {
-        return super.equals(obj);
+    }
+    /**
+     * <code>string pinned_slave = 11;</code>
+     * @return The bytes for pinnedSlave.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getPinnedSlaveBytes() {
+      java.lang.Object ref = pinnedSlave_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        pinnedSlave_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
       }
-      eu.novusmc.athena.common.Protocol.Group other = (eu.novusmc.athena.common.Protocol.Group) obj;
-
-      if (!getName()
-          .equals(other.getName())) return false;
-      if (type_ != other.type_) return false;
-      if (getMinServices()
-          != other.getMinServices()) return false;
-      if (getMaxServices()
-          != other.getMaxServices()) return false;
-      if (getMemory()
-          != other.getMemory()) return false;
-      if (getStartPort()
-          != other.getStartPort()) return false;
-      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
-      return true;
     }
 
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
+    public static final int REQUIRED_LABELS_FIELD_NUMBER = 12;
+    private static final class RequiredLabelsDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.String, java.lang.String> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.String, java.lang.String>newDefaultInstance(
+                  eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_RequiredLabelsEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "",
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "");
+    }
+    @SuppressWarnings("serial")
+    private com.google.protobuf.MapField<
+        java.lang.String, java.lang.String> requiredLabels_;
+    private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+    internalGetRequiredLabels() {
+      if (requiredLabels_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            RequiredLabelsDefaultEntryHolder.defaultEntry);
       }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      return requiredLabels_;
+    }
+    public int getRequiredLabelsCount() {
+      return internalGetRequiredLabels().getMap().size();
+    }
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    @java.lang.Override
+    public boolean containsRequiredLabels(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      return internalGetRequiredLabels().getMap().containsKey(key);
+    }
+    /**
+     * Use {@link #getRequiredLabelsMap()} instead.
+     */
+    @java.lang.Override
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.String, java.lang.String> getRequiredLabels() {
+      return getRequiredLabelsMap();
+    }
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    @java.lang.Override
+    public java.util.Map<java.lang.String, java.lang.String> getRequiredLabelsMap() {
+      return internalGetRequiredLabels().getMap();
+    }
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    @java.lang.Override
+    public /* nullable */
+java.lang.String getRequiredLabelsOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetRequiredLabels().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
+    }
+    /**
+     * <code>map&lt;string, string&gt; required_labels = 12;</code>
+     */
+    @java.lang.Override
+    public java.lang.String getRequiredLabelsOrThrow(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetRequiredLabels().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
+      }
+      return map.get(key);
+    }
+
+    public static final int PREFERRED_LABELS_FIELD_NUMBER = 13;
+    private static final class PreferredLabelsDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.String, java.lang.String> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.String, java.lang.String>newDefaultInstance(
+                  eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_PreferredLabelsEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "",
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "");
+    }
+    @SuppressWarnings("serial")
+    private com.google.protobuf.MapField<
+        java.lang.String, java.lang.String> preferredLabels_;
+    private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+    internalGetPreferredLabels() {
+      if (preferredLabels_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            PreferredLabelsDefaultEntryHolder.defaultEntry);
+      }
+      return preferredLabels_;
+    }
+    public int getPreferredLabelsCount() {
+      return internalGetPreferredLabels().getMap().size();
+    }
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    @java.lang.Override
+    public boolean containsPreferredLabels(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      return internalGetPreferredLabels().getMap().containsKey(key);
+    }
+    /**
+     * Use {@link #getPreferredLabelsMap()} instead.
+     */
+    @java.lang.Override
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.String, java.lang.String> getPreferredLabels() {
+      return getPreferredLabelsMap();
+    }
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    @java.lang.Override
+    public java.util.Map<java.lang.String, java.lang.String> getPreferredLabelsMap() {
+      return internalGetPreferredLabels().getMap();
+    }
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    @java.lang.Override
+    public /* nullable */
+java.lang.String getPreferredLabelsOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetPreferredLabels().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
+    }
+    /**
+     * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+     */
+    @java.lang.Override
+    public java.lang.String getPreferredLabelsOrThrow(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetPreferredLabels().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
+      }
+      return map.get(key);
+    }
+
+    public static final int ANTI_AFFINITY_FIELD_NUMBER = 14;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList antiAffinity_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @return A list containing the antiAffinity.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getAntiAffinityList() {
+      return antiAffinity_;
+    }
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @return The count of antiAffinity.
+     */
+    public int getAntiAffinityCount() {
+      return antiAffinity_.size();
+    }
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @param index The index of the element to return.
+     * @return The antiAffinity at the given index.
+     */
+    public java.lang.String getAntiAffinity(int index) {
+      return antiAffinity_.get(index);
+    }
+    /**
+     * <code>repeated string anti_affinity = 14;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the antiAffinity at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getAntiAffinityBytes(int index) {
+      return antiAffinity_.getByteString(index);
+    }
+
+    public static final int STATIC_FIELD_NUMBER = 15;
+    private boolean static_ = false;
+    /**
+     * <pre>
+     * static services keep their working directory on the pinned slave.
+     * </pre>
+     *
+     * <code>bool static = 15;</code>
+     * @return The static.
+     */
+    @java.lang.Override
+    public boolean getStatic() {
+      return static_;
+    }
+
+    public static final int TEMPLATES_FIELD_NUMBER = 16;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList templates_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @return A list containing the templates.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getTemplatesList() {
+      return templates_;
+    }
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @return The count of templates.
+     */
+    public int getTemplatesCount() {
+      return templates_.size();
+    }
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @param index The index of the element to return.
+     * @return The templates at the given index.
+     */
+    public java.lang.String getTemplates(int index) {
+      return templates_.get(index);
+    }
+    /**
+     * <pre>
+     * templates are applied in order after the global templates. Defaults to a
+     * template named after the group.
+     * </pre>
+     *
+     * <code>repeated string templates = 16;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the templates at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getTemplatesBytes(int index) {
+      return templates_.getByteString(index);
+    }
+
+    public static final int RANDOM_TEMPLATES_FIELD_NUMBER = 17;
+    @SuppressWarnings("serial")
+    private java.util.List<eu.novusmc.athena.common.Protocol.WeightedTemplate> randomTemplates_;
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    @java.lang.Override
+    public java.util.List<eu.novusmc.athena.common.Protocol.WeightedTemplate> getRandomTemplatesList() {
+      return randomTemplates_;
+    }
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder> 
+        getRandomTemplatesOrBuilderList() {
+      return randomTemplates_;
+    }
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    @java.lang.Override
+    public int getRandomTemplatesCount() {
+      return randomTemplates_.size();
+    }
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.WeightedTemplate getRandomTemplates(int index) {
+      return randomTemplates_.get(index);
+    }
+    /**
+     * <pre>
+     * one of random_templates is picked for every service and applied last.
+     * </pre>
+     *
+     * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder getRandomTemplatesOrBuilder(
+        int index) {
+      return randomTemplates_.get(index);
+    }
+
+    public static final int TEMPLATE_VERSIONS_FIELD_NUMBER = 18;
+    private static final class TemplateVersionsDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.String, java.lang.Integer> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.String, java.lang.Integer>newDefaultInstance(
+                  eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_TemplateVersionsEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "",
+                  com.google.protobuf.WireFormat.FieldType.INT32,
+                  0);
+    }
+    @SuppressWarnings("serial")
+    private com.google.protobuf.MapField<
+        java.lang.String, java.lang.Integer> templateVersions_;
+    private com.google.protobuf.MapField<java.lang.String, java.lang.Integer>
+    internalGetTemplateVersions() {
+      if (templateVersions_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            TemplateVersionsDefaultEntryHolder.defaultEntry);
+      }
+      return templateVersions_;
+    }
+    public int getTemplateVersionsCount() {
+      return internalGetTemplateVersions().getMap().size();
+    }
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    @java.lang.Override
+    public boolean containsTemplateVersions(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      return internalGetTemplateVersions().getMap().containsKey(key);
+    }
+    /**
+     * Use {@link #getTemplateVersionsMap()} instead.
+     */
+    @java.lang.Override
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.String, java.lang.Integer> getTemplateVersions() {
+      return getTemplateVersionsMap();
+    }
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    @java.lang.Override
+    public java.util.Map<java.lang.String, java.lang.Integer> getTemplateVersionsMap() {
+      return internalGetTemplateVersions().getMap();
+    }
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    @java.lang.Override
+    public int getTemplateVersionsOrDefault(
+        java.lang.String key,
+        int defaultValue) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.Integer> map =
+          internalGetTemplateVersions().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
+    }
+    /**
+     * <pre>
+     * template_versions pins templates to a version, all other templates follow
+     * their latest version.
+     * </pre>
+     *
+     * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+     */
+    @java.lang.Override
+    public int getTemplateVersionsOrThrow(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.Integer> map =
+          internalGetTemplateVersions().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
+      }
+      return map.get(key);
+    }
+
+    public static final int WARM_SERVICES_FIELD_NUMBER = 19;
+    private int warmServices_ = 0;
+    /**
+     * <pre>
+     * warm_services is the number of services that are kept started in
+     * addition to the services of the group, so they can be put online
+     * instantly.
+     * </pre>
+     *
+     * <code>int32 warm_services = 19;</code>
+     * @return The warmServices.
+     */
+    @java.lang.Override
+    public int getWarmServices() {
+      return warmServices_;
+    }
+
+    public static final int FALLBACK_GROUP_FIELD_NUMBER = 20;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object fallbackGroup_ = "";
+    /**
+     * <pre>
+     * players of stopped servers are moved to the servers of fallback_group.
+     * </pre>
+     *
+     * <code>string fallback_group = 20;</code>
+     * @return The fallbackGroup.
+     */
+    @java.lang.Override
+    public java.lang.String getFallbackGroup() {
+      java.lang.Object ref = fallbackGroup_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        fallbackGroup_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * players of stopped servers are moved to the servers of fallback_group.
+     * </pre>
+     *
+     * <code>string fallback_group = 20;</code>
+     * @return The bytes for fallbackGroup.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getFallbackGroupBytes() {
+      java.lang.Object ref = fallbackGroup_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        fallbackGroup_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int DRAIN_TIMEOUT_FIELD_NUMBER = 21;
+    private int drainTimeout_ = 0;
+    /**
+     * <pre>
+     * drain_timeout is the number of seconds a server may take to get rid of
+     * its players before it is stopped.
+     * </pre>
+     *
+     * <code>int32 drain_timeout = 21;</code>
+     * @return The drainTimeout.
+     */
+    @java.lang.Override
+    public int getDrainTimeout() {
+      return drainTimeout_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, name_);
+      }
+      if (type_ != eu.novusmc.athena.common.Protocol.Service.Type.TYPE_UNKNOWN.getNumber()) {
+        output.writeEnum(2, type_);
+      }
+      if (minServices_ != 0) {
+        output.writeInt32(3, minServices_);
+      }
+      if (maxServices_ != 0) {
+        output.writeInt32(4, maxServices_);
+      }
+      if (memory_ != 0) {
+        output.writeInt32(5, memory_);
+      }
+      if (startPort_ != 0) {
+        output.writeInt32(6, startPort_);
+      }
+      if (maxPlayers_ != 0) {
+        output.writeInt32(7, maxPlayers_);
+      }
+      if (scaleThreshold_ != 0) {
+        output.writeInt32(8, scaleThreshold_);
+      }
+      if (scaleDownDelay_ != 0) {
+        output.writeInt32(9, scaleDownDelay_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(placement_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 10, placement_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(pinnedSlave_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 11, pinnedSlave_);
+      }
+      com.google.protobuf.GeneratedMessage
+        .serializeStringMapTo(
+          output,
+          internalGetRequiredLabels(),
+          RequiredLabelsDefaultEntryHolder.defaultEntry,
+          12);
+      com.google.protobuf.GeneratedMessage
+        .serializeStringMapTo(
+          output,
+          internalGetPreferredLabels(),
+          PreferredLabelsDefaultEntryHolder.defaultEntry,
+          13);
+      for (int i = 0; i < antiAffinity_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 14, antiAffinity_.getRaw(i));
+      }
+      if (static_ != false) {
+        output.writeBool(15, static_);
+      }
+      for (int i = 0; i < templates_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 16, templates_.getRaw(i));
+      }
+      for (int i = 0; i < randomTemplates_.size(); i++) {
+        output.writeMessage(17, randomTemplates_.get(i));
+      }
+      com.google.protobuf.GeneratedMessage
+        .serializeStringMapTo(
+          output,
+          internalGetTemplateVersions(),
+          TemplateVersionsDefaultEntryHolder.defaultEntry,
+          18);
+      if (warmServices_ != 0) {
+        output.writeInt32(19, warmServices_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(fallbackGroup_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 20, fallbackGroup_);
+      }
+      if (drainTimeout_ != 0) {
+        output.writeInt32(21, drainTimeout_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, name_);
+      }
+      if (type_ != eu.novusmc.athena.common.Protocol.Service.Type.TYPE_UNKNOWN.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(2, type_);
+      }
+      if (minServices_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(3, minServices_);
+      }
+      if (maxServices_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(4, maxServices_);
+      }
+      if (memory_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(5, memory_);
+      }
+      if (startPort_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(6, startPort_);
+      }
+      if (maxPlayers_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(7, maxPlayers_);
+      }
+      if (scaleThreshold_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(8, scaleThreshold_);
+      }
+      if (scaleDownDelay_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(9, scaleDownDelay_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(placement_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(10, placement_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(pinnedSlave_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(11, pinnedSlave_);
+      }
+      for (java.util.Map.Entry<java.lang.String, java.lang.String> entry
+           : internalGetRequiredLabels().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+        requiredLabels__ = RequiredLabelsDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
+        size += com.google.protobuf.CodedOutputStream
+            .computeMessageSize(12, requiredLabels__);
+      }
+      for (java.util.Map.Entry<java.lang.String, java.lang.String> entry
+           : internalGetPreferredLabels().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+        preferredLabels__ = PreferredLabelsDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
+        size += com.google.protobuf.CodedOutputStream
+            .computeMessageSize(13, preferredLabels__);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < antiAffinity_.size(); i++) {
+          dataSize += computeStringSizeNoTag(antiAffinity_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getAntiAffinityList().size();
+      }
+      if (static_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(15, static_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < templates_.size(); i++) {
+          dataSize += computeStringSizeNoTag(templates_.getRaw(i));
+        }
+        size += dataSize;
+        size += 2 * getTemplatesList().size();
+      }
+      for (int i = 0; i < randomTemplates_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(17, randomTemplates_.get(i));
+      }
+      for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry
+           : internalGetTemplateVersions().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.String, java.lang.Integer>
+        templateVersions__ = TemplateVersionsDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
+        size += com.google.protobuf.CodedOutputStream
+            .computeMessageSize(18, templateVersions__);
+      }
+      if (warmServices_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(19, warmServices_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(fallbackGroup_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(20, fallbackGroup_);
+      }
+      if (drainTimeout_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(21, drainTimeout_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.Group)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.Group other = (eu.novusmc.athena.common.Protocol.Group) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (type_ != other.type_) return false;
+      if (getMinServices()
+          != other.getMinServices()) return false;
+      if (getMaxServices()
+          != other.getMaxServices()) return false;
+      if (getMemory()
+          != other.getMemory()) return false;
+      if (getStartPort()
+          != other.getStartPort()) return false;
+      if (getMaxPlayers()
+          != other.getMaxPlayers()) return false;
+      if (getScaleThreshold()
+          != other.getScaleThreshold()) return false;
+      if (getScaleDownDelay()
+          != other.getScaleDownDelay()) return false;
+      if (!getPlacement()
+          .equals(other.getPlacement())) return false;
+      if (!getPinnedSlave()
+          .equals(other.getPinnedSlave())) return false;
+      if (!internalGetRequiredLabels().equals(
+          other.internalGetRequiredLabels())) return false;
+      if (!internalGetPreferredLabels().equals(
+          other.internalGetPreferredLabels())) return false;
+      if (!getAntiAffinityList()
+          .equals(other.getAntiAffinityList())) return false;
+      if (getStatic()
+          != other.getStatic()) return false;
+      if (!getTemplatesList()
+          .equals(other.getTemplatesList())) return false;
+      if (!getRandomTemplatesList()
+          .equals(other.getRandomTemplatesList())) return false;
+      if (!internalGetTemplateVersions().equals(
+          other.internalGetTemplateVersions())) return false;
+      if (getWarmServices()
+          != other.getWarmServices()) return false;
+      if (!getFallbackGroup()
+          .equals(other.getFallbackGroup())) return false;
+      if (getDrainTimeout()
+          != other.getDrainTimeout()) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (37 * hash) + TYPE_FIELD_NUMBER;
+      hash = (53 * hash) + type_;
+      hash = (37 * hash) + MIN_SERVICES_FIELD_NUMBER;
+      hash = (53 * hash) + getMinServices();
+      hash = (37 * hash) + MAX_SERVICES_FIELD_NUMBER;
+      hash = (53 * hash) + getMaxServices();
+      hash = (37 * hash) + MEMORY_FIELD_NUMBER;
+      hash = (53 * hash) + getMemory();
+      hash = (37 * hash) + START_PORT_FIELD_NUMBER;
+      hash = (53 * hash) + getStartPort();
+      hash = (37 * hash) + MAX_PLAYERS_FIELD_NUMBER;
+      hash = (53 * hash) + getMaxPlayers();
+      hash = (37 * hash) + SCALE_THRESHOLD_FIELD_NUMBER;
+      hash = (53 * hash) + getScaleThreshold();
+      hash = (37 * hash) + SCALE_DOWN_DELAY_FIELD_NUMBER;
+      hash = (53 * hash) + getScaleDownDelay();
+      hash = (37 * hash) + PLACEMENT_FIELD_NUMBER;
+      hash = (53 * hash) + getPlacement().hashCode();
+      hash = (37 * hash) + PINNED_SLAVE_FIELD_NUMBER;
+      hash = (53 * hash) + getPinnedSlave().hashCode();
+      if (!internalGetRequiredLabels().getMap().isEmpty()) {
+        hash = (37 * hash) + REQUIRED_LABELS_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetRequiredLabels().hashCode();
+      }
+      if (!internalGetPreferredLabels().getMap().isEmpty()) {
+        hash = (37 * hash) + PREFERRED_LABELS_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetPreferredLabels().hashCode();
+      }
+      if (getAntiAffinityCount() > 0) {
+        hash = (37 * hash) + ANTI_AFFINITY_FIELD_NUMBER;
+        hash = (53 * hash) + getAntiAffinityList().hashCode();
+      }
+      hash = (37 * hash) + STATIC_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getStatic());
+      if (getTemplatesCount() > 0) {
+        hash = (37 * hash) + TEMPLATES_FIELD_NUMBER;
+        hash = (53 * hash) + getTemplatesList().hashCode();
+      }
+      if (getRandomTemplatesCount() > 0) {
+        hash = (37 * hash) + RANDOM_TEMPLATES_FIELD_NUMBER;
+        hash = (53 * hash) + getRandomTemplatesList().hashCode();
+      }
+      if (!internalGetTemplateVersions().getMap().isEmpty()) {
+        hash = (37 * hash) + TEMPLATE_VERSIONS_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetTemplateVersions().hashCode();
+      }
+      hash = (37 * hash) + WARM_SERVICES_FIELD_NUMBER;
+      hash = (53 * hash) + getWarmServices();
+      hash = (37 * hash) + FALLBACK_GROUP_FIELD_NUMBER;
+      hash = (53 * hash) + getFallbackGroup().hashCode();
+      hash = (37 * hash) + DRAIN_TIMEOUT_FIELD_NUMBER;
+      hash = (53 * hash) + getDrainTimeout();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Group parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Group parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.Group prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.Group}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.Group)
+        eu.novusmc.athena.common.Protocol.GroupOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_descriptor;
+      }
+
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 12:
+            return internalGetRequiredLabels();
+          case 13:
+            return internalGetPreferredLabels();
+          case 18:
+            return internalGetTemplateVersions();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMutableMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 12:
+            return internalGetMutableRequiredLabels();
+          case 13:
+            return internalGetMutablePreferredLabels();
+          case 18:
+            return internalGetMutableTemplateVersions();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.Group.class, eu.novusmc.athena.common.Protocol.Group.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.Group.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessage
+                .alwaysUseFieldBuilders) {
+          getRandomTemplatesFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        name_ = "";
+        type_ = 0;
+        minServices_ = 0;
+        maxServices_ = 0;
+        memory_ = 0;
+        startPort_ = 0;
+        maxPlayers_ = 0;
+        scaleThreshold_ = 0;
+        scaleDownDelay_ = 0;
+        placement_ = "";
+        pinnedSlave_ = "";
+        internalGetMutableRequiredLabels().clear();
+        internalGetMutablePreferredLabels().clear();
+        antiAffinity_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
+        static_ = false;
+        templates_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
+        if (randomTemplatesBuilder_ == null) {
+          randomTemplates_ = java.util.Collections.emptyList();
+        } else {
+          randomTemplates_ = null;
+          randomTemplatesBuilder_.clear();
+        }
+        bitField0_ = (bitField0_ & ~0x00010000);
+        internalGetMutableTemplateVersions().clear();
+        warmServices_ = 0;
+        fallbackGroup_ = "";
+        drainTimeout_ = 0;
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.Group getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.Group.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.Group build() {
+        eu.novusmc.athena.common.Protocol.Group result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.Group buildPartial() {
+        eu.novusmc.athena.common.Protocol.Group result = new eu.novusmc.athena.common.Protocol.Group(this);
+        buildPartialRepeatedFields(result);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartialRepeatedFields(eu.novusmc.athena.common.Protocol.Group result) {
+        if (randomTemplatesBuilder_ == null) {
+          if (((bitField0_ & 0x00010000) != 0)) {
+            randomTemplates_ = java.util.Collections.unmodifiableList(randomTemplates_);
+            bitField0_ = (bitField0_ & ~0x00010000);
+          }
+          result.randomTemplates_ = randomTemplates_;
+        } else {
+          result.randomTemplates_ = randomTemplatesBuilder_.build();
+        }
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.Group result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.name_ = name_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.type_ = type_;
+        }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          result.minServices_ = minServices_;
+        }
+        if (((from_bitField0_ & 0x00000008) != 0)) {
+          result.maxServices_ = maxServices_;
+        }
+        if (((from_bitField0_ & 0x00000010) != 0)) {
+          result.memory_ = memory_;
+        }
+        if (((from_bitField0_ & 0x00000020) != 0)) {
+          result.startPort_ = startPort_;
+        }
+        if (((from_bitField0_ & 0x00000040) != 0)) {
+          result.maxPlayers_ = maxPlayers_;
+        }
+        if (((from_bitField0_ & 0x00000080) != 0)) {
+          result.scaleThreshold_ = scaleThreshold_;
+        }
+        if (((from_bitField0_ & 0x00000100) != 0)) {
+          result.scaleDownDelay_ = scaleDownDelay_;
+        }
+        if (((from_bitField0_ & 0x00000200) != 0)) {
+          result.placement_ = placement_;
+        }
+        if (((from_bitField0_ & 0x00000400) != 0)) {
+          result.pinnedSlave_ = pinnedSlave_;
+        }
+        if (((from_bitField0_ & 0x00000800) != 0)) {
+          result.requiredLabels_ = internalGetRequiredLabels();
+          result.requiredLabels_.makeImmutable();
+        }
+        if (((from_bitField0_ & 0x00001000) != 0)) {
+          result.preferredLabels_ = internalGetPreferredLabels();
+          result.preferredLabels_.makeImmutable();
+        }
+        if (((from_bitField0_ & 0x00002000) != 0)) {
+          antiAffinity_.makeImmutable();
+          result.antiAffinity_ = antiAffinity_;
+        }
+        if (((from_bitField0_ & 0x00004000) != 0)) {
+          result.static_ = static_;
+        }
+        if (((from_bitField0_ & 0x00008000) != 0)) {
+          templates_.makeImmutable();
+          result.templates_ = templates_;
+        }
+        if (((from_bitField0_ & 0x00020000) != 0)) {
+          result.templateVersions_ = internalGetTemplateVersions();
+          result.templateVersions_.makeImmutable();
+        }
+        if (((from_bitField0_ & 0x00040000) != 0)) {
+          result.warmServices_ = warmServices_;
+        }
+        if (((from_bitField0_ & 0x00080000) != 0)) {
+          result.fallbackGroup_ = fallbackGroup_;
+        }
+        if (((from_bitField0_ & 0x00100000) != 0)) {
+          result.drainTimeout_ = drainTimeout_;
+        }
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.Group) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.Group)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.Group other) {
+        if (other == eu.novusmc.athena.common.Protocol.Group.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        if (other.type_ != 0) {
+          setTypeValue(other.getTypeValue());
+        }
+        if (other.getMinServices() != 0) {
+          setMinServices(other.getMinServices());
+        }
+        if (other.getMaxServices() != 0) {
+          setMaxServices(other.getMaxServices());
+        }
+        if (other.getMemory() != 0) {
+          setMemory(other.getMemory());
+        }
+        if (other.getStartPort() != 0) {
+          setStartPort(other.getStartPort());
+        }
+        if (other.getMaxPlayers() != 0) {
+          setMaxPlayers(other.getMaxPlayers());
+        }
+        if (other.getScaleThreshold() != 0) {
+          setScaleThreshold(other.getScaleThreshold());
+        }
+        if (other.getScaleDownDelay() != 0) {
+          setScaleDownDelay(other.getScaleDownDelay());
+        }
+        if (!other.getPlacement().isEmpty()) {
+          placement_ = other.placement_;
+          bitField0_ |= 0x00000200;
+          onChanged();
+        }
+        if (!other.getPinnedSlave().isEmpty()) {
+          pinnedSlave_ = other.pinnedSlave_;
+          bitField0_ |= 0x00000400;
+          onChanged();
+        }
+        internalGetMutableRequiredLabels().mergeFrom(
+            other.internalGetRequiredLabels());
+        bitField0_ |= 0x00000800;
+        internalGetMutablePreferredLabels().mergeFrom(
+            other.internalGetPreferredLabels());
+        bitField0_ |= 0x00001000;
+        if (!other.antiAffinity_.isEmpty()) {
+          if (antiAffinity_.isEmpty()) {
+            antiAffinity_ = other.antiAffinity_;
+            bitField0_ |= 0x00002000;
+          } else {
+            ensureAntiAffinityIsMutable();
+            antiAffinity_.addAll(other.antiAffinity_);
+          }
+          onChanged();
+        }
+        if (other.getStatic() != false) {
+          setStatic(other.getStatic());
+        }
+        if (!other.templates_.isEmpty()) {
+          if (templates_.isEmpty()) {
+            templates_ = other.templates_;
+            bitField0_ |= 0x00008000;
+          } else {
+            ensureTemplatesIsMutable();
+            templates_.addAll(other.templates_);
+          }
+          onChanged();
+        }
+        if (randomTemplatesBuilder_ == null) {
+          if (!other.randomTemplates_.isEmpty()) {
+            if (randomTemplates_.isEmpty()) {
+              randomTemplates_ = other.randomTemplates_;
+              bitField0_ = (bitField0_ & ~0x00010000);
+            } else {
+              ensureRandomTemplatesIsMutable();
+              randomTemplates_.addAll(other.randomTemplates_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.randomTemplates_.isEmpty()) {
+            if (randomTemplatesBuilder_.isEmpty()) {
+              randomTemplatesBuilder_.dispose();
+              randomTemplatesBuilder_ = null;
+              randomTemplates_ = other.randomTemplates_;
+              bitField0_ = (bitField0_ & ~0x00010000);
+              randomTemplatesBuilder_ =
+                com.google.protobuf.GeneratedMessage.alwaysUseFieldBuilders ?
+                   getRandomTemplatesFieldBuilder() : null;
+            } else {
+              randomTemplatesBuilder_.addAllMessages(other.randomTemplates_);
+            }
+          }
+        }
+        internalGetMutableTemplateVersions().mergeFrom(
+            other.internalGetTemplateVersions());
+        bitField0_ |= 0x00020000;
+        if (other.getWarmServices() != 0) {
+          setWarmServices(other.getWarmServices());
+        }
+        if (!other.getFallbackGroup().isEmpty()) {
+          fallbackGroup_ = other.fallbackGroup_;
+          bitField0_ |= 0x00080000;
+          onChanged();
+        }
+        if (other.getDrainTimeout() != 0) {
+          setDrainTimeout(other.getDrainTimeout());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                name_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              case 16: {
+                type_ = input.readEnum();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 16
+              case 24: {
+                minServices_ = input.readInt32();
+                bitField0_ |= 0x00000004;
+                break;
+              } // case 24
+              case 32: {
+                maxServices_ = input.readInt32();
+                bitField0_ |= 0x00000008;
+                break;
+              } // case 32
+              case 40: {
+                memory_ = input.readInt32();
+                bitField0_ |= 0x00000010;
+                break;
+              } // case 40
+              case 48: {
+                startPort_ = input.readInt32();
+                bitField0_ |= 0x00000020;
+                break;
+              } // case 48
+              case 56: {
+                maxPlayers_ = input.readInt32();
+                bitField0_ |= 0x00000040;
+                break;
+              } // case 56
+              case 64: {
+                scaleThreshold_ = input.readInt32();
+                bitField0_ |= 0x00000080;
+                break;
+              } // case 64
+              case 72: {
+                scaleDownDelay_ = input.readInt32();
+                bitField0_ |= 0x00000100;
+                break;
+              } // case 72
+              case 82: {
+                placement_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000200;
+                break;
+              } // case 82
+              case 90: {
+                pinnedSlave_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000400;
+                break;
+              } // case 90
+              case 98: {
+                com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+                requiredLabels__ = input.readMessage(
+                    RequiredLabelsDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+                internalGetMutableRequiredLabels().getMutableMap().put(
+                    requiredLabels__.getKey(), requiredLabels__.getValue());
+                bitField0_ |= 0x00000800;
+                break;
+              } // case 98
+              case 106: {
+                com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+                preferredLabels__ = input.readMessage(
+                    PreferredLabelsDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+                internalGetMutablePreferredLabels().getMutableMap().put(
+                    preferredLabels__.getKey(), preferredLabels__.getValue());
+                bitField0_ |= 0x00001000;
+                break;
+              } // case 106
+              case 114: {
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureAntiAffinityIsMutable();
+                antiAffinity_.add(s);
+                break;
+              } // case 114
+              case 120: {
+                static_ = input.readBool();
+                bitField0_ |= 0x00004000;
+                break;
+              } // case 120
+              case 130: {
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureTemplatesIsMutable();
+                templates_.add(s);
+                break;
+              } // case 130
+              case 138: {
+                eu.novusmc.athena.common.Protocol.WeightedTemplate m =
+                    input.readMessage(
+                        eu.novusmc.athena.common.Protocol.WeightedTemplate.parser(),
+                        extensionRegistry);
+                if (randomTemplatesBuilder_ == null) {
+                  ensureRandomTemplatesIsMutable();
+                  randomTemplates_.add(m);
+                } else {
+                  randomTemplatesBuilder_.addMessage(m);
+                }
+                break;
+              } // case 138
+              case 146: {
+                com.google.protobuf.MapEntry<java.lang.String, java.lang.Integer>
+                templateVersions__ = input.readMessage(
+                    TemplateVersionsDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+                internalGetMutableTemplateVersions().getMutableMap().put(
+                    templateVersions__.getKey(), templateVersions__.getValue());
+                bitField0_ |= 0x00020000;
+                break;
+              } // case 146
+              case 152: {
+                warmServices_ = input.readInt32();
+                bitField0_ |= 0x00040000;
+                break;
+              } // case 152
+              case 162: {
+                fallbackGroup_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00080000;
+                break;
+              } // case 162
+              case 168: {
+                drainTimeout_ = input.readInt32();
+                bitField0_ |= 0x00100000;
+                break;
+              } // case 168
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        name_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+        name_ = getDefaultInstance().getName();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        name_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+
+      private int type_ = 0;
+      /**
+       * <code>.protocol.Service.Type type = 2;</code>
+       * @return The enum numeric value on the wire for type.
+       */
+      @java.lang.Override public int getTypeValue() {
+        return type_;
+      }
+      /**
+       * <code>.protocol.Service.Type type = 2;</code>
+       * @param value The enum numeric value on the wire for type to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTypeValue(int value) {
+        type_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.protocol.Service.Type type = 2;</code>
+       * @return The type.
+       */
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.Service.Type getType() {
+        eu.novusmc.athena.common.Protocol.Service.Type result = eu.novusmc.athena.common.Protocol.Service.Type.forNumber(type_);
+        return result == null ? eu.novusmc.athena.common.Protocol.Service.Type.UNRECOGNIZED : result;
+      }
+      /**
+       * <code>.protocol.Service.Type type = 2;</code>
+       * @param value The type to set.
+       * @return This builder for chaining.
+       */
+      public Builder setType(eu.novusmc.athena.common.Protocol.Service.Type value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        bitField0_ |= 0x00000002;
+        type_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.protocol.Service.Type type = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearType() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        type_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int minServices_ ;
+      /**
+       * <code>int32 min_services = 3;</code>
+       * @return The minServices.
+       */
+      @java.lang.Override
+      public int getMinServices() {
+        return minServices_;
+      }
+      /**
+       * <code>int32 min_services = 3;</code>
+       * @param value The minServices to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMinServices(int value) {
+
+        minServices_ = value;
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 min_services = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMinServices() {
+        bitField0_ = (bitField0_ & ~0x00000004);
+        minServices_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int maxServices_ ;
+      /**
+       * <code>int32 max_services = 4;</code>
+       * @return The maxServices.
+       */
+      @java.lang.Override
+      public int getMaxServices() {
+        return maxServices_;
+      }
+      /**
+       * <code>int32 max_services = 4;</code>
+       * @param value The maxServices to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMaxServices(int value) {
+
+        maxServices_ = value;
+        bitField0_ |= 0x00000008;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 max_services = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMaxServices() {
+        bitField0_ = (bitField0_ & ~0x00000008);
+        maxServices_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int memory_ ;
+      /**
+       * <code>int32 memory = 5;</code>
+       * @return The memory.
+       */
+      @java.lang.Override
+      public int getMemory() {
+        return memory_;
+      }
+      /**
+       * <code>int32 memory = 5;</code>
+       * @param value The memory to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMemory(int value) {
+
+        memory_ = value;
+        bitField0_ |= 0x00000010;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 memory = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMemory() {
+        bitField0_ = (bitField0_ & ~0x00000010);
+        memory_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int startPort_ ;
+      /**
+       * <code>int32 start_port = 6;</code>
+       * @return The startPort.
+       */
+      @java.lang.Override
+      public int getStartPort() {
+        return startPort_;
+      }
+      /**
+       * <code>int32 start_port = 6;</code>
+       * @param value The startPort to set.
+       * @return This builder for chaining.
+       */
+      public Builder setStartPort(int value) {
+
+        startPort_ = value;
+        bitField0_ |= 0x00000020;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 start_port = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearStartPort() {
+        bitField0_ = (bitField0_ & ~0x00000020);
+        startPort_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int maxPlayers_ ;
+      /**
+       * <code>int32 max_players = 7;</code>
+       * @return The maxPlayers.
+       */
+      @java.lang.Override
+      public int getMaxPlayers() {
+        return maxPlayers_;
+      }
+      /**
+       * <code>int32 max_players = 7;</code>
+       * @param value The maxPlayers to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMaxPlayers(int value) {
+
+        maxPlayers_ = value;
+        bitField0_ |= 0x00000040;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 max_players = 7;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMaxPlayers() {
+        bitField0_ = (bitField0_ & ~0x00000040);
+        maxPlayers_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int scaleThreshold_ ;
+      /**
+       * <code>int32 scale_threshold = 8;</code>
+       * @return The scaleThreshold.
+       */
+      @java.lang.Override
+      public int getScaleThreshold() {
+        return scaleThreshold_;
+      }
+      /**
+       * <code>int32 scale_threshold = 8;</code>
+       * @param value The scaleThreshold to set.
+       * @return This builder for chaining.
+       */
+      public Builder setScaleThreshold(int value) {
+
+        scaleThreshold_ = value;
+        bitField0_ |= 0x00000080;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 scale_threshold = 8;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearScaleThreshold() {
+        bitField0_ = (bitField0_ & ~0x00000080);
+        scaleThreshold_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int scaleDownDelay_ ;
+      /**
+       * <code>int32 scale_down_delay = 9;</code>
+       * @return The scaleDownDelay.
+       */
+      @java.lang.Override
+      public int getScaleDownDelay() {
+        return scaleDownDelay_;
+      }
+      /**
+       * <code>int32 scale_down_delay = 9;</code>
+       * @param value The scaleDownDelay to set.
+       * @return This builder for chaining.
+       */
+      public Builder setScaleDownDelay(int value) {
+
+        scaleDownDelay_ = value;
+        bitField0_ |= 0x00000100;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 scale_down_delay = 9;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearScaleDownDelay() {
+        bitField0_ = (bitField0_ & ~0x00000100);
+        scaleDownDelay_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object placement_ = "";
+      /**
+       * <code>string placement = 10;</code>
+       * @return The placement.
+       */
+      public java.lang.String getPlacement() {
+        java.lang.Object ref = placement_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          placement_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string placement = 10;</code>
+       * @return The bytes for placement.
+       */
+      public com.google.protobuf.ByteString
+          getPlacementBytes() {
+        java.lang.Object ref = placement_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          placement_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string placement = 10;</code>
+       * @param value The placement to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPlacement(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        placement_ = value;
+        bitField0_ |= 0x00000200;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string placement = 10;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPlacement() {
+        placement_ = getDefaultInstance().getPlacement();
+        bitField0_ = (bitField0_ & ~0x00000200);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string placement = 10;</code>
+       * @param value The bytes for placement to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPlacementBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        placement_ = value;
+        bitField0_ |= 0x00000200;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object pinnedSlave_ = "";
+      /**
+       * <code>string pinned_slave = 11;</code>
+       * @return The pinnedSlave.
+       */
+      public java.lang.String getPinnedSlave() {
+        java.lang.Object ref = pinnedSlave_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          pinnedSlave_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string pinned_slave = 11;</code>
+       * @return The bytes for pinnedSlave.
+       */
+      public com.google.protobuf.ByteString
+          getPinnedSlaveBytes() {
+        java.lang.Object ref = pinnedSlave_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          pinnedSlave_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string pinned_slave = 11;</code>
+       * @param value The pinnedSlave to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPinnedSlave(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        pinnedSlave_ = value;
+        bitField0_ |= 0x00000400;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string pinned_slave = 11;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPinnedSlave() {
+        pinnedSlave_ = getDefaultInstance().getPinnedSlave();
+        bitField0_ = (bitField0_ & ~0x00000400);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string pinned_slave = 11;</code>
+       * @param value The bytes for pinnedSlave to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPinnedSlaveBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        pinnedSlave_ = value;
+        bitField0_ |= 0x00000400;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.MapField<
+          java.lang.String, java.lang.String> requiredLabels_;
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetRequiredLabels() {
+        if (requiredLabels_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              RequiredLabelsDefaultEntryHolder.defaultEntry);
+        }
+        return requiredLabels_;
+      }
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetMutableRequiredLabels() {
+        if (requiredLabels_ == null) {
+          requiredLabels_ = com.google.protobuf.MapField.newMapField(
+              RequiredLabelsDefaultEntryHolder.defaultEntry);
+        }
+        if (!requiredLabels_.isMutable()) {
+          requiredLabels_ = requiredLabels_.copy();
+        }
+        bitField0_ |= 0x00000800;
+        onChanged();
+        return requiredLabels_;
+      }
+      public int getRequiredLabelsCount() {
+        return internalGetRequiredLabels().getMap().size();
+      }
+      /**
+       * <code>map&lt;string, string&gt; required_labels = 12;</code>
+       */
+      @java.lang.Override
+      public boolean containsRequiredLabels(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        return internalGetRequiredLabels().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getRequiredLabelsMap()} instead.
+       */
+      @java.lang.Override
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String> getRequiredLabels() {
+        return getRequiredLabelsMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; required_labels = 12;</code>
+       */
+      @java.lang.Override
+      public java.util.Map<java.lang.String, java.lang.String> getRequiredLabelsMap() {
+        return internalGetRequiredLabels().getMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; required_labels = 12;</code>
+       */
+      @java.lang.Override
+      public /* nullable */
+java.lang.String getRequiredLabelsOrDefault(
+          java.lang.String key,
+          /* nullable */
+java.lang.String defaultValue) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetRequiredLabels().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <code>map&lt;string, string&gt; required_labels = 12;</code>
+       */
+      @java.lang.Override
+      public java.lang.String getRequiredLabelsOrThrow(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetRequiredLabels().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+      public Builder clearRequiredLabels() {
+        bitField0_ = (bitField0_ & ~0x00000800);
+        internalGetMutableRequiredLabels().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <code>map&lt;string, string&gt; required_labels = 12;</code>
+       */
+      public Builder removeRequiredLabels(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        internalGetMutableRequiredLabels().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String>
+          getMutableRequiredLabels() {
+        bitField0_ |= 0x00000800;
+        return internalGetMutableRequiredLabels().getMutableMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; required_labels = 12;</code>
+       */
+      public Builder putRequiredLabels(
+          java.lang.String key,
+          java.lang.String value) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        if (value == null) { throw new NullPointerException("map value"); }
+        internalGetMutableRequiredLabels().getMutableMap()
+            .put(key, value);
+        bitField0_ |= 0x00000800;
+        return this;
+      }
+      /**
+       * <code>map&lt;string, string&gt; required_labels = 12;</code>
+       */
+      public Builder putAllRequiredLabels(
+          java.util.Map<java.lang.String, java.lang.String> values) {
+        internalGetMutableRequiredLabels().getMutableMap()
+            .putAll(values);
+        bitField0_ |= 0x00000800;
+        return this;
+      }
+
+      private com.google.protobuf.MapField<
+          java.lang.String, java.lang.String> preferredLabels_;
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetPreferredLabels() {
+        if (preferredLabels_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              PreferredLabelsDefaultEntryHolder.defaultEntry);
+        }
+        return preferredLabels_;
+      }
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetMutablePreferredLabels() {
+        if (preferredLabels_ == null) {
+          preferredLabels_ = com.google.protobuf.MapField.newMapField(
+              PreferredLabelsDefaultEntryHolder.defaultEntry);
+        }
+        if (!preferredLabels_.isMutable()) {
+          preferredLabels_ = preferredLabels_.copy();
+        }
+        bitField0_ |= 0x00001000;
+        onChanged();
+        return preferredLabels_;
+      }
+      public int getPreferredLabelsCount() {
+        return internalGetPreferredLabels().getMap().size();
+      }
+      /**
+       * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+       */
+      @java.lang.Override
+      public boolean containsPreferredLabels(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        return internalGetPreferredLabels().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getPreferredLabelsMap()} instead.
+       */
+      @java.lang.Override
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String> getPreferredLabels() {
+        return getPreferredLabelsMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+       */
+      @java.lang.Override
+      public java.util.Map<java.lang.String, java.lang.String> getPreferredLabelsMap() {
+        return internalGetPreferredLabels().getMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+       */
+      @java.lang.Override
+      public /* nullable */
+java.lang.String getPreferredLabelsOrDefault(
+          java.lang.String key,
+          /* nullable */
+java.lang.String defaultValue) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetPreferredLabels().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+       */
+      @java.lang.Override
+      public java.lang.String getPreferredLabelsOrThrow(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetPreferredLabels().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+      public Builder clearPreferredLabels() {
+        bitField0_ = (bitField0_ & ~0x00001000);
+        internalGetMutablePreferredLabels().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+       */
+      public Builder removePreferredLabels(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        internalGetMutablePreferredLabels().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String>
+          getMutablePreferredLabels() {
+        bitField0_ |= 0x00001000;
+        return internalGetMutablePreferredLabels().getMutableMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+       */
+      public Builder putPreferredLabels(
+          java.lang.String key,
+          java.lang.String value) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        if (value == null) { throw new NullPointerException("map value"); }
+        internalGetMutablePreferredLabels().getMutableMap()
+            .put(key, value);
+        bitField0_ |= 0x00001000;
+        return this;
+      }
+      /**
+       * <code>map&lt;string, string&gt; preferred_labels = 13;</code>
+       */
+      public Builder putAllPreferredLabels(
+          java.util.Map<java.lang.String, java.lang.String> values) {
+        internalGetMutablePreferredLabels().getMutableMap()
+            .putAll(values);
+        bitField0_ |= 0x00001000;
+        return this;
+      }
+
+      private com.google.protobuf.LazyStringArrayList antiAffinity_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureAntiAffinityIsMutable() {
+        if (!antiAffinity_.isModifiable()) {
+          antiAffinity_ = new com.google.protobuf.LazyStringArrayList(antiAffinity_);
+        }
+        bitField0_ |= 0x00002000;
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @return A list containing the antiAffinity.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getAntiAffinityList() {
+        antiAffinity_.makeImmutable();
+        return antiAffinity_;
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @return The count of antiAffinity.
+       */
+      public int getAntiAffinityCount() {
+        return antiAffinity_.size();
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @param index The index of the element to return.
+       * @return The antiAffinity at the given index.
+       */
+      public java.lang.String getAntiAffinity(int index) {
+        return antiAffinity_.get(index);
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the antiAffinity at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getAntiAffinityBytes(int index) {
+        return antiAffinity_.getByteString(index);
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @param index The index to set the value at.
+       * @param value The antiAffinity to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAntiAffinity(
+          int index, java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureAntiAffinityIsMutable();
+        antiAffinity_.set(index, value);
+        bitField0_ |= 0x00002000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @param value The antiAffinity to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAntiAffinity(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureAntiAffinityIsMutable();
+        antiAffinity_.add(value);
+        bitField0_ |= 0x00002000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @param values The antiAffinity to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllAntiAffinity(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureAntiAffinityIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, antiAffinity_);
+        bitField0_ |= 0x00002000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAntiAffinity() {
+        antiAffinity_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00002000);;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string anti_affinity = 14;</code>
+       * @param value The bytes of the antiAffinity to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAntiAffinityBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureAntiAffinityIsMutable();
+        antiAffinity_.add(value);
+        bitField0_ |= 0x00002000;
+        onChanged();
+        return this;
+      }
+
+      private boolean static_ ;
+      /**
+       * <pre>
+       * static services keep their working directory on the pinned slave.
+       * </pre>
+       *
+       * <code>bool static = 15;</code>
+       * @return The static.
+       */
+      @java.lang.Override
+      public boolean getStatic() {
+        return static_;
+      }
+      /**
+       * <pre>
+       * static services keep their working directory on the pinned slave.
+       * </pre>
+       *
+       * <code>bool static = 15;</code>
+       * @param value The static to set.
+       * @return This builder for chaining.
+       */
+      public Builder setStatic(boolean value) {
+
+        static_ = value;
+        bitField0_ |= 0x00004000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * static services keep their working directory on the pinned slave.
+       * </pre>
+       *
+       * <code>bool static = 15;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearStatic() {
+        bitField0_ = (bitField0_ & ~0x00004000);
+        static_ = false;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.LazyStringArrayList templates_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureTemplatesIsMutable() {
+        if (!templates_.isModifiable()) {
+          templates_ = new com.google.protobuf.LazyStringArrayList(templates_);
+        }
+        bitField0_ |= 0x00008000;
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @return A list containing the templates.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getTemplatesList() {
+        templates_.makeImmutable();
+        return templates_;
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @return The count of templates.
+       */
+      public int getTemplatesCount() {
+        return templates_.size();
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @param index The index of the element to return.
+       * @return The templates at the given index.
+       */
+      public java.lang.String getTemplates(int index) {
+        return templates_.get(index);
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the templates at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getTemplatesBytes(int index) {
+        return templates_.getByteString(index);
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @param index The index to set the value at.
+       * @param value The templates to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTemplates(
+          int index, java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureTemplatesIsMutable();
+        templates_.set(index, value);
+        bitField0_ |= 0x00008000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @param value The templates to add.
+       * @return This builder for chaining.
+       */
+      public Builder addTemplates(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureTemplatesIsMutable();
+        templates_.add(value);
+        bitField0_ |= 0x00008000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @param values The templates to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllTemplates(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureTemplatesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, templates_);
+        bitField0_ |= 0x00008000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTemplates() {
+        templates_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00008000);;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * templates are applied in order after the global templates. Defaults to a
+       * template named after the group.
+       * </pre>
+       *
+       * <code>repeated string templates = 16;</code>
+       * @param value The bytes of the templates to add.
+       * @return This builder for chaining.
+       */
+      public Builder addTemplatesBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureTemplatesIsMutable();
+        templates_.add(value);
+        bitField0_ |= 0x00008000;
+        onChanged();
+        return this;
+      }
+
+      private java.util.List<eu.novusmc.athena.common.Protocol.WeightedTemplate> randomTemplates_ =
+        java.util.Collections.emptyList();
+      private void ensureRandomTemplatesIsMutable() {
+        if (!((bitField0_ & 0x00010000) != 0)) {
+          randomTemplates_ = new java.util.ArrayList<eu.novusmc.athena.common.Protocol.WeightedTemplate>(randomTemplates_);
+          bitField0_ |= 0x00010000;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilder<
+          eu.novusmc.athena.common.Protocol.WeightedTemplate, eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder, eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder> randomTemplatesBuilder_;
+
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public java.util.List<eu.novusmc.athena.common.Protocol.WeightedTemplate> getRandomTemplatesList() {
+        if (randomTemplatesBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(randomTemplates_);
+        } else {
+          return randomTemplatesBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public int getRandomTemplatesCount() {
+        if (randomTemplatesBuilder_ == null) {
+          return randomTemplates_.size();
+        } else {
+          return randomTemplatesBuilder_.getCount();
+        }
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.WeightedTemplate getRandomTemplates(int index) {
+        if (randomTemplatesBuilder_ == null) {
+          return randomTemplates_.get(index);
+        } else {
+          return randomTemplatesBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder setRandomTemplates(
+          int index, eu.novusmc.athena.common.Protocol.WeightedTemplate value) {
+        if (randomTemplatesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRandomTemplatesIsMutable();
+          randomTemplates_.set(index, value);
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder setRandomTemplates(
+          int index, eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder builderForValue) {
+        if (randomTemplatesBuilder_ == null) {
+          ensureRandomTemplatesIsMutable();
+          randomTemplates_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder addRandomTemplates(eu.novusmc.athena.common.Protocol.WeightedTemplate value) {
+        if (randomTemplatesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRandomTemplatesIsMutable();
+          randomTemplates_.add(value);
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder addRandomTemplates(
+          int index, eu.novusmc.athena.common.Protocol.WeightedTemplate value) {
+        if (randomTemplatesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRandomTemplatesIsMutable();
+          randomTemplates_.add(index, value);
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder addRandomTemplates(
+          eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder builderForValue) {
+        if (randomTemplatesBuilder_ == null) {
+          ensureRandomTemplatesIsMutable();
+          randomTemplates_.add(builderForValue.build());
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder addRandomTemplates(
+          int index, eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder builderForValue) {
+        if (randomTemplatesBuilder_ == null) {
+          ensureRandomTemplatesIsMutable();
+          randomTemplates_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder addAllRandomTemplates(
+          java.lang.Iterable<? extends eu.novusmc.athena.common.Protocol.WeightedTemplate> values) {
+        if (randomTemplatesBuilder_ == null) {
+          ensureRandomTemplatesIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, randomTemplates_);
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder clearRandomTemplates() {
+        if (randomTemplatesBuilder_ == null) {
+          randomTemplates_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00010000);
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public Builder removeRandomTemplates(int index) {
+        if (randomTemplatesBuilder_ == null) {
+          ensureRandomTemplatesIsMutable();
+          randomTemplates_.remove(index);
+          onChanged();
+        } else {
+          randomTemplatesBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder getRandomTemplatesBuilder(
+          int index) {
+        return getRandomTemplatesFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder getRandomTemplatesOrBuilder(
+          int index) {
+        if (randomTemplatesBuilder_ == null) {
+          return randomTemplates_.get(index);  } else {
+          return randomTemplatesBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public java.util.List<? extends eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder> 
+           getRandomTemplatesOrBuilderList() {
+        if (randomTemplatesBuilder_ != null) {
+          return randomTemplatesBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(randomTemplates_);
+        }
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder addRandomTemplatesBuilder() {
+        return getRandomTemplatesFieldBuilder().addBuilder(
+            eu.novusmc.athena.common.Protocol.WeightedTemplate.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder addRandomTemplatesBuilder(
+          int index) {
+        return getRandomTemplatesFieldBuilder().addBuilder(
+            index, eu.novusmc.athena.common.Protocol.WeightedTemplate.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * one of random_templates is picked for every service and applied last.
+       * </pre>
+       *
+       * <code>repeated .protocol.WeightedTemplate random_templates = 17;</code>
+       */
+      public java.util.List<eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder> 
+           getRandomTemplatesBuilderList() {
+        return getRandomTemplatesFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilder<
+          eu.novusmc.athena.common.Protocol.WeightedTemplate, eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder, eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder> 
+          getRandomTemplatesFieldBuilder() {
+        if (randomTemplatesBuilder_ == null) {
+          randomTemplatesBuilder_ = new com.google.protobuf.RepeatedFieldBuilder<
+              eu.novusmc.athena.common.Protocol.WeightedTemplate, eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder, eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder>(
+                  randomTemplates_,
+                  ((bitField0_ & 0x00010000) != 0),
+                  getParentForChildren(),
+                  isClean());
+          randomTemplates_ = null;
+        }
+        return randomTemplatesBuilder_;
+      }
+
+      private com.google.protobuf.MapField<
+          java.lang.String, java.lang.Integer> templateVersions_;
+      private com.google.protobuf.MapField<java.lang.String, java.lang.Integer>
+          internalGetTemplateVersions() {
+        if (templateVersions_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              TemplateVersionsDefaultEntryHolder.defaultEntry);
+        }
+        return templateVersions_;
+      }
+      private com.google.protobuf.MapField<java.lang.String, java.lang.Integer>
+          internalGetMutableTemplateVersions() {
+        if (templateVersions_ == null) {
+          templateVersions_ = com.google.protobuf.MapField.newMapField(
+              TemplateVersionsDefaultEntryHolder.defaultEntry);
+        }
+        if (!templateVersions_.isMutable()) {
+          templateVersions_ = templateVersions_.copy();
+        }
+        bitField0_ |= 0x00020000;
+        onChanged();
+        return templateVersions_;
+      }
+      public int getTemplateVersionsCount() {
+        return internalGetTemplateVersions().getMap().size();
+      }
+      /**
+       * <pre>
+       * template_versions pins templates to a version, all other templates follow
+       * their latest version.
+       * </pre>
+       *
+       * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+       */
+      @java.lang.Override
+      public boolean containsTemplateVersions(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        return internalGetTemplateVersions().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getTemplateVersionsMap()} instead.
+       */
+      @java.lang.Override
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.Integer> getTemplateVersions() {
+        return getTemplateVersionsMap();
+      }
+      /**
+       * <pre>
+       * template_versions pins templates to a version, all other templates follow
+       * their latest version.
+       * </pre>
+       *
+       * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+       */
+      @java.lang.Override
+      public java.util.Map<java.lang.String, java.lang.Integer> getTemplateVersionsMap() {
+        return internalGetTemplateVersions().getMap();
+      }
+      /**
+       * <pre>
+       * template_versions pins templates to a version, all other templates follow
+       * their latest version.
+       * </pre>
+       *
+       * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+       */
+      @java.lang.Override
+      public int getTemplateVersionsOrDefault(
+          java.lang.String key,
+          int defaultValue) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.Integer> map =
+            internalGetTemplateVersions().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <pre>
+       * template_versions pins templates to a version, all other templates follow
+       * their latest version.
+       * </pre>
+       *
+       * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+       */
+      @java.lang.Override
+      public int getTemplateVersionsOrThrow(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.Integer> map =
+            internalGetTemplateVersions().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+      public Builder clearTemplateVersions() {
+        bitField0_ = (bitField0_ & ~0x00020000);
+        internalGetMutableTemplateVersions().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <pre>
+       * template_versions pins templates to a version, all other templates follow
+       * their latest version.
+       * </pre>
+       *
+       * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+       */
+      public Builder removeTemplateVersions(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        internalGetMutableTemplateVersions().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.Integer>
+          getMutableTemplateVersions() {
+        bitField0_ |= 0x00020000;
+        return internalGetMutableTemplateVersions().getMutableMap();
+      }
+      /**
+       * <pre>
+       * template_versions pins templates to a version, all other templates follow
+       * their latest version.
+       * </pre>
+       *
+       * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+       */
+      public Builder putTemplateVersions(
+          java.lang.String key,
+          int value) {
+        if (key == null) { throw new NullPointerException("map key"); }
+
+        internalGetMutableTemplateVersions().getMutableMap()
+            .put(key, value);
+        bitField0_ |= 0x00020000;
+        return this;
+      }
+      /**
+       * <pre>
+       * template_versions pins templates to a version, all other templates follow
+       * their latest version.
+       * </pre>
+       *
+       * <code>map&lt;string, int32&gt; template_versions = 18;</code>
+       */
+      public Builder putAllTemplateVersions(
+          java.util.Map<java.lang.String, java.lang.Integer> values) {
+        internalGetMutableTemplateVersions().getMutableMap()
+            .putAll(values);
+        bitField0_ |= 0x00020000;
+        return this;
+      }
+
+      private int warmServices_ ;
+      /**
+       * <pre>
+       * warm_services is the number of services that are kept started in
+       * addition to the services of the group, so they can be put online
+       * instantly.
+       * </pre>
+       *
+       * <code>int32 warm_services = 19;</code>
+       * @return The warmServices.
+       */
+      @java.lang.Override
+      public int getWarmServices() {
+        return warmServices_;
+      }
+      /**
+       * <pre>
+       * warm_services is the number of services that are kept started in
+       * addition to the services of the group, so they can be put online
+       * instantly.
+       * </pre>
+       *
+       * <code>int32 warm_services = 19;</code>
+       * @param value The warmServices to set.
+       * @return This builder for chaining.
+       */
+      public Builder setWarmServices(int value) {
+
+        warmServices_ = value;
+        bitField0_ |= 0x00040000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * warm_services is the number of services that are kept started in
+       * addition to the services of the group, so they can be put online
+       * instantly.
+       * </pre>
+       *
+       * <code>int32 warm_services = 19;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearWarmServices() {
+        bitField0_ = (bitField0_ & ~0x00040000);
+        warmServices_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object fallbackGroup_ = "";
+      /**
+       * <pre>
+       * players of stopped servers are moved to the servers of fallback_group.
+       * </pre>
+       *
+       * <code>string fallback_group = 20;</code>
+       * @return The fallbackGroup.
+       */
+      public java.lang.String getFallbackGroup() {
+        java.lang.Object ref = fallbackGroup_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          fallbackGroup_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * players of stopped servers are moved to the servers of fallback_group.
+       * </pre>
+       *
+       * <code>string fallback_group = 20;</code>
+       * @return The bytes for fallbackGroup.
+       */
+      public com.google.protobuf.ByteString
+          getFallbackGroupBytes() {
+        java.lang.Object ref = fallbackGroup_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          fallbackGroup_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * players of stopped servers are moved to the servers of fallback_group.
+       * </pre>
+       *
+       * <code>string fallback_group = 20;</code>
+       * @param value The fallbackGroup to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFallbackGroup(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        fallbackGroup_ = value;
+        bitField0_ |= 0x00080000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * players of stopped servers are moved to the servers of fallback_group.
+       * </pre>
+       *
+       * <code>string fallback_group = 20;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFallbackGroup() {
+        fallbackGroup_ = getDefaultInstance().getFallbackGroup();
+        bitField0_ = (bitField0_ & ~0x00080000);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * players of stopped servers are moved to the servers of fallback_group.
+       * </pre>
+       *
+       * <code>string fallback_group = 20;</code>
+       * @param value The bytes for fallbackGroup to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFallbackGroupBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        fallbackGroup_ = value;
+        bitField0_ |= 0x00080000;
+        onChanged();
+        return this;
+      }
+
+      private int drainTimeout_ ;
+      /**
+       * <pre>
+       * drain_timeout is the number of seconds a server may take to get rid of
+       * its players before it is stopped.
+       * </pre>
+       *
+       * <code>int32 drain_timeout = 21;</code>
+       * @return The drainTimeout.
+       */
+      @java.lang.Override
+      public int getDrainTimeout() {
+        return drainTimeout_;
+      }
+      /**
+       * <pre>
+       * drain_timeout is the number of seconds a server may take to get rid of
+       * its players before it is stopped.
+       * </pre>
+       *
+       * <code>int32 drain_timeout = 21;</code>
+       * @param value The drainTimeout to set.
+       * @return This builder for chaining.
+       */
+      public Builder setDrainTimeout(int value) {
+
+        drainTimeout_ = value;
+        bitField0_ |= 0x00100000;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * drain_timeout is the number of seconds a server may take to get rid of
+       * its players before it is stopped.
+       * </pre>
+       *
+       * <code>int32 drain_timeout = 21;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearDrainTimeout() {
+        bitField0_ = (bitField0_ & ~0x00100000);
+        drainTimeout_ = 0;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.Group)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.Group)
+    private static final eu.novusmc.athena.common.Protocol.Group DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.Group();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Group getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<Group>
+        PARSER = new com.google.protobuf.AbstractParser<Group>() {
+      @java.lang.Override
+      public Group parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<Group> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<Group> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.Group getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface WeightedTemplateOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.WeightedTemplate)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+
+    /**
+     * <code>int32 weight = 2;</code>
+     * @return The weight.
+     */
+    int getWeight();
+  }
+  /**
+   * Protobuf type {@code protocol.WeightedTemplate}
+   */
+  public static final class WeightedTemplate extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.WeightedTemplate)
+      WeightedTemplateOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        WeightedTemplate.class.getName());
+    }
+    // Use WeightedTemplate.newBuilder() to construct.
+    private WeightedTemplate(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private WeightedTemplate() {
+      name_ = "";
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_WeightedTemplate_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_WeightedTemplate_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.WeightedTemplate.class, eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder.class);
+    }
+
+    public static final int NAME_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object name_ = "";
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    @java.lang.Override
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int WEIGHT_FIELD_NUMBER = 2;
+    private int weight_ = 0;
+    /**
+     * <code>int32 weight = 2;</code>
+     * @return The weight.
+     */
+    @java.lang.Override
+    public int getWeight() {
+      return weight_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, name_);
+      }
+      if (weight_ != 0) {
+        output.writeInt32(2, weight_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, name_);
+      }
+      if (weight_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(2, weight_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.WeightedTemplate)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.WeightedTemplate other = (eu.novusmc.athena.common.Protocol.WeightedTemplate) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (getWeight()
+          != other.getWeight()) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
       hash = (53 * hash) + getName().hashCode();
-      hash = (37 * hash) + TYPE_FIELD_NUMBER;
-      hash = (53 * hash) + type_;
-      hash = (37 * hash) + MIN_SERVICES_FIELD_NUMBER;
-      hash = (53 * hash) + getMinServices();
-      hash = (37 * hash) + MAX_SERVICES_FIELD_NUMBER;
-      hash = (53 * hash) + getMaxServices();
+      hash = (37 * hash) + WEIGHT_FIELD_NUMBER;
+      hash = (53 * hash) + getWeight();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.WeightedTemplate prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.WeightedTemplate}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.WeightedTemplate)
+        eu.novusmc.athena.common.Protocol.WeightedTemplateOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_WeightedTemplate_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_WeightedTemplate_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.WeightedTemplate.class, eu.novusmc.athena.common.Protocol.WeightedTemplate.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.WeightedTemplate.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        name_ = "";
+        weight_ = 0;
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_WeightedTemplate_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.WeightedTemplate getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.WeightedTemplate.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.WeightedTemplate build() {
+        eu.novusmc.athena.common.Protocol.WeightedTemplate result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.WeightedTemplate buildPartial() {
+        eu.novusmc.athena.common.Protocol.WeightedTemplate result = new eu.novusmc.athena.common.Protocol.WeightedTemplate(this);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.WeightedTemplate result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.name_ = name_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.weight_ = weight_;
+        }
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.WeightedTemplate) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.WeightedTemplate)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.WeightedTemplate other) {
+        if (other == eu.novusmc.athena.common.Protocol.WeightedTemplate.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        if (other.getWeight() != 0) {
+          setWeight(other.getWeight());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                name_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              case 16: {
+                weight_ = input.readInt32();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 16
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        name_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+        name_ = getDefaultInstance().getName();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        name_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+
+      private int weight_ ;
+      /**
+       * <code>int32 weight = 2;</code>
+       * @return The weight.
+       */
+      @java.lang.Override
+      public int getWeight() {
+        return weight_;
+      }
+      /**
+       * <code>int32 weight = 2;</code>
+       * @param value The weight to set.
+       * @return This builder for chaining.
+       */
+      public Builder setWeight(int value) {
+
+        weight_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 weight = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearWeight() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        weight_ = 0;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.WeightedTemplate)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.WeightedTemplate)
+    private static final eu.novusmc.athena.common.Protocol.WeightedTemplate DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.WeightedTemplate();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.WeightedTemplate getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<WeightedTemplate>
+        PARSER = new com.google.protobuf.AbstractParser<WeightedTemplate>() {
+      @java.lang.Override
+      public WeightedTemplate parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<WeightedTemplate> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<WeightedTemplate> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.WeightedTemplate getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface EnvelopeOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.Envelope)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.google.protobuf.Any payload = 1;</code>
+     * @return Whether the payload field is set.
+     */
+    boolean hasPayload();
+    /**
+     * <code>.google.protobuf.Any payload = 1;</code>
+     * @return The payload.
+     */
+    com.google.protobuf.Any getPayload();
+    /**
+     * <code>.google.protobuf.Any payload = 1;</code>
+     */
+    com.google.protobuf.AnyOrBuilder getPayloadOrBuilder();
+
+    /**
+     * <pre>
+     * request_id is set if the sender expects a PacketReply.
+     * </pre>
+     *
+     * <code>uint64 request_id = 2;</code>
+     * @return The requestId.
+     */
+    long getRequestId();
+  }
+  /**
+   * Protobuf type {@code protocol.Envelope}
+   */
+  public static final class Envelope extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.Envelope)
+      EnvelopeOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        Envelope.class.getName());
+    }
+    // Use Envelope.newBuilder() to construct.
+    private Envelope(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private Envelope() {
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.Envelope.class, eu.novusmc.athena.common.Protocol.Envelope.Builder.class);
+    }
+
+    private int bitField0_;
+    public static final int PAYLOAD_FIELD_NUMBER = 1;
+    private com.google.protobuf.Any payload_;
+    /**
+     * <code>.google.protobuf.Any payload = 1;</code>
+     * @return Whether the payload field is set.
+     */
+    @java.lang.Override
+    public boolean hasPayload() {
+      return ((bitField0_ & 0x00000001) != 0);
+    }
+    /**
+     * <code>.google.protobuf.Any payload = 1;</code>
+     * @return The payload.
+     */
+    @java.lang.Override
+    public com.google.protobuf.Any getPayload() {
+      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+    }
+    /**
+     * <code>.google.protobuf.Any payload = 1;</code>
+     */
+    @java.lang.Override
+    public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
+      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+    }
+
+    public static final int REQUEST_ID_FIELD_NUMBER = 2;
+    private long requestId_ = 0L;
+    /**
+     * <pre>
+     * request_id is set if the sender expects a PacketReply.
+     * </pre>
+     *
+     * <code>uint64 request_id = 2;</code>
+     * @return The requestId.
+     */
+    @java.lang.Override
+    public long getRequestId() {
+      return requestId_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (((bitField0_ & 0x00000001) != 0)) {
+        output.writeMessage(1, getPayload());
+      }
+      if (requestId_ != 0L) {
+        output.writeUInt64(2, requestId_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (((bitField0_ & 0x00000001) != 0)) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, getPayload());
+      }
+      if (requestId_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(2, requestId_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.Envelope)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.Envelope other = (eu.novusmc.athena.common.Protocol.Envelope) obj;
+
+      if (hasPayload() != other.hasPayload()) return false;
+      if (hasPayload()) {
+        if (!getPayload()
+            .equals(other.getPayload())) return false;
+      }
+      if (getRequestId()
+          != other.getRequestId()) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (hasPayload()) {
+        hash = (37 * hash) + PAYLOAD_FIELD_NUMBER;
+        hash = (53 * hash) + getPayload().hashCode();
+      }
+      hash = (37 * hash) + REQUEST_ID_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getRequestId());
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Envelope parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Envelope parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.Envelope prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.Envelope}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.Envelope)
+        eu.novusmc.athena.common.Protocol.EnvelopeOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.Envelope.class, eu.novusmc.athena.common.Protocol.Envelope.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.Envelope.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessage
+                .alwaysUseFieldBuilders) {
+          getPayloadFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        payload_ = null;
+        if (payloadBuilder_ != null) {
+          payloadBuilder_.dispose();
+          payloadBuilder_ = null;
+        }
+        requestId_ = 0L;
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.Envelope getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.Envelope.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.Envelope build() {
+        eu.novusmc.athena.common.Protocol.Envelope result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.Envelope buildPartial() {
+        eu.novusmc.athena.common.Protocol.Envelope result = new eu.novusmc.athena.common.Protocol.Envelope(this);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.Envelope result) {
+        int from_bitField0_ = bitField0_;
+        int to_bitField0_ = 0;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.payload_ = payloadBuilder_ == null
+              ? payload_
+              : payloadBuilder_.build();
+          to_bitField0_ |= 0x00000001;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.requestId_ = requestId_;
+        }
+        result.bitField0_ |= to_bitField0_;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.Envelope) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.Envelope)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.Envelope other) {
+        if (other == eu.novusmc.athena.common.Protocol.Envelope.getDefaultInstance()) return this;
+        if (other.hasPayload()) {
+          mergePayload(other.getPayload());
+        }
+        if (other.getRequestId() != 0L) {
+          setRequestId(other.getRequestId());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                input.readMessage(
+                    getPayloadFieldBuilder().getBuilder(),
+                    extensionRegistry);
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              case 16: {
+                requestId_ = input.readUInt64();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 16
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private com.google.protobuf.Any payload_;
+      private com.google.protobuf.SingleFieldBuilder<
+          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> payloadBuilder_;
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       * @return Whether the payload field is set.
+       */
+      public boolean hasPayload() {
+        return ((bitField0_ & 0x00000001) != 0);
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       * @return The payload.
+       */
+      public com.google.protobuf.Any getPayload() {
+        if (payloadBuilder_ == null) {
+          return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+        } else {
+          return payloadBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       */
+      public Builder setPayload(com.google.protobuf.Any value) {
+        if (payloadBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          payload_ = value;
+        } else {
+          payloadBuilder_.setMessage(value);
+        }
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       */
+      public Builder setPayload(
+          com.google.protobuf.Any.Builder builderForValue) {
+        if (payloadBuilder_ == null) {
+          payload_ = builderForValue.build();
+        } else {
+          payloadBuilder_.setMessage(builderForValue.build());
+        }
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       */
+      public Builder mergePayload(com.google.protobuf.Any value) {
+        if (payloadBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0) &&
+            payload_ != null &&
+            payload_ != com.google.protobuf.Any.getDefaultInstance()) {
+            getPayloadBuilder().mergeFrom(value);
+          } else {
+            payload_ = value;
+          }
+        } else {
+          payloadBuilder_.mergeFrom(value);
+        }
+        if (payload_ != null) {
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       */
+      public Builder clearPayload() {
+        bitField0_ = (bitField0_ & ~0x00000001);
+        payload_ = null;
+        if (payloadBuilder_ != null) {
+          payloadBuilder_.dispose();
+          payloadBuilder_ = null;
+        }
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       */
+      public com.google.protobuf.Any.Builder getPayloadBuilder() {
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return getPayloadFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       */
+      public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
+        if (payloadBuilder_ != null) {
+          return payloadBuilder_.getMessageOrBuilder();
+        } else {
+          return payload_ == null ?
+              com.google.protobuf.Any.getDefaultInstance() : payload_;
+        }
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 1;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilder<
+          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> 
+          getPayloadFieldBuilder() {
+        if (payloadBuilder_ == null) {
+          payloadBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+              com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder>(
+                  getPayload(),
+                  getParentForChildren(),
+                  isClean());
+          payload_ = null;
+        }
+        return payloadBuilder_;
+      }
+
+      private long requestId_ ;
+      /**
+       * <pre>
+       * request_id is set if the sender expects a PacketReply.
+       * </pre>
+       *
+       * <code>uint64 request_id = 2;</code>
+       * @return The requestId.
+       */
+      @java.lang.Override
+      public long getRequestId() {
+        return requestId_;
+      }
+      /**
+       * <pre>
+       * request_id is set if the sender expects a PacketReply.
+       * </pre>
+       *
+       * <code>uint64 request_id = 2;</code>
+       * @param value The requestId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRequestId(long value) {
+
+        requestId_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * request_id is set if the sender expects a PacketReply.
+       * </pre>
+       *
+       * <code>uint64 request_id = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRequestId() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        requestId_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.Envelope)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.Envelope)
+    private static final eu.novusmc.athena.common.Protocol.Envelope DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.Envelope();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.Envelope getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<Envelope>
+        PARSER = new com.google.protobuf.AbstractParser<Envelope>() {
+      @java.lang.Override
+      public Envelope parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<Envelope> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<Envelope> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.Envelope getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ServiceEnvelopeOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.ServiceEnvelope)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
+     */
+    java.lang.String getServiceName();
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
+     */
+    com.google.protobuf.ByteString
+        getServiceNameBytes();
+
+    /**
+     * <code>.google.protobuf.Any payload = 2;</code>
+     * @return Whether the payload field is set.
+     */
+    boolean hasPayload();
+    /**
+     * <code>.google.protobuf.Any payload = 2;</code>
+     * @return The payload.
+     */
+    com.google.protobuf.Any getPayload();
+    /**
+     * <code>.google.protobuf.Any payload = 2;</code>
+     */
+    com.google.protobuf.AnyOrBuilder getPayloadOrBuilder();
+  }
+  /**
+   * Protobuf type {@code protocol.ServiceEnvelope}
+   */
+  public static final class ServiceEnvelope extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.ServiceEnvelope)
+      ServiceEnvelopeOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        ServiceEnvelope.class.getName());
+    }
+    // Use ServiceEnvelope.newBuilder() to construct.
+    private ServiceEnvelope(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private ServiceEnvelope() {
+      serviceName_ = "";
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.ServiceEnvelope.class, eu.novusmc.athena.common.Protocol.ServiceEnvelope.Builder.class);
+    }
+
+    private int bitField0_;
+    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object serviceName_ = "";
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
+     */
+    @java.lang.Override
+    public java.lang.String getServiceName() {
+      java.lang.Object ref = serviceName_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        serviceName_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getServiceNameBytes() {
+      java.lang.Object ref = serviceName_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        serviceName_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int PAYLOAD_FIELD_NUMBER = 2;
+    private com.google.protobuf.Any payload_;
+    /**
+     * <code>.google.protobuf.Any payload = 2;</code>
+     * @return Whether the payload field is set.
+     */
+    @java.lang.Override
+    public boolean hasPayload() {
+      return ((bitField0_ & 0x00000001) != 0);
+    }
+    /**
+     * <code>.google.protobuf.Any payload = 2;</code>
+     * @return The payload.
+     */
+    @java.lang.Override
+    public com.google.protobuf.Any getPayload() {
+      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+    }
+    /**
+     * <code>.google.protobuf.Any payload = 2;</code>
+     */
+    @java.lang.Override
+    public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
+      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      }
+      if (((bitField0_ & 0x00000001) != 0)) {
+        output.writeMessage(2, getPayload());
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      }
+      if (((bitField0_ & 0x00000001) != 0)) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(2, getPayload());
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.ServiceEnvelope)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.ServiceEnvelope other = (eu.novusmc.athena.common.Protocol.ServiceEnvelope) obj;
+
+      if (!getServiceName()
+          .equals(other.getServiceName())) return false;
+      if (hasPayload() != other.hasPayload()) return false;
+      if (hasPayload()) {
+        if (!getPayload()
+            .equals(other.getPayload())) return false;
+      }
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServiceName().hashCode();
+      if (hasPayload()) {
+        hash = (37 * hash) + PAYLOAD_FIELD_NUMBER;
+        hash = (53 * hash) + getPayload().hashCode();
+      }
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.ServiceEnvelope prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.ServiceEnvelope}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.ServiceEnvelope)
+        eu.novusmc.athena.common.Protocol.ServiceEnvelopeOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.ServiceEnvelope.class, eu.novusmc.athena.common.Protocol.ServiceEnvelope.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.ServiceEnvelope.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessage
+                .alwaysUseFieldBuilders) {
+          getPayloadFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        serviceName_ = "";
+        payload_ = null;
+        if (payloadBuilder_ != null) {
+          payloadBuilder_.dispose();
+          payloadBuilder_ = null;
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.ServiceEnvelope getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.ServiceEnvelope.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.ServiceEnvelope build() {
+        eu.novusmc.athena.common.Protocol.ServiceEnvelope result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.ServiceEnvelope buildPartial() {
+        eu.novusmc.athena.common.Protocol.ServiceEnvelope result = new eu.novusmc.athena.common.Protocol.ServiceEnvelope(this);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.ServiceEnvelope result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.serviceName_ = serviceName_;
+        }
+        int to_bitField0_ = 0;
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.payload_ = payloadBuilder_ == null
+              ? payload_
+              : payloadBuilder_.build();
+          to_bitField0_ |= 0x00000001;
+        }
+        result.bitField0_ |= to_bitField0_;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.ServiceEnvelope) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.ServiceEnvelope)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.ServiceEnvelope other) {
+        if (other == eu.novusmc.athena.common.Protocol.ServiceEnvelope.getDefaultInstance()) return this;
+        if (!other.getServiceName().isEmpty()) {
+          serviceName_ = other.serviceName_;
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        if (other.hasPayload()) {
+          mergePayload(other.getPayload());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                serviceName_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              case 18: {
+                input.readMessage(
+                    getPayloadFieldBuilder().getBuilder(),
+                    extensionRegistry);
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object serviceName_ = "";
+      /**
+       * <code>string service_name = 1;</code>
+       * @return The serviceName.
+       */
+      public java.lang.String getServiceName() {
+        java.lang.Object ref = serviceName_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          serviceName_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @return The bytes for serviceName.
+       */
+      public com.google.protobuf.ByteString
+          getServiceNameBytes() {
+        java.lang.Object ref = serviceName_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          serviceName_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @param value The serviceName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setServiceName(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        serviceName_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearServiceName() {
+        serviceName_ = getDefaultInstance().getServiceName();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @param value The bytes for serviceName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setServiceNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        serviceName_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.Any payload_;
+      private com.google.protobuf.SingleFieldBuilder<
+          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> payloadBuilder_;
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       * @return Whether the payload field is set.
+       */
+      public boolean hasPayload() {
+        return ((bitField0_ & 0x00000002) != 0);
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       * @return The payload.
+       */
+      public com.google.protobuf.Any getPayload() {
+        if (payloadBuilder_ == null) {
+          return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+        } else {
+          return payloadBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       */
+      public Builder setPayload(com.google.protobuf.Any value) {
+        if (payloadBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          payload_ = value;
+        } else {
+          payloadBuilder_.setMessage(value);
+        }
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       */
+      public Builder setPayload(
+          com.google.protobuf.Any.Builder builderForValue) {
+        if (payloadBuilder_ == null) {
+          payload_ = builderForValue.build();
+        } else {
+          payloadBuilder_.setMessage(builderForValue.build());
+        }
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       */
+      public Builder mergePayload(com.google.protobuf.Any value) {
+        if (payloadBuilder_ == null) {
+          if (((bitField0_ & 0x00000002) != 0) &&
+            payload_ != null &&
+            payload_ != com.google.protobuf.Any.getDefaultInstance()) {
+            getPayloadBuilder().mergeFrom(value);
+          } else {
+            payload_ = value;
+          }
+        } else {
+          payloadBuilder_.mergeFrom(value);
+        }
+        if (payload_ != null) {
+          bitField0_ |= 0x00000002;
+          onChanged();
+        }
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       */
+      public Builder clearPayload() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        payload_ = null;
+        if (payloadBuilder_ != null) {
+          payloadBuilder_.dispose();
+          payloadBuilder_ = null;
+        }
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       */
+      public com.google.protobuf.Any.Builder getPayloadBuilder() {
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return getPayloadFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       */
+      public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
+        if (payloadBuilder_ != null) {
+          return payloadBuilder_.getMessageOrBuilder();
+        } else {
+          return payload_ == null ?
+              com.google.protobuf.Any.getDefaultInstance() : payload_;
+        }
+      }
+      /**
+       * <code>.google.protobuf.Any payload = 2;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilder<
+          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> 
+          getPayloadFieldBuilder() {
+        if (payloadBuilder_ == null) {
+          payloadBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+              com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder>(
+                  getPayload(),
+                  getParentForChildren(),
+                  isClean());
+          payload_ = null;
+        }
+        return payloadBuilder_;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.ServiceEnvelope)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.ServiceEnvelope)
+    private static final eu.novusmc.athena.common.Protocol.ServiceEnvelope DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.ServiceEnvelope();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ServiceEnvelope>
+        PARSER = new com.google.protobuf.AbstractParser<ServiceEnvelope>() {
+      @java.lang.Override
+      public ServiceEnvelope parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<ServiceEnvelope> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ServiceEnvelope> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.ServiceEnvelope getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PacketAuthenticateOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketAuthenticate)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string slave_name = 1;</code>
+     * @return The slaveName.
+     */
+    java.lang.String getSlaveName();
+    /**
+     * <code>string slave_name = 1;</code>
+     * @return The bytes for slaveName.
+     */
+    com.google.protobuf.ByteString
+        getSlaveNameBytes();
+
+    /**
+     * <code>string secret_key = 2;</code>
+     * @return The secretKey.
+     */
+    java.lang.String getSecretKey();
+    /**
+     * <code>string secret_key = 2;</code>
+     * @return The bytes for secretKey.
+     */
+    com.google.protobuf.ByteString
+        getSecretKeyBytes();
+
+    /**
+     * <code>int32 memory = 3;</code>
+     * @return The memory.
+     */
+    int getMemory();
+
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    int getLabelsCount();
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    boolean containsLabels(
+        java.lang.String key);
+    /**
+     * Use {@link #getLabelsMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.String>
+    getLabels();
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.String>
+    getLabelsMap();
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    /* nullable */
+java.lang.String getLabelsOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue);
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    java.lang.String getLabelsOrThrow(
+        java.lang.String key);
+
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    java.util.List<eu.novusmc.athena.common.Protocol.Service> 
+        getServicesList();
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    eu.novusmc.athena.common.Protocol.Service getServices(int index);
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    int getServicesCount();
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    java.util.List<? extends eu.novusmc.athena.common.Protocol.ServiceOrBuilder> 
+        getServicesOrBuilderList();
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServicesOrBuilder(
+        int index);
+
+    /**
+     * <code>int32 protocol_version = 6;</code>
+     * @return The protocolVersion.
+     */
+    int getProtocolVersion();
+
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @return A list containing the capabilities.
+     */
+    java.util.List<java.lang.String>
+        getCapabilitiesList();
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @return The count of capabilities.
+     */
+    int getCapabilitiesCount();
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @param index The index of the element to return.
+     * @return The capabilities at the given index.
+     */
+    java.lang.String getCapabilities(int index);
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the capabilities at the given index.
+     */
+    com.google.protobuf.ByteString
+        getCapabilitiesBytes(int index);
+  }
+  /**
+   * Protobuf type {@code protocol.PacketAuthenticate}
+   */
+  public static final class PacketAuthenticate extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.PacketAuthenticate)
+      PacketAuthenticateOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        PacketAuthenticate.class.getName());
+    }
+    // Use PacketAuthenticate.newBuilder() to construct.
+    private PacketAuthenticate(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private PacketAuthenticate() {
+      slaveName_ = "";
+      secretKey_ = "";
+      services_ = java.util.Collections.emptyList();
+      capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_descriptor;
+    }
+
+    @SuppressWarnings({"rawtypes"})
+    @java.lang.Override
+    protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+        int number) {
+      switch (number) {
+        case 4:
+          return internalGetLabels();
+        default:
+          throw new RuntimeException(
+              "Invalid map field number: " + number);
+      }
+    }
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketAuthenticate.class, eu.novusmc.athena.common.Protocol.PacketAuthenticate.Builder.class);
+    }
+
+    public static final int SLAVE_NAME_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object slaveName_ = "";
+    /**
+     * <code>string slave_name = 1;</code>
+     * @return The slaveName.
+     */
+    @java.lang.Override
+    public java.lang.String getSlaveName() {
+      java.lang.Object ref = slaveName_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        slaveName_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string slave_name = 1;</code>
+     * @return The bytes for slaveName.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getSlaveNameBytes() {
+      java.lang.Object ref = slaveName_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        slaveName_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int SECRET_KEY_FIELD_NUMBER = 2;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object secretKey_ = "";
+    /**
+     * <code>string secret_key = 2;</code>
+     * @return The secretKey.
+     */
+    @java.lang.Override
+    public java.lang.String getSecretKey() {
+      java.lang.Object ref = secretKey_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        secretKey_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string secret_key = 2;</code>
+     * @return The bytes for secretKey.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getSecretKeyBytes() {
+      java.lang.Object ref = secretKey_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        secretKey_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int MEMORY_FIELD_NUMBER = 3;
+    private int memory_ = 0;
+    /**
+     * <code>int32 memory = 3;</code>
+     * @return The memory.
+     */
+    @java.lang.Override
+    public int getMemory() {
+      return memory_;
+    }
+
+    public static final int LABELS_FIELD_NUMBER = 4;
+    private static final class LabelsDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.String, java.lang.String> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.String, java.lang.String>newDefaultInstance(
+                  eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_LabelsEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "",
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "");
+    }
+    @SuppressWarnings("serial")
+    private com.google.protobuf.MapField<
+        java.lang.String, java.lang.String> labels_;
+    private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+    internalGetLabels() {
+      if (labels_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            LabelsDefaultEntryHolder.defaultEntry);
+      }
+      return labels_;
+    }
+    public int getLabelsCount() {
+      return internalGetLabels().getMap().size();
+    }
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    @java.lang.Override
+    public boolean containsLabels(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      return internalGetLabels().getMap().containsKey(key);
+    }
+    /**
+     * Use {@link #getLabelsMap()} instead.
+     */
+    @java.lang.Override
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.String, java.lang.String> getLabels() {
+      return getLabelsMap();
+    }
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    @java.lang.Override
+    public java.util.Map<java.lang.String, java.lang.String> getLabelsMap() {
+      return internalGetLabels().getMap();
+    }
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    @java.lang.Override
+    public /* nullable */
+java.lang.String getLabelsOrDefault(
+        java.lang.String key,
+        /* nullable */
+java.lang.String defaultValue) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetLabels().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
+    }
+    /**
+     * <code>map&lt;string, string&gt; labels = 4;</code>
+     */
+    @java.lang.Override
+    public java.lang.String getLabelsOrThrow(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.String> map =
+          internalGetLabels().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
+      }
+      return map.get(key);
+    }
+
+    public static final int SERVICES_FIELD_NUMBER = 5;
+    @SuppressWarnings("serial")
+    private java.util.List<eu.novusmc.athena.common.Protocol.Service> services_;
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    @java.lang.Override
+    public java.util.List<eu.novusmc.athena.common.Protocol.Service> getServicesList() {
+      return services_;
+    }
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends eu.novusmc.athena.common.Protocol.ServiceOrBuilder> 
+        getServicesOrBuilderList() {
+      return services_;
+    }
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    @java.lang.Override
+    public int getServicesCount() {
+      return services_.size();
+    }
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.Service getServices(int index) {
+      return services_.get(index);
+    }
+    /**
+     * <code>repeated .protocol.Service services = 5;</code>
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServicesOrBuilder(
+        int index) {
+      return services_.get(index);
+    }
+
+    public static final int PROTOCOL_VERSION_FIELD_NUMBER = 6;
+    private int protocolVersion_ = 0;
+    /**
+     * <code>int32 protocol_version = 6;</code>
+     * @return The protocolVersion.
+     */
+    @java.lang.Override
+    public int getProtocolVersion() {
+      return protocolVersion_;
+    }
+
+    public static final int CAPABILITIES_FIELD_NUMBER = 7;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList capabilities_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @return A list containing the capabilities.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getCapabilitiesList() {
+      return capabilities_;
+    }
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @return The count of capabilities.
+     */
+    public int getCapabilitiesCount() {
+      return capabilities_.size();
+    }
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @param index The index of the element to return.
+     * @return The capabilities at the given index.
+     */
+    public java.lang.String getCapabilities(int index) {
+      return capabilities_.get(index);
+    }
+    /**
+     * <code>repeated string capabilities = 7;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the capabilities at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getCapabilitiesBytes(int index) {
+      return capabilities_.getByteString(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(slaveName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, slaveName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(secretKey_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 2, secretKey_);
+      }
+      if (memory_ != 0) {
+        output.writeInt32(3, memory_);
+      }
+      com.google.protobuf.GeneratedMessage
+        .serializeStringMapTo(
+          output,
+          internalGetLabels(),
+          LabelsDefaultEntryHolder.defaultEntry,
+          4);
+      for (int i = 0; i < services_.size(); i++) {
+        output.writeMessage(5, services_.get(i));
+      }
+      if (protocolVersion_ != 0) {
+        output.writeInt32(6, protocolVersion_);
+      }
+      for (int i = 0; i < capabilities_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 7, capabilities_.getRaw(i));
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(slaveName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, slaveName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(secretKey_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, secretKey_);
+      }
+      if (memory_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(3, memory_);
+      }
+      for (java.util.Map.Entry<java.lang.String, java.lang.String> entry
+           : internalGetLabels().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+        labels__ = LabelsDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
+        size += com.google.protobuf.CodedOutputStream
+            .computeMessageSize(4, labels__);
+      }
+      for (int i = 0; i < services_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(5, services_.get(i));
+      }
+      if (protocolVersion_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(6, protocolVersion_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < capabilities_.size(); i++) {
+          dataSize += computeStringSizeNoTag(capabilities_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getCapabilitiesList().size();
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAuthenticate)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.PacketAuthenticate other = (eu.novusmc.athena.common.Protocol.PacketAuthenticate) obj;
+
+      if (!getSlaveName()
+          .equals(other.getSlaveName())) return false;
+      if (!getSecretKey()
+          .equals(other.getSecretKey())) return false;
+      if (getMemory()
+          != other.getMemory()) return false;
+      if (!internalGetLabels().equals(
+          other.internalGetLabels())) return false;
+      if (!getServicesList()
+          .equals(other.getServicesList())) return false;
+      if (getProtocolVersion()
+          != other.getProtocolVersion()) return false;
+      if (!getCapabilitiesList()
+          .equals(other.getCapabilitiesList())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + SLAVE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getSlaveName().hashCode();
+      hash = (37 * hash) + SECRET_KEY_FIELD_NUMBER;
+      hash = (53 * hash) + getSecretKey().hashCode();
       hash = (37 * hash) + MEMORY_FIELD_NUMBER;
       hash = (53 * hash) + getMemory();
-      hash = (37 * hash) + START_PORT_FIELD_NUMBER;
-      hash = (53 * hash) + getStartPort();
+      if (!internalGetLabels().getMap().isEmpty()) {
+        hash = (37 * hash) + LABELS_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetLabels().hashCode();
+      }
+      if (getServicesCount() > 0) {
+        hash = (37 * hash) + SERVICES_FIELD_NUMBER;
+        hash = (53 * hash) + getServicesList().hashCode();
+      }
+      hash = (37 * hash) + PROTOCOL_VERSION_FIELD_NUMBER;
+      hash = (53 * hash) + getProtocolVersion();
+      if (getCapabilitiesCount() > 0) {
+        hash = (37 * hash) + CAPABILITIES_FIELD_NUMBER;
+        hash = (53 * hash) + getCapabilitiesList().hashCode();
+      }
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAuthenticate prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.PacketAuthenticate}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.PacketAuthenticate)
+        eu.novusmc.athena.common.Protocol.PacketAuthenticateOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_descriptor;
+      }
+
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 4:
+            return internalGetLabels();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMutableMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 4:
+            return internalGetMutableLabels();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.PacketAuthenticate.class, eu.novusmc.athena.common.Protocol.PacketAuthenticate.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.PacketAuthenticate.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessage
+                .alwaysUseFieldBuilders) {
+          getServicesFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        slaveName_ = "";
+        secretKey_ = "";
+        memory_ = 0;
+        internalGetMutableLabels().clear();
+        if (servicesBuilder_ == null) {
+          services_ = java.util.Collections.emptyList();
+        } else {
+          services_ = null;
+          servicesBuilder_.clear();
+        }
+        bitField0_ = (bitField0_ & ~0x00000010);
+        protocolVersion_ = 0;
+        capabilities_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthenticate getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketAuthenticate.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthenticate build() {
+        eu.novusmc.athena.common.Protocol.PacketAuthenticate result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthenticate buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketAuthenticate result = new eu.novusmc.athena.common.Protocol.PacketAuthenticate(this);
+        buildPartialRepeatedFields(result);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartialRepeatedFields(eu.novusmc.athena.common.Protocol.PacketAuthenticate result) {
+        if (servicesBuilder_ == null) {
+          if (((bitField0_ & 0x00000010) != 0)) {
+            services_ = java.util.Collections.unmodifiableList(services_);
+            bitField0_ = (bitField0_ & ~0x00000010);
+          }
+          result.services_ = services_;
+        } else {
+          result.services_ = servicesBuilder_.build();
+        }
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketAuthenticate result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.slaveName_ = slaveName_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.secretKey_ = secretKey_;
+        }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          result.memory_ = memory_;
+        }
+        if (((from_bitField0_ & 0x00000008) != 0)) {
+          result.labels_ = internalGetLabels();
+          result.labels_.makeImmutable();
+        }
+        if (((from_bitField0_ & 0x00000020) != 0)) {
+          result.protocolVersion_ = protocolVersion_;
+        }
+        if (((from_bitField0_ & 0x00000040) != 0)) {
+          capabilities_.makeImmutable();
+          result.capabilities_ = capabilities_;
+        }
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAuthenticate) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAuthenticate)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAuthenticate other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketAuthenticate.getDefaultInstance()) return this;
+        if (!other.getSlaveName().isEmpty()) {
+          slaveName_ = other.slaveName_;
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        if (!other.getSecretKey().isEmpty()) {
+          secretKey_ = other.secretKey_;
+          bitField0_ |= 0x00000002;
+          onChanged();
+        }
+        if (other.getMemory() != 0) {
+          setMemory(other.getMemory());
+        }
+        internalGetMutableLabels().mergeFrom(
+            other.internalGetLabels());
+        bitField0_ |= 0x00000008;
+        if (servicesBuilder_ == null) {
+          if (!other.services_.isEmpty()) {
+            if (services_.isEmpty()) {
+              services_ = other.services_;
+              bitField0_ = (bitField0_ & ~0x00000010);
+            } else {
+              ensureServicesIsMutable();
+              services_.addAll(other.services_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.services_.isEmpty()) {
+            if (servicesBuilder_.isEmpty()) {
+              servicesBuilder_.dispose();
+              servicesBuilder_ = null;
+              services_ = other.services_;
+              bitField0_ = (bitField0_ & ~0x00000010);
+              servicesBuilder_ =
+                com.google.protobuf.GeneratedMessage.alwaysUseFieldBuilders ?
+                   getServicesFieldBuilder() : null;
+            } else {
+              servicesBuilder_.addAllMessages(other.services_);
+            }
+          }
+        }
+        if (other.getProtocolVersion() != 0) {
+          setProtocolVersion(other.getProtocolVersion());
+        }
+        if (!other.capabilities_.isEmpty()) {
+          if (capabilities_.isEmpty()) {
+            capabilities_ = other.capabilities_;
+            bitField0_ |= 0x00000040;
+          } else {
+            ensureCapabilitiesIsMutable();
+            capabilities_.addAll(other.capabilities_);
+          }
+          onChanged();
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                slaveName_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              case 18: {
+                secretKey_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
+              case 24: {
+                memory_ = input.readInt32();
+                bitField0_ |= 0x00000004;
+                break;
+              } // case 24
+              case 34: {
+                com.google.protobuf.MapEntry<java.lang.String, java.lang.String>
+                labels__ = input.readMessage(
+                    LabelsDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+                internalGetMutableLabels().getMutableMap().put(
+                    labels__.getKey(), labels__.getValue());
+                bitField0_ |= 0x00000008;
+                break;
+              } // case 34
+              case 42: {
+                eu.novusmc.athena.common.Protocol.Service m =
+                    input.readMessage(
+                        eu.novusmc.athena.common.Protocol.Service.parser(),
+                        extensionRegistry);
+                if (servicesBuilder_ == null) {
+                  ensureServicesIsMutable();
+                  services_.add(m);
+                } else {
+                  servicesBuilder_.addMessage(m);
+                }
+                break;
+              } // case 42
+              case 48: {
+                protocolVersion_ = input.readInt32();
+                bitField0_ |= 0x00000020;
+                break;
+              } // case 48
+              case 58: {
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureCapabilitiesIsMutable();
+                capabilities_.add(s);
+                break;
+              } // case 58
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object slaveName_ = "";
+      /**
+       * <code>string slave_name = 1;</code>
+       * @return The slaveName.
+       */
+      public java.lang.String getSlaveName() {
+        java.lang.Object ref = slaveName_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          slaveName_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string slave_name = 1;</code>
+       * @return The bytes for slaveName.
+       */
+      public com.google.protobuf.ByteString
+          getSlaveNameBytes() {
+        java.lang.Object ref = slaveName_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          slaveName_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string slave_name = 1;</code>
+       * @param value The slaveName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSlaveName(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        slaveName_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string slave_name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSlaveName() {
+        slaveName_ = getDefaultInstance().getSlaveName();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string slave_name = 1;</code>
+       * @param value The bytes for slaveName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSlaveNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        slaveName_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object secretKey_ = "";
+      /**
+       * <code>string secret_key = 2;</code>
+       * @return The secretKey.
+       */
+      public java.lang.String getSecretKey() {
+        java.lang.Object ref = secretKey_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          secretKey_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string secret_key = 2;</code>
+       * @return The bytes for secretKey.
+       */
+      public com.google.protobuf.ByteString
+          getSecretKeyBytes() {
+        java.lang.Object ref = secretKey_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          secretKey_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string secret_key = 2;</code>
+       * @param value The secretKey to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSecretKey(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        secretKey_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string secret_key = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSecretKey() {
+        secretKey_ = getDefaultInstance().getSecretKey();
+        bitField0_ = (bitField0_ & ~0x00000002);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string secret_key = 2;</code>
+       * @param value The bytes for secretKey to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSecretKeyBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        secretKey_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+
+      private int memory_ ;
+      /**
+       * <code>int32 memory = 3;</code>
+       * @return The memory.
+       */
+      @java.lang.Override
+      public int getMemory() {
+        return memory_;
+      }
+      /**
+       * <code>int32 memory = 3;</code>
+       * @param value The memory to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMemory(int value) {
+
+        memory_ = value;
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 memory = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMemory() {
+        bitField0_ = (bitField0_ & ~0x00000004);
+        memory_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.MapField<
+          java.lang.String, java.lang.String> labels_;
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetLabels() {
+        if (labels_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              LabelsDefaultEntryHolder.defaultEntry);
+        }
+        return labels_;
+      }
+      private com.google.protobuf.MapField<java.lang.String, java.lang.String>
+          internalGetMutableLabels() {
+        if (labels_ == null) {
+          labels_ = com.google.protobuf.MapField.newMapField(
+              LabelsDefaultEntryHolder.defaultEntry);
+        }
+        if (!labels_.isMutable()) {
+          labels_ = labels_.copy();
+        }
+        bitField0_ |= 0x00000008;
+        onChanged();
+        return labels_;
+      }
+      public int getLabelsCount() {
+        return internalGetLabels().getMap().size();
+      }
+      /**
+       * <code>map&lt;string, string&gt; labels = 4;</code>
+       */
+      @java.lang.Override
+      public boolean containsLabels(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        return internalGetLabels().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getLabelsMap()} instead.
+       */
+      @java.lang.Override
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String> getLabels() {
+        return getLabelsMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; labels = 4;</code>
+       */
+      @java.lang.Override
+      public java.util.Map<java.lang.String, java.lang.String> getLabelsMap() {
+        return internalGetLabels().getMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; labels = 4;</code>
+       */
+      @java.lang.Override
+      public /* nullable */
+java.lang.String getLabelsOrDefault(
+          java.lang.String key,
+          /* nullable */
+java.lang.String defaultValue) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetLabels().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <code>map&lt;string, string&gt; labels = 4;</code>
+       */
+      @java.lang.Override
+      public java.lang.String getLabelsOrThrow(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.String> map =
+            internalGetLabels().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+      public Builder clearLabels() {
+        bitField0_ = (bitField0_ & ~0x00000008);
+        internalGetMutableLabels().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <code>map&lt;string, string&gt; labels = 4;</code>
+       */
+      public Builder removeLabels(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        internalGetMutableLabels().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.String>
+          getMutableLabels() {
+        bitField0_ |= 0x00000008;
+        return internalGetMutableLabels().getMutableMap();
+      }
+      /**
+       * <code>map&lt;string, string&gt; labels = 4;</code>
+       */
+      public Builder putLabels(
+          java.lang.String key,
+          java.lang.String value) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        if (value == null) { throw new NullPointerException("map value"); }
+        internalGetMutableLabels().getMutableMap()
+            .put(key, value);
+        bitField0_ |= 0x00000008;
+        return this;
+      }
+      /**
+       * <code>map&lt;string, string&gt; labels = 4;</code>
+       */
+      public Builder putAllLabels(
+          java.util.Map<java.lang.String, java.lang.String> values) {
+        internalGetMutableLabels().getMutableMap()
+            .putAll(values);
+        bitField0_ |= 0x00000008;
+        return this;
+      }
+
+      private java.util.List<eu.novusmc.athena.common.Protocol.Service> services_ =
+        java.util.Collections.emptyList();
+      private void ensureServicesIsMutable() {
+        if (!((bitField0_ & 0x00000010) != 0)) {
+          services_ = new java.util.ArrayList<eu.novusmc.athena.common.Protocol.Service>(services_);
+          bitField0_ |= 0x00000010;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilder<
+          eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder> servicesBuilder_;
+
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public java.util.List<eu.novusmc.athena.common.Protocol.Service> getServicesList() {
+        if (servicesBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(services_);
+        } else {
+          return servicesBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public int getServicesCount() {
+        if (servicesBuilder_ == null) {
+          return services_.size();
+        } else {
+          return servicesBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.Service getServices(int index) {
+        if (servicesBuilder_ == null) {
+          return services_.get(index);
+        } else {
+          return servicesBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder setServices(
+          int index, eu.novusmc.athena.common.Protocol.Service value) {
+        if (servicesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureServicesIsMutable();
+          services_.set(index, value);
+          onChanged();
+        } else {
+          servicesBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder setServices(
+          int index, eu.novusmc.athena.common.Protocol.Service.Builder builderForValue) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          servicesBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder addServices(eu.novusmc.athena.common.Protocol.Service value) {
+        if (servicesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureServicesIsMutable();
+          services_.add(value);
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder addServices(
+          int index, eu.novusmc.athena.common.Protocol.Service value) {
+        if (servicesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureServicesIsMutable();
+          services_.add(index, value);
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder addServices(
+          eu.novusmc.athena.common.Protocol.Service.Builder builderForValue) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.add(builderForValue.build());
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder addServices(
+          int index, eu.novusmc.athena.common.Protocol.Service.Builder builderForValue) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder addAllServices(
+          java.lang.Iterable<? extends eu.novusmc.athena.common.Protocol.Service> values) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, services_);
+          onChanged();
+        } else {
+          servicesBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder clearServices() {
+        if (servicesBuilder_ == null) {
+          services_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000010);
+          onChanged();
+        } else {
+          servicesBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public Builder removeServices(int index) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.remove(index);
+          onChanged();
+        } else {
+          servicesBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.Service.Builder getServicesBuilder(
+          int index) {
+        return getServicesFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServicesOrBuilder(
+          int index) {
+        if (servicesBuilder_ == null) {
+          return services_.get(index);  } else {
+          return servicesBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public java.util.List<? extends eu.novusmc.athena.common.Protocol.ServiceOrBuilder> 
+           getServicesOrBuilderList() {
+        if (servicesBuilder_ != null) {
+          return servicesBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(services_);
+        }
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.Service.Builder addServicesBuilder() {
+        return getServicesFieldBuilder().addBuilder(
+            eu.novusmc.athena.common.Protocol.Service.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.Service.Builder addServicesBuilder(
+          int index) {
+        return getServicesFieldBuilder().addBuilder(
+            index, eu.novusmc.athena.common.Protocol.Service.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .protocol.Service services = 5;</code>
+       */
+      public java.util.List<eu.novusmc.athena.common.Protocol.Service.Builder> 
+           getServicesBuilderList() {
+        return getServicesFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilder<
+          eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder> 
+          getServicesFieldBuilder() {
+        if (servicesBuilder_ == null) {
+          servicesBuilder_ = new com.google.protobuf.RepeatedFieldBuilder<
+              eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder>(
+                  services_,
+                  ((bitField0_ & 0x00000010) != 0),
+                  getParentForChildren(),
+                  isClean());
+          services_ = null;
+        }
+        return servicesBuilder_;
+      }
+
+      private int protocolVersion_ ;
+      /**
+       * <code>int32 protocol_version = 6;</code>
+       * @return The protocolVersion.
+       */
+      @java.lang.Override
+      public int getProtocolVersion() {
+        return protocolVersion_;
+      }
+      /**
+       * <code>int32 protocol_version = 6;</code>
+       * @param value The protocolVersion to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProtocolVersion(int value) {
+
+        protocolVersion_ = value;
+        bitField0_ |= 0x00000020;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 protocol_version = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearProtocolVersion() {
+        bitField0_ = (bitField0_ & ~0x00000020);
+        protocolVersion_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.LazyStringArrayList capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureCapabilitiesIsMutable() {
+        if (!capabilities_.isModifiable()) {
+          capabilities_ = new com.google.protobuf.LazyStringArrayList(capabilities_);
+        }
+        bitField0_ |= 0x00000040;
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @return A list containing the capabilities.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getCapabilitiesList() {
+        capabilities_.makeImmutable();
+        return capabilities_;
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @return The count of capabilities.
+       */
+      public int getCapabilitiesCount() {
+        return capabilities_.size();
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @param index The index of the element to return.
+       * @return The capabilities at the given index.
+       */
+      public java.lang.String getCapabilities(int index) {
+        return capabilities_.get(index);
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the capabilities at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getCapabilitiesBytes(int index) {
+        return capabilities_.getByteString(index);
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @param index The index to set the value at.
+       * @param value The capabilities to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCapabilities(
+          int index, java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureCapabilitiesIsMutable();
+        capabilities_.set(index, value);
+        bitField0_ |= 0x00000040;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @param value The capabilities to add.
+       * @return This builder for chaining.
+       */
+      public Builder addCapabilities(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureCapabilitiesIsMutable();
+        capabilities_.add(value);
+        bitField0_ |= 0x00000040;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @param values The capabilities to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllCapabilities(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureCapabilitiesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, capabilities_);
+        bitField0_ |= 0x00000040;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCapabilities() {
+        capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000040);;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 7;</code>
+       * @param value The bytes of the capabilities to add.
+       * @return This builder for chaining.
+       */
+      public Builder addCapabilitiesBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureCapabilitiesIsMutable();
+        capabilities_.add(value);
+        bitField0_ |= 0x00000040;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketAuthenticate)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.PacketAuthenticate)
+    private static final eu.novusmc.athena.common.Protocol.PacketAuthenticate DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAuthenticate();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PacketAuthenticate>
+        PARSER = new com.google.protobuf.AbstractParser<PacketAuthenticate>() {
+      @java.lang.Override
+      public PacketAuthenticate parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PacketAuthenticate> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PacketAuthenticate> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.PacketAuthenticate getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PacketAuthSuccessOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketAuthSuccess)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>int32 heartbeat_interval = 1;</code>
+     * @return The heartbeatInterval.
+     */
+    int getHeartbeatInterval();
+
+    /**
+     * <code>int32 heartbeat_timeout = 2;</code>
+     * @return The heartbeatTimeout.
+     */
+    int getHeartbeatTimeout();
+
+    /**
+     * <code>int32 protocol_version = 3;</code>
+     * @return The protocolVersion.
+     */
+    int getProtocolVersion();
+
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @return A list containing the capabilities.
+     */
+    java.util.List<java.lang.String>
+        getCapabilitiesList();
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @return The count of capabilities.
+     */
+    int getCapabilitiesCount();
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @param index The index of the element to return.
+     * @return The capabilities at the given index.
+     */
+    java.lang.String getCapabilities(int index);
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the capabilities at the given index.
+     */
+    com.google.protobuf.ByteString
+        getCapabilitiesBytes(int index);
+  }
+  /**
+   * Protobuf type {@code protocol.PacketAuthSuccess}
+   */
+  public static final class PacketAuthSuccess extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.PacketAuthSuccess)
+      PacketAuthSuccessOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        PacketAuthSuccess.class.getName());
+    }
+    // Use PacketAuthSuccess.newBuilder() to construct.
+    private PacketAuthSuccess(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private PacketAuthSuccess() {
+      capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketAuthSuccess.class, eu.novusmc.athena.common.Protocol.PacketAuthSuccess.Builder.class);
+    }
+
+    public static final int HEARTBEAT_INTERVAL_FIELD_NUMBER = 1;
+    private int heartbeatInterval_ = 0;
+    /**
+     * <code>int32 heartbeat_interval = 1;</code>
+     * @return The heartbeatInterval.
+     */
+    @java.lang.Override
+    public int getHeartbeatInterval() {
+      return heartbeatInterval_;
+    }
+
+    public static final int HEARTBEAT_TIMEOUT_FIELD_NUMBER = 2;
+    private int heartbeatTimeout_ = 0;
+    /**
+     * <code>int32 heartbeat_timeout = 2;</code>
+     * @return The heartbeatTimeout.
+     */
+    @java.lang.Override
+    public int getHeartbeatTimeout() {
+      return heartbeatTimeout_;
+    }
+
+    public static final int PROTOCOL_VERSION_FIELD_NUMBER = 3;
+    private int protocolVersion_ = 0;
+    /**
+     * <code>int32 protocol_version = 3;</code>
+     * @return The protocolVersion.
+     */
+    @java.lang.Override
+    public int getProtocolVersion() {
+      return protocolVersion_;
+    }
+
+    public static final int CAPABILITIES_FIELD_NUMBER = 4;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList capabilities_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @return A list containing the capabilities.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getCapabilitiesList() {
+      return capabilities_;
+    }
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @return The count of capabilities.
+     */
+    public int getCapabilitiesCount() {
+      return capabilities_.size();
+    }
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @param index The index of the element to return.
+     * @return The capabilities at the given index.
+     */
+    public java.lang.String getCapabilities(int index) {
+      return capabilities_.get(index);
+    }
+    /**
+     * <code>repeated string capabilities = 4;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the capabilities at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getCapabilitiesBytes(int index) {
+      return capabilities_.getByteString(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (heartbeatInterval_ != 0) {
+        output.writeInt32(1, heartbeatInterval_);
+      }
+      if (heartbeatTimeout_ != 0) {
+        output.writeInt32(2, heartbeatTimeout_);
+      }
+      if (protocolVersion_ != 0) {
+        output.writeInt32(3, protocolVersion_);
+      }
+      for (int i = 0; i < capabilities_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 4, capabilities_.getRaw(i));
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (heartbeatInterval_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(1, heartbeatInterval_);
+      }
+      if (heartbeatTimeout_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(2, heartbeatTimeout_);
+      }
+      if (protocolVersion_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(3, protocolVersion_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < capabilities_.size(); i++) {
+          dataSize += computeStringSizeNoTag(capabilities_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getCapabilitiesList().size();
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAuthSuccess)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.PacketAuthSuccess other = (eu.novusmc.athena.common.Protocol.PacketAuthSuccess) obj;
+
+      if (getHeartbeatInterval()
+          != other.getHeartbeatInterval()) return false;
+      if (getHeartbeatTimeout()
+          != other.getHeartbeatTimeout()) return false;
+      if (getProtocolVersion()
+          != other.getProtocolVersion()) return false;
+      if (!getCapabilitiesList()
+          .equals(other.getCapabilitiesList())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + HEARTBEAT_INTERVAL_FIELD_NUMBER;
+      hash = (53 * hash) + getHeartbeatInterval();
+      hash = (37 * hash) + HEARTBEAT_TIMEOUT_FIELD_NUMBER;
+      hash = (53 * hash) + getHeartbeatTimeout();
+      hash = (37 * hash) + PROTOCOL_VERSION_FIELD_NUMBER;
+      hash = (53 * hash) + getProtocolVersion();
+      if (getCapabilitiesCount() > 0) {
+        hash = (37 * hash) + CAPABILITIES_FIELD_NUMBER;
+        hash = (53 * hash) + getCapabilitiesList().hashCode();
+      }
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAuthSuccess prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.PacketAuthSuccess}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.PacketAuthSuccess)
+        eu.novusmc.athena.common.Protocol.PacketAuthSuccessOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.PacketAuthSuccess.class, eu.novusmc.athena.common.Protocol.PacketAuthSuccess.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.PacketAuthSuccess.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        heartbeatInterval_ = 0;
+        heartbeatTimeout_ = 0;
+        protocolVersion_ = 0;
+        capabilities_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthSuccess getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketAuthSuccess.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthSuccess build() {
+        eu.novusmc.athena.common.Protocol.PacketAuthSuccess result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthSuccess buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketAuthSuccess result = new eu.novusmc.athena.common.Protocol.PacketAuthSuccess(this);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketAuthSuccess result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.heartbeatInterval_ = heartbeatInterval_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.heartbeatTimeout_ = heartbeatTimeout_;
+        }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          result.protocolVersion_ = protocolVersion_;
+        }
+        if (((from_bitField0_ & 0x00000008) != 0)) {
+          capabilities_.makeImmutable();
+          result.capabilities_ = capabilities_;
+        }
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAuthSuccess) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAuthSuccess)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAuthSuccess other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketAuthSuccess.getDefaultInstance()) return this;
+        if (other.getHeartbeatInterval() != 0) {
+          setHeartbeatInterval(other.getHeartbeatInterval());
+        }
+        if (other.getHeartbeatTimeout() != 0) {
+          setHeartbeatTimeout(other.getHeartbeatTimeout());
+        }
+        if (other.getProtocolVersion() != 0) {
+          setProtocolVersion(other.getProtocolVersion());
+        }
+        if (!other.capabilities_.isEmpty()) {
+          if (capabilities_.isEmpty()) {
+            capabilities_ = other.capabilities_;
+            bitField0_ |= 0x00000008;
+          } else {
+            ensureCapabilitiesIsMutable();
+            capabilities_.addAll(other.capabilities_);
+          }
+          onChanged();
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                heartbeatInterval_ = input.readInt32();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 8
+              case 16: {
+                heartbeatTimeout_ = input.readInt32();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 16
+              case 24: {
+                protocolVersion_ = input.readInt32();
+                bitField0_ |= 0x00000004;
+                break;
+              } // case 24
+              case 34: {
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureCapabilitiesIsMutable();
+                capabilities_.add(s);
+                break;
+              } // case 34
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private int heartbeatInterval_ ;
+      /**
+       * <code>int32 heartbeat_interval = 1;</code>
+       * @return The heartbeatInterval.
+       */
+      @java.lang.Override
+      public int getHeartbeatInterval() {
+        return heartbeatInterval_;
+      }
+      /**
+       * <code>int32 heartbeat_interval = 1;</code>
+       * @param value The heartbeatInterval to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHeartbeatInterval(int value) {
+
+        heartbeatInterval_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 heartbeat_interval = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearHeartbeatInterval() {
+        bitField0_ = (bitField0_ & ~0x00000001);
+        heartbeatInterval_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int heartbeatTimeout_ ;
+      /**
+       * <code>int32 heartbeat_timeout = 2;</code>
+       * @return The heartbeatTimeout.
+       */
+      @java.lang.Override
+      public int getHeartbeatTimeout() {
+        return heartbeatTimeout_;
+      }
+      /**
+       * <code>int32 heartbeat_timeout = 2;</code>
+       * @param value The heartbeatTimeout to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHeartbeatTimeout(int value) {
+
+        heartbeatTimeout_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 heartbeat_timeout = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearHeartbeatTimeout() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        heartbeatTimeout_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int protocolVersion_ ;
+      /**
+       * <code>int32 protocol_version = 3;</code>
+       * @return The protocolVersion.
+       */
+      @java.lang.Override
+      public int getProtocolVersion() {
+        return protocolVersion_;
+      }
+      /**
+       * <code>int32 protocol_version = 3;</code>
+       * @param value The protocolVersion to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProtocolVersion(int value) {
+
+        protocolVersion_ = value;
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 protocol_version = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearProtocolVersion() {
+        bitField0_ = (bitField0_ & ~0x00000004);
+        protocolVersion_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.LazyStringArrayList capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureCapabilitiesIsMutable() {
+        if (!capabilities_.isModifiable()) {
+          capabilities_ = new com.google.protobuf.LazyStringArrayList(capabilities_);
+        }
+        bitField0_ |= 0x00000008;
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @return A list containing the capabilities.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getCapabilitiesList() {
+        capabilities_.makeImmutable();
+        return capabilities_;
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @return The count of capabilities.
+       */
+      public int getCapabilitiesCount() {
+        return capabilities_.size();
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @param index The index of the element to return.
+       * @return The capabilities at the given index.
+       */
+      public java.lang.String getCapabilities(int index) {
+        return capabilities_.get(index);
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the capabilities at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getCapabilitiesBytes(int index) {
+        return capabilities_.getByteString(index);
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @param index The index to set the value at.
+       * @param value The capabilities to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCapabilities(
+          int index, java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureCapabilitiesIsMutable();
+        capabilities_.set(index, value);
+        bitField0_ |= 0x00000008;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @param value The capabilities to add.
+       * @return This builder for chaining.
+       */
+      public Builder addCapabilities(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureCapabilitiesIsMutable();
+        capabilities_.add(value);
+        bitField0_ |= 0x00000008;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @param values The capabilities to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllCapabilities(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureCapabilitiesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, capabilities_);
+        bitField0_ |= 0x00000008;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCapabilities() {
+        capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000008);;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string capabilities = 4;</code>
+       * @param value The bytes of the capabilities to add.
+       * @return This builder for chaining.
+       */
+      public Builder addCapabilitiesBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureCapabilitiesIsMutable();
+        capabilities_.add(value);
+        bitField0_ |= 0x00000008;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketAuthSuccess)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.PacketAuthSuccess)
+    private static final eu.novusmc.athena.common.Protocol.PacketAuthSuccess DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAuthSuccess();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PacketAuthSuccess>
+        PARSER = new com.google.protobuf.AbstractParser<PacketAuthSuccess>() {
+      @java.lang.Override
+      public PacketAuthSuccess parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PacketAuthSuccess> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PacketAuthSuccess> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.PacketAuthSuccess getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PacketAuthFailedOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketAuthFailed)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string message = 1;</code>
+     * @return The message.
+     */
+    java.lang.String getMessage();
+    /**
+     * <code>string message = 1;</code>
+     * @return The bytes for message.
+     */
+    com.google.protobuf.ByteString
+        getMessageBytes();
+  }
+  /**
+   * Protobuf type {@code protocol.PacketAuthFailed}
+   */
+  public static final class PacketAuthFailed extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.PacketAuthFailed)
+      PacketAuthFailedOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        PacketAuthFailed.class.getName());
+    }
+    // Use PacketAuthFailed.newBuilder() to construct.
+    private PacketAuthFailed(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private PacketAuthFailed() {
+      message_ = "";
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketAuthFailed.class, eu.novusmc.athena.common.Protocol.PacketAuthFailed.Builder.class);
+    }
+
+    public static final int MESSAGE_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object message_ = "";
+    /**
+     * <code>string message = 1;</code>
+     * @return The message.
+     */
+    @java.lang.Override
+    public java.lang.String getMessage() {
+      java.lang.Object ref = message_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        message_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string message = 1;</code>
+     * @return The bytes for message.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getMessageBytes() {
+      java.lang.Object ref = message_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        message_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, message_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, message_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAuthFailed)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.PacketAuthFailed other = (eu.novusmc.athena.common.Protocol.PacketAuthFailed) obj;
+
+      if (!getMessage()
+          .equals(other.getMessage())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + MESSAGE_FIELD_NUMBER;
+      hash = (53 * hash) + getMessage().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAuthFailed prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.PacketAuthFailed}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.PacketAuthFailed)
+        eu.novusmc.athena.common.Protocol.PacketAuthFailedOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.PacketAuthFailed.class, eu.novusmc.athena.common.Protocol.PacketAuthFailed.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.PacketAuthFailed.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        message_ = "";
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthFailed getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketAuthFailed.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthFailed build() {
+        eu.novusmc.athena.common.Protocol.PacketAuthFailed result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketAuthFailed buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketAuthFailed result = new eu.novusmc.athena.common.Protocol.PacketAuthFailed(this);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketAuthFailed result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.message_ = message_;
+        }
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAuthFailed) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAuthFailed)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAuthFailed other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketAuthFailed.getDefaultInstance()) return this;
+        if (!other.getMessage().isEmpty()) {
+          message_ = other.message_;
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                message_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object message_ = "";
+      /**
+       * <code>string message = 1;</code>
+       * @return The message.
+       */
+      public java.lang.String getMessage() {
+        java.lang.Object ref = message_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          message_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string message = 1;</code>
+       * @return The bytes for message.
+       */
+      public com.google.protobuf.ByteString
+          getMessageBytes() {
+        java.lang.Object ref = message_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          message_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string message = 1;</code>
+       * @param value The message to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMessage(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        message_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string message = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMessage() {
+        message_ = getDefaultInstance().getMessage();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string message = 1;</code>
+       * @param value The bytes for message to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMessageBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        message_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketAuthFailed)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.PacketAuthFailed)
+    private static final eu.novusmc.athena.common.Protocol.PacketAuthFailed DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAuthFailed();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PacketAuthFailed>
+        PARSER = new com.google.protobuf.AbstractParser<PacketAuthFailed>() {
+      @java.lang.Override
+      public PacketAuthFailed parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PacketAuthFailed> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PacketAuthFailed> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.PacketAuthFailed getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PacketReplyOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketReply)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>uint64 request_id = 1;</code>
+     * @return The requestId.
+     */
+    long getRequestId();
+
+    /**
+     * <pre>
+     * error is empty if the request succeeded.
+     * </pre>
+     *
+     * <code>string error = 2;</code>
+     * @return The error.
+     */
+    java.lang.String getError();
+    /**
+     * <pre>
+     * error is empty if the request succeeded.
+     * </pre>
+     *
+     * <code>string error = 2;</code>
+     * @return The bytes for error.
+     */
+    com.google.protobuf.ByteString
+        getErrorBytes();
+  }
+  /**
+   * Protobuf type {@code protocol.PacketReply}
+   */
+  public static final class PacketReply extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.PacketReply)
+      PacketReplyOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        PacketReply.class.getName());
+    }
+    // Use PacketReply.newBuilder() to construct.
+    private PacketReply(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private PacketReply() {
+      error_ = "";
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketReply_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketReply_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketReply.class, eu.novusmc.athena.common.Protocol.PacketReply.Builder.class);
+    }
+
+    public static final int REQUEST_ID_FIELD_NUMBER = 1;
+    private long requestId_ = 0L;
+    /**
+     * <code>uint64 request_id = 1;</code>
+     * @return The requestId.
+     */
+    @java.lang.Override
+    public long getRequestId() {
+      return requestId_;
+    }
+
+    public static final int ERROR_FIELD_NUMBER = 2;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object error_ = "";
+    /**
+     * <pre>
+     * error is empty if the request succeeded.
+     * </pre>
+     *
+     * <code>string error = 2;</code>
+     * @return The error.
+     */
+    @java.lang.Override
+    public java.lang.String getError() {
+      java.lang.Object ref = error_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        error_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * error is empty if the request succeeded.
+     * </pre>
+     *
+     * <code>string error = 2;</code>
+     * @return The bytes for error.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getErrorBytes() {
+      java.lang.Object ref = error_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        error_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (requestId_ != 0L) {
+        output.writeUInt64(1, requestId_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(error_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 2, error_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (requestId_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(1, requestId_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(error_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, error_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketReply)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.PacketReply other = (eu.novusmc.athena.common.Protocol.PacketReply) obj;
+
+      if (getRequestId()
+          != other.getRequestId()) return false;
+      if (!getError()
+          .equals(other.getError())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + REQUEST_ID_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getRequestId());
+      hash = (37 * hash) + ERROR_FIELD_NUMBER;
+      hash = (53 * hash) + getError().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketReply parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketReply prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.PacketReply}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.PacketReply)
+        eu.novusmc.athena.common.Protocol.PacketReplyOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketReply_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketReply_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.PacketReply.class, eu.novusmc.athena.common.Protocol.PacketReply.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.PacketReply.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        bitField0_ = 0;
+        requestId_ = 0L;
+        error_ = "";
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketReply_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketReply getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketReply.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketReply build() {
+        eu.novusmc.athena.common.Protocol.PacketReply result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketReply buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketReply result = new eu.novusmc.athena.common.Protocol.PacketReply(this);
+        if (bitField0_ != 0) { buildPartial0(result); }
+        onBuilt();
+        return result;
+      }
+
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketReply result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.requestId_ = requestId_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.error_ = error_;
+        }
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketReply) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketReply)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketReply other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketReply.getDefaultInstance()) return this;
+        if (other.getRequestId() != 0L) {
+          setRequestId(other.getRequestId());
+        }
+        if (!other.getError().isEmpty()) {
+          error_ = other.error_;
+          bitField0_ |= 0x00000002;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                requestId_ = input.readUInt64();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 8
+              case 18: {
+                error_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private long requestId_ ;
+      /**
+       * <code>uint64 request_id = 1;</code>
+       * @return The requestId.
+       */
+      @java.lang.Override
+      public long getRequestId() {
+        return requestId_;
+      }
+      /**
+       * <code>uint64 request_id = 1;</code>
+       * @param value The requestId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRequestId(long value) {
+
+        requestId_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint64 request_id = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRequestId() {
+        bitField0_ = (bitField0_ & ~0x00000001);
+        requestId_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object error_ = "";
+      /**
+       * <pre>
+       * error is empty if the request succeeded.
+       * </pre>
+       *
+       * <code>string error = 2;</code>
+       * @return The error.
+       */
+      public java.lang.String getError() {
+        java.lang.Object ref = error_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          error_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * error is empty if the request succeeded.
+       * </pre>
+       *
+       * <code>string error = 2;</code>
+       * @return The bytes for error.
+       */
+      public com.google.protobuf.ByteString
+          getErrorBytes() {
+        java.lang.Object ref = error_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          error_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * error is empty if the request succeeded.
+       * </pre>
+       *
+       * <code>string error = 2;</code>
+       * @param value The error to set.
+       * @return This builder for chaining.
+       */
+      public Builder setError(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        error_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * error is empty if the request succeeded.
+       * </pre>
+       *
+       * <code>string error = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearError() {
+        error_ = getDefaultInstance().getError();
+        bitField0_ = (bitField0_ & ~0x00000002);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * error is empty if the request succeeded.
+       * </pre>
+       *
+       * <code>string error = 2;</code>
+       * @param value The bytes for error to set.
+       * @return This builder for chaining.
+       */
+      public Builder setErrorBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        error_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketReply)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.PacketReply)
+    private static final eu.novusmc.athena.common.Protocol.PacketReply DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketReply();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketReply getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PacketReply>
+        PARSER = new com.google.protobuf.AbstractParser<PacketReply>() {
+      @java.lang.Override
+      public PacketReply parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PacketReply> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PacketReply> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.PacketReply getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PacketPingOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketPing)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code protocol.PacketPing}
+   */
+  public static final class PacketPing extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.PacketPing)
+      PacketPingOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        PacketPing.class.getName());
+    }
+    // Use PacketPing.newBuilder() to construct.
+    private PacketPing(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private PacketPing() {
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPing_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPing_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketPing.class, eu.novusmc.athena.common.Protocol.PacketPing.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketPing)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.PacketPing other = (eu.novusmc.athena.common.Protocol.PacketPing) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPing parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketPing prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.PacketPing}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.PacketPing)
+        eu.novusmc.athena.common.Protocol.PacketPingOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPing_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPing_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.PacketPing.class, eu.novusmc.athena.common.Protocol.PacketPing.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.PacketPing.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPing_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketPing getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketPing.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketPing build() {
+        eu.novusmc.athena.common.Protocol.PacketPing result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketPing buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketPing result = new eu.novusmc.athena.common.Protocol.PacketPing(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketPing) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketPing)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketPing other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketPing.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketPing)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.PacketPing)
+    private static final eu.novusmc.athena.common.Protocol.PacketPing DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketPing();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPing getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PacketPing>
+        PARSER = new com.google.protobuf.AbstractParser<PacketPing>() {
+      @java.lang.Override
+      public PacketPing parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PacketPing> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PacketPing> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.PacketPing getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PacketPongOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketPong)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code protocol.PacketPong}
+   */
+  public static final class PacketPong extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.PacketPong)
+      PacketPongOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        PacketPong.class.getName());
+    }
+    // Use PacketPong.newBuilder() to construct.
+    private PacketPong(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private PacketPong() {
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPong_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPong_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketPong.class, eu.novusmc.athena.common.Protocol.PacketPong.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketPong)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.PacketPong other = (eu.novusmc.athena.common.Protocol.PacketPong) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input);
+    }
+    public static eu.novusmc.athena.common.Protocol.PacketPong parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessage
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketPong prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code protocol.PacketPong}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessage.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:protocol.PacketPong)
+        eu.novusmc.athena.common.Protocol.PacketPongOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPong_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPong_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                eu.novusmc.athena.common.Protocol.PacketPong.class, eu.novusmc.athena.common.Protocol.PacketPong.Builder.class);
+      }
+
+      // Construct using eu.novusmc.athena.common.Protocol.PacketPong.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessage.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketPong_descriptor;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketPong getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketPong.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketPong build() {
+        eu.novusmc.athena.common.Protocol.PacketPong result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public eu.novusmc.athena.common.Protocol.PacketPong buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketPong result = new eu.novusmc.athena.common.Protocol.PacketPong(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketPong) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketPong)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketPong other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketPong.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketPong)
+    }
+
+    // @@protoc_insertion_point(class_scope:protocol.PacketPong)
+    private static final eu.novusmc.athena.common.Protocol.PacketPong DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketPong();
+    }
+
+    public static eu.novusmc.athena.common.Protocol.PacketPong getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PacketPong>
+        PARSER = new com.google.protobuf.AbstractParser<PacketPong>() {
+      @java.lang.Override
+      public PacketPong parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PacketPong> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PacketPong> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.PacketPong getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PacketScheduleServiceRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketScheduleServiceRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.protocol.Service service = 1;</code>
+     * @return Whether the service field is set.
+     */
+    boolean hasService();
+    /**
+     * <code>.protocol.Service service = 1;</code>
+     * @return The service.
+     */
+    eu.novusmc.athena.common.Protocol.Service getService();
+    /**
+     * <code>.protocol.Service service = 1;</code>
+     */
+    eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServiceOrBuilder();
+
+    /**
+     * <code>.protocol.Group group = 2;</code>
+     * @return Whether the group field is set.
+     */
+    boolean hasGroup();
+    /**
+     * <code>.protocol.Group group = 2;</code>
+     * @return The group.
+     */
+    eu.novusmc.athena.common.Protocol.Group getGroup();
+    /**
+     * <code>.protocol.Group group = 2;</code>
+     */
+    eu.novusmc.athena.common.Protocol.GroupOrBuilder getGroupOrBuilder();
+  }
+  /**
+   * Protobuf type {@code protocol.PacketScheduleServiceRequest}
+   */
+  public static final class PacketScheduleServiceRequest extends
+      com.google.protobuf.GeneratedMessage implements
+      // @@protoc_insertion_point(message_implements:protocol.PacketScheduleServiceRequest)
+      PacketScheduleServiceRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    static {
+      com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
+        com.google.protobuf.RuntimeVersion.RuntimeDomain.PUBLIC,
+        /* major= */ 4,
+        /* minor= */ 29,
+        /* patch= */ 1,
+        /* suffix= */ "",
+        PacketScheduleServiceRequest.class.getName());
+    }
+    // Use PacketScheduleServiceRequest.newBuilder() to construct.
+    private PacketScheduleServiceRequest(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+      super(builder);
+    }
+    private PacketScheduleServiceRequest() {
+    }
+
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.class, eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.Builder.class);
+    }
+
+    private int bitField0_;
+    public static final int SERVICE_FIELD_NUMBER = 1;
+    private eu.novusmc.athena.common.Protocol.Service service_;
+    /**
+     * <code>.protocol.Service service = 1;</code>
+     * @return Whether the service field is set.
+     */
+    @java.lang.Override
+    public boolean hasService() {
+      return ((bitField0_ & 0x00000001) != 0);
+    }
+    /**
+     * <code>.protocol.Service service = 1;</code>
+     * @return The service.
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.Service getService() {
+      return service_ == null ? eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
+    }
+    /**
+     * <code>.protocol.Service service = 1;</code>
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServiceOrBuilder() {
+      return service_ == null ? eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
+    }
+
+    public static final int GROUP_FIELD_NUMBER = 2;
+    private eu.novusmc.athena.common.Protocol.Group group_;
+    /**
+     * <code>.protocol.Group group = 2;</code>
+     * @return Whether the group field is set.
+     */
+    @java.lang.Override
+    public boolean hasGroup() {
+      return ((bitField0_ & 0x00000002) != 0);
+    }
+    /**
+     * <code>.protocol.Group group = 2;</code>
+     * @return The group.
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.Group getGroup() {
+      return group_ == null ? eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
+    }
+    /**
+     * <code>.protocol.Group group = 2;</code>
+     */
+    @java.lang.Override
+    public eu.novusmc.athena.common.Protocol.GroupOrBuilder getGroupOrBuilder() {
+      return group_ == null ? eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (((bitField0_ & 0x00000001) != 0)) {
+        output.writeMessage(1, getService());
+      }
+      if (((bitField0_ & 0x00000002) != 0)) {
+        output.writeMessage(2, getGroup());
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (((bitField0_ & 0x00000001) != 0)) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, getService());
+      }
+      if (((bitField0_ & 0x00000002) != 0)) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(2, getGroup());
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest)) {
+        return super.equals(obj);
+      }
+      eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest other = (eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest) obj;
+
+      if (hasService() != other.hasService()) return false;
+      if (hasService()) {
+        if (!getService()
+            .equals(other.getService())) return false;
+      }
+      if (hasGroup() != other.hasGroup()) return false;
+      if (hasGroup()) {
+        if (!getGroup()
+            .equals(other.getGroup())) return false;
+      }
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (hasService()) {
+        hash = (37 * hash) + SERVICE_FIELD_NUMBER;
+        hash = (53 * hash) + getService().hashCode();
+      }
+      if (hasGroup()) {
+        hash = (37 * hash) + GROUP_FIELD_NUMBER;
+        hash = (53 * hash) + getGroup().hashCode();
+      }
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -1818,26 +12745,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.Group parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.Group parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.Group parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -1850,7 +12777,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.Group prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -1866,62 +12793,73 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.Group}
+     * Protobuf type {@code protocol.PacketScheduleServiceRequest}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.Group)
-        eu.novusmc.athena.common.Protocol.GroupOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketScheduleServiceRequest)
+        eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequestOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.Group.class, eu.novusmc.athena.common.Protocol.Group.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.class, eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.Group.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.newBuilder()
       private Builder() {
-
+        maybeForceBuilderInitialization();
       }
 
       private Builder(
           com.google.protobuf.GeneratedMessage.BuilderParent parent) {
         super(parent);
-
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessage
+                .alwaysUseFieldBuilders) {
+          getServiceFieldBuilder();
+          getGroupFieldBuilder();
+        }
       }
       @java.lang.Override
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        name_ = "";
-        type_ = 0;
-        minServices_ = 0;
-        maxServices_ = 0;
-        memory_ = 0;
-        startPort_ = 0;
+        service_ = null;
+        if (serviceBuilder_ != null) {
+          serviceBuilder_.dispose();
+          serviceBuilder_ = null;
+        }
+        group_ = null;
+        if (groupBuilder_ != null) {
+          groupBuilder_.dispose();
+          groupBuilder_ = null;
+        }
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Group_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.Group getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.Group.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.Group build() {
-        eu.novusmc.athena.common.Protocol.Group result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest build() {
+        eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -1929,66 +12867,48 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.Group buildPartial() {
-        eu.novusmc.athena.common.Protocol.Group result = new eu.novusmc.athena.common.Protocol.Group(this);
+      public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest result = new eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.Group result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest result) {
         int from_bitField0_ = bitField0_;
+        int to_bitField0_ = 0;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.name_ = name_;
+          result.service_ = serviceBuilder_ == null
+              ? service_
+              : serviceBuilder_.build();
+          to_bitField0_ |= 0x00000001;
         }
         if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.type_ = type_;
-        }
-        if (((from_bitField0_ & 0x00000004) != 0)) {
-          result.minServices_ = minServices_;
-        }
-        if (((from_bitField0_ & 0x00000008) != 0)) {
-          result.maxServices_ = maxServices_;
-        }
-        if (((from_bitField0_ & 0x00000010) != 0)) {
-          result.memory_ = memory_;
-        }
-        if (((from_bitField0_ & 0x00000020) != 0)) {
-          result.startPort_ = startPort_;
+          result.group_ = groupBuilder_ == null
+              ? group_
+              : groupBuilder_.build();
+          to_bitField0_ |= 0x00000002;
         }
+        result.bitField0_ |= to_bitField0_;
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.Group) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.Group)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.Group other) {
-        if (other == eu.novusmc.athena.common.Protocol.Group.getDefaultInstance()) return this;
-        if (!other.getName().isEmpty()) {
-          name_ = other.name_;
-          bitField0_ |= 0x00000001;
-          onChanged();
-        }
-        if (other.type_ != 0) {
-          setTypeValue(other.getTypeValue());
-        }
-        if (other.getMinServices() != 0) {
-          setMinServices(other.getMinServices());
-        }
-        if (other.getMaxServices() != 0) {
-          setMaxServices(other.getMaxServices());
-        }
-        if (other.getMemory() != 0) {
-          setMemory(other.getMemory());
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.getDefaultInstance()) return this;
+        if (other.hasService()) {
+          mergeService(other.getService());
         }
-        if (other.getStartPort() != 0) {
-          setStartPort(other.getStartPort());
+        if (other.hasGroup()) {
+          mergeGroup(other.getGroup());
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -2017,35 +12937,19 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                name_ = input.readStringRequireUtf8();
+                input.readMessage(
+                    getServiceFieldBuilder().getBuilder(),
+                    extensionRegistry);
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
-              case 16: {
-                type_ = input.readEnum();
+              case 18: {
+                input.readMessage(
+                    getGroupFieldBuilder().getBuilder(),
+                    extensionRegistry);
                 bitField0_ |= 0x00000002;
                 break;
-              } // case 16
-              case 24: {
-                minServices_ = input.readInt32();
-                bitField0_ |= 0x00000004;
-                break;
-              } // case 24
-              case 32: {
-                maxServices_ = input.readInt32();
-                bitField0_ |= 0x00000008;
-                break;
-              } // case 32
-              case 40: {
-                memory_ = input.readInt32();
-                bitField0_ |= 0x00000010;
-                break;
-              } // case 40
-              case 48: {
-                startPort_ = input.readInt32();
-                bitField0_ |= 0x00000020;
-                break;
-              } // case 48
+              } // case 18
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -2063,276 +12967,265 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object name_ = "";
+      private eu.novusmc.athena.common.Protocol.Service service_;
+      private com.google.protobuf.SingleFieldBuilder<
+          eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder> serviceBuilder_;
       /**
-       * <code>string name = 1;</code>
-       * @return The name.
+       * <code>.protocol.Service service = 1;</code>
+       * @return Whether the service field is set.
        */
-      public java.lang.String getName() {
-        java.lang.Object ref = name_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          name_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
+      public boolean hasService() {
+        return ((bitField0_ & 0x00000001) != 0);
       }
       /**
-       * <code>string name = 1;</code>
-       * @return The bytes for name.
+       * <code>.protocol.Service service = 1;</code>
+       * @return The service.
        */
-      public com.google.protobuf.ByteString
-          getNameBytes() {
-        java.lang.Object ref = name_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          name_ = b;
-          return b;
+      public eu.novusmc.athena.common.Protocol.Service getService() {
+        if (serviceBuilder_ == null) {
+          return service_ == null ? eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
         } else {
-          return (com.google.protobuf.ByteString) ref;
+          return serviceBuilder_.getMessage();
         }
       }
       /**
-       * <code>string name = 1;</code>
-       * @param value The name to set.
-       * @return This builder for chaining.
+       * <code>.protocol.Service service = 1;</code>
        */
-      public Builder setName(
-          java.lang.String value) {
-        if (value == null) { throw new NullPointerException(); }
-        name_ = value;
+      public Builder setService(eu.novusmc.athena.common.Protocol.Service value) {
+        if (serviceBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          service_ = value;
+        } else {
+          serviceBuilder_.setMessage(value);
+        }
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string name = 1;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearName() {
-        name_ = getDefaultInstance().getName();
-        bitField0_ = (bitField0_ & ~0x00000001);
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string name = 1;</code>
-       * @param value The bytes for name to set.
-       * @return This builder for chaining.
+       * <code>.protocol.Service service = 1;</code>
        */
-      public Builder setNameBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) { throw new NullPointerException(); }
-        checkByteStringIsUtf8(value);
-        name_ = value;
+      public Builder setService(
+          eu.novusmc.athena.common.Protocol.Service.Builder builderForValue) {
+        if (serviceBuilder_ == null) {
+          service_ = builderForValue.build();
+        } else {
+          serviceBuilder_.setMessage(builderForValue.build());
+        }
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
-
-      private int type_ = 0;
-      /**
-       * <code>.protocol.Service.Type type = 2;</code>
-       * @return The enum numeric value on the wire for type.
-       */
-      @java.lang.Override public int getTypeValue() {
-        return type_;
-      }
-      /**
-       * <code>.protocol.Service.Type type = 2;</code>
-       * @param value The enum numeric value on the wire for type to set.
-       * @return This builder for chaining.
-       */
-      public Builder setTypeValue(int value) {
-        type_ = value;
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.protocol.Service.Type type = 2;</code>
-       * @return The type.
-       */
-      @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.Service.Type getType() {
-        eu.novusmc.athena.common.Protocol.Service.Type result = eu.novusmc.athena.common.Protocol.Service.Type.forNumber(type_);
-        return result == null ? eu.novusmc.athena.common.Protocol.Service.Type.UNRECOGNIZED : result;
-      }
       /**
-       * <code>.protocol.Service.Type type = 2;</code>
-       * @param value The type to set.
-       * @return This builder for chaining.
+       * <code>.protocol.Service service = 1;</code>
        */
-      public Builder setType(eu.novusmc.athena.common.Protocol.Service.Type value) {
-        if (value == null) {
-          throw new NullPointerException();
+      public Builder mergeService(eu.novusmc.athena.common.Protocol.Service value) {
+        if (serviceBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0) &&
+            service_ != null &&
+            service_ != eu.novusmc.athena.common.Protocol.Service.getDefaultInstance()) {
+            getServiceBuilder().mergeFrom(value);
+          } else {
+            service_ = value;
+          }
+        } else {
+          serviceBuilder_.mergeFrom(value);
+        }
+        if (service_ != null) {
+          bitField0_ |= 0x00000001;
+          onChanged();
         }
-        bitField0_ |= 0x00000002;
-        type_ = value.getNumber();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.protocol.Service.Type type = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearType() {
-        bitField0_ = (bitField0_ & ~0x00000002);
-        type_ = 0;
-        onChanged();
         return this;
       }
-
-      private int minServices_ ;
-      /**
-       * <code>int32 min_services = 3;</code>
-       * @return The minServices.
-       */
-      @java.lang.Override
-      public int getMinServices() {
-        return minServices_;
-      }
       /**
-       * <code>int32 min_services = 3;</code>
-       * @param value The minServices to set.
-       * @return This builder for chaining.
+       * <code>.protocol.Service service = 1;</code>
        */
-      public Builder setMinServices(int value) {
-
-        minServices_ = value;
-        bitField0_ |= 0x00000004;
+      public Builder clearService() {
+        bitField0_ = (bitField0_ & ~0x00000001);
+        service_ = null;
+        if (serviceBuilder_ != null) {
+          serviceBuilder_.dispose();
+          serviceBuilder_ = null;
+        }
         onChanged();
         return this;
       }
       /**
-       * <code>int32 min_services = 3;</code>
-       * @return This builder for chaining.
+       * <code>.protocol.Service service = 1;</code>
        */
-      public Builder clearMinServices() {
-        bitField0_ = (bitField0_ & ~0x00000004);
-        minServices_ = 0;
+      public eu.novusmc.athena.common.Protocol.Service.Builder getServiceBuilder() {
+        bitField0_ |= 0x00000001;
         onChanged();
-        return this;
+        return getServiceFieldBuilder().getBuilder();
       }
-
-      private int maxServices_ ;
       /**
-       * <code>int32 max_services = 4;</code>
-       * @return The maxServices.
+       * <code>.protocol.Service service = 1;</code>
        */
-      @java.lang.Override
-      public int getMaxServices() {
-        return maxServices_;
+      public eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServiceOrBuilder() {
+        if (serviceBuilder_ != null) {
+          return serviceBuilder_.getMessageOrBuilder();
+        } else {
+          return service_ == null ?
+              eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
+        }
       }
       /**
-       * <code>int32 max_services = 4;</code>
-       * @param value The maxServices to set.
-       * @return This builder for chaining.
+       * <code>.protocol.Service service = 1;</code>
        */
-      public Builder setMaxServices(int value) {
-
-        maxServices_ = value;
-        bitField0_ |= 0x00000008;
-        onChanged();
-        return this;
+      private com.google.protobuf.SingleFieldBuilder<
+          eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder> 
+          getServiceFieldBuilder() {
+        if (serviceBuilder_ == null) {
+          serviceBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+              eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder>(
+                  getService(),
+                  getParentForChildren(),
+                  isClean());
+          service_ = null;
+        }
+        return serviceBuilder_;
       }
+
+      private eu.novusmc.athena.common.Protocol.Group group_;
+      private com.google.protobuf.SingleFieldBuilder<
+          eu.novusmc.athena.common.Protocol.Group, eu.novusmc.athena.common.Protocol.Group.Builder, eu.novusmc.athena.common.Protocol.GroupOrBuilder> groupBuilder_;
       /**
-       * <code>int32 max_services = 4;</code>
-       * @return This builder for chaining.
+       * <code>.protocol.Group group = 2;</code>
+       * @return Whether the group field is set.
        */
-      public Builder clearMaxServices() {
-        bitField0_ = (bitField0_ & ~0x00000008);
-        maxServices_ = 0;
-        onChanged();
-        return this;
+      public boolean hasGroup() {
+        return ((bitField0_ & 0x00000002) != 0);
       }
-
-      private int memory_ ;
       /**
-       * <code>int32 memory = 5;</code>
-       * @return The memory.
+       * <code>.protocol.Group group = 2;</code>
+       * @return The group.
        */
-      @java.lang.Override
-      public int getMemory() {
-        return memory_;
+      public eu.novusmc.athena.common.Protocol.Group getGroup() {
+        if (groupBuilder_ == null) {
+          return group_ == null ? eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
+        } else {
+          return groupBuilder_.getMessage();
+        }
       }
       /**
-       * <code>int32 memory = 5;</code>
-       * @param value The memory to set.
-       * @return This builder for chaining.
+       * <code>.protocol.Group group = 2;</code>
        */
-      public Builder setMemory(int value) {
-
-        memory_ = value;
-        bitField0_ |= 0x00000010;
+      public Builder setGroup(eu.novusmc.athena.common.Protocol.Group value) {
+        if (groupBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          group_ = value;
+        } else {
+          groupBuilder_.setMessage(value);
+        }
+        bitField0_ |= 0x00000002;
         onChanged();
         return this;
       }
       /**
-       * <code>int32 memory = 5;</code>
-       * @return This builder for chaining.
+       * <code>.protocol.Group group = 2;</code>
        */
-      public Builder clearMemory() {
-        bitField0_ = (bitField0_ & ~0x00000010);
-        memory_ = 0;
+      public Builder setGroup(
+          eu.novusmc.athena.common.Protocol.Group.Builder builderForValue) {
+        if (groupBuilder_ == null) {
+          group_ = builderForValue.build();
+        } else {
+          groupBuilder_.setMessage(builderForValue.build());
+        }
+        bitField0_ |= 0x00000002;
         onChanged();
         return this;
       }
-
-      private int startPort_ ;
       /**
-       * <code>int32 start_port = 6;</code>
-       * @return The startPort.
+       * <code>.protocol.Group group = 2;</code>
        */
-      @java.lang.Override
-      public int getStartPort() {
-        return startPort_;
+      public Builder mergeGroup(eu.novusmc.athena.common.Protocol.Group value) {
+        if (groupBuilder_ == null) {
+          if (((bitField0_ & 0x00000002) != 0) &&
+            group_ != null &&
+            group_ != eu.novusmc.athena.common.Protocol.Group.getDefaultInstance()) {
+            getGroupBuilder().mergeFrom(value);
+          } else {
+            group_ = value;
+          }
+        } else {
+          groupBuilder_.mergeFrom(value);
+        }
+        if (group_ != null) {
+          bitField0_ |= 0x00000002;
+          onChanged();
+        }
+        return this;
       }
       /**
-       * <code>int32 start_port = 6;</code>
-       * @param value The startPort to set.
-       * @return This builder for chaining.
+       * <code>.protocol.Group group = 2;</code>
        */
-      public Builder setStartPort(int value) {
-
-        startPort_ = value;
-        bitField0_ |= 0x00000020;
+      public Builder clearGroup() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        group_ = null;
+        if (groupBuilder_ != null) {
+          groupBuilder_.dispose();
+          groupBuilder_ = null;
+        }
         onChanged();
         return this;
       }
       /**
-       * <code>int32 start_port = 6;</code>
-       * @return This builder for chaining.
+       * <code>.protocol.Group group = 2;</code>
        */
-      public Builder clearStartPort() {
-        bitField0_ = (bitField0_ & ~0x00000020);
-        startPort_ = 0;
+      public eu.novusmc.athena.common.Protocol.Group.Builder getGroupBuilder() {
+        bitField0_ |= 0x00000002;
         onChanged();
-        return this;
+        return getGroupFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.protocol.Group group = 2;</code>
+       */
+      public eu.novusmc.athena.common.Protocol.GroupOrBuilder getGroupOrBuilder() {
+        if (groupBuilder_ != null) {
+          return groupBuilder_.getMessageOrBuilder();
+        } else {
+          return group_ == null ?
+              eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
+        }
+      }
+      /**
+       * <code>.protocol.Group group = 2;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilder<
+          eu.novusmc.athena.common.Protocol.Group, eu.novusmc.athena.common.Protocol.Group.Builder, eu.novusmc.athena.common.Protocol.GroupOrBuilder> 
+          getGroupFieldBuilder() {
+        if (groupBuilder_ == null) {
+          groupBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+              eu.novusmc.athena.common.Protocol.Group, eu.novusmc.athena.common.Protocol.Group.Builder, eu.novusmc.athena.common.Protocol.GroupOrBuilder>(
+                  getGroup(),
+                  getParentForChildren(),
+                  isClean());
+          group_ = null;
+        }
+        return groupBuilder_;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.Group)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketScheduleServiceRequest)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.Group)
-    private static final eu.novusmc.athena.common.Protocol.Group DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketScheduleServiceRequest)
+    private static final eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.Group();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest();
     }
 
-    public static eu.novusmc.athena.common.Protocol.Group getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<Group>
-        PARSER = new com.google.protobuf.AbstractParser<Group>() {
+    private static final com.google.protobuf.Parser<PacketScheduleServiceRequest>
+        PARSER = new com.google.protobuf.AbstractParser<PacketScheduleServiceRequest>() {
       @java.lang.Override
-      public Group parsePartialFrom(
+      public PacketScheduleServiceRequest parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -2351,48 +13244,57 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<Group> parser() {
+    public static com.google.protobuf.Parser<PacketScheduleServiceRequest> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<Group> getParserForType() {
+    public com.google.protobuf.Parser<PacketScheduleServiceRequest> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.Group getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface EnvelopeOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.Envelope)
+  public interface PacketServiceStartFailedOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceStartFailed)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>.google.protobuf.Any payload = 1;</code>
-     * @return Whether the payload field is set.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
-    boolean hasPayload();
+    java.lang.String getServiceName();
     /**
-     * <code>.google.protobuf.Any payload = 1;</code>
-     * @return The payload.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
-    com.google.protobuf.Any getPayload();
+    com.google.protobuf.ByteString
+        getServiceNameBytes();
+
     /**
-     * <code>.google.protobuf.Any payload = 1;</code>
+     * <code>string message = 2;</code>
+     * @return The message.
      */
-    com.google.protobuf.AnyOrBuilder getPayloadOrBuilder();
+    java.lang.String getMessage();
+    /**
+     * <code>string message = 2;</code>
+     * @return The bytes for message.
+     */
+    com.google.protobuf.ByteString
+        getMessageBytes();
   }
   /**
-   * Protobuf type {@code protocol.Envelope}
+   * Protobuf type {@code protocol.PacketServiceStartFailed}
    */
-  public static final class Envelope extends
+  public static final class PacketServiceStartFailed extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.Envelope)
-      EnvelopeOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketServiceStartFailed)
+      PacketServiceStartFailedOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -2401,53 +13303,106 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        Envelope.class.getName());
+        PacketServiceStartFailed.class.getName());
     }
-    // Use Envelope.newBuilder() to construct.
-    private Envelope(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketServiceStartFailed.newBuilder() to construct.
+    private PacketServiceStartFailed(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private Envelope() {
+    private PacketServiceStartFailed() {
+      serviceName_ = "";
+      message_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.Envelope.class, eu.novusmc.athena.common.Protocol.Envelope.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.class, eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.Builder.class);
     }
 
-    private int bitField0_;
-    public static final int PAYLOAD_FIELD_NUMBER = 1;
-    private com.google.protobuf.Any payload_;
+    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object serviceName_ = "";
     /**
-     * <code>.google.protobuf.Any payload = 1;</code>
-     * @return Whether the payload field is set.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
     @java.lang.Override
-    public boolean hasPayload() {
-      return ((bitField0_ & 0x00000001) != 0);
+    public java.lang.String getServiceName() {
+      java.lang.Object ref = serviceName_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        serviceName_ = s;
+        return s;
+      }
     }
     /**
-     * <code>.google.protobuf.Any payload = 1;</code>
-     * @return The payload.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     @java.lang.Override
-    public com.google.protobuf.Any getPayload() {
-      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+    public com.google.protobuf.ByteString
+        getServiceNameBytes() {
+      java.lang.Object ref = serviceName_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        serviceName_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
+
+    public static final int MESSAGE_FIELD_NUMBER = 2;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object message_ = "";
     /**
-     * <code>.google.protobuf.Any payload = 1;</code>
+     * <code>string message = 2;</code>
+     * @return The message.
      */
     @java.lang.Override
-    public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
-      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+    public java.lang.String getMessage() {
+      java.lang.Object ref = message_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        message_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string message = 2;</code>
+     * @return The bytes for message.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getMessageBytes() {
+      java.lang.Object ref = message_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        message_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
 
     private byte memoizedIsInitialized = -1;
@@ -2464,8 +13419,11 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (((bitField0_ & 0x00000001) != 0)) {
-        output.writeMessage(1, getPayload());
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 2, message_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -2476,9 +13434,11 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (((bitField0_ & 0x00000001) != 0)) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeMessageSize(1, getPayload());
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, message_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -2490,16 +13450,15 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.Envelope)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceStartFailed)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.Envelope other = (eu.novusmc.athena.common.Protocol.Envelope) obj;
+      eu.novusmc.athena.common.Protocol.PacketServiceStartFailed other = (eu.novusmc.athena.common.Protocol.PacketServiceStartFailed) obj;
 
-      if (hasPayload() != other.hasPayload()) return false;
-      if (hasPayload()) {
-        if (!getPayload()
-            .equals(other.getPayload())) return false;
-      }
+      if (!getServiceName()
+          .equals(other.getServiceName())) return false;
+      if (!getMessage()
+          .equals(other.getMessage())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -2511,53 +13470,53 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      if (hasPayload()) {
-        hash = (37 * hash) + PAYLOAD_FIELD_NUMBER;
-        hash = (53 * hash) + getPayload().hashCode();
-      }
+      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServiceName().hashCode();
+      hash = (37 * hash) + MESSAGE_FIELD_NUMBER;
+      hash = (53 * hash) + getMessage().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -2565,26 +13524,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.Envelope parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.Envelope parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.Envelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -2597,7 +13556,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.Envelope prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceStartFailed prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -2613,67 +13572,58 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.Envelope}
+     * Protobuf type {@code protocol.PacketServiceStartFailed}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.Envelope)
-        eu.novusmc.athena.common.Protocol.EnvelopeOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceStartFailed)
+        eu.novusmc.athena.common.Protocol.PacketServiceStartFailedOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.Envelope.class, eu.novusmc.athena.common.Protocol.Envelope.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.class, eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.Envelope.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.newBuilder()
       private Builder() {
-        maybeForceBuilderInitialization();
+
       }
 
       private Builder(
           com.google.protobuf.GeneratedMessage.BuilderParent parent) {
         super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessage
-                .alwaysUseFieldBuilders) {
-          getPayloadFieldBuilder();
-        }
+
       }
       @java.lang.Override
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        payload_ = null;
-        if (payloadBuilder_ != null) {
-          payloadBuilder_.dispose();
-          payloadBuilder_ = null;
-        }
+        serviceName_ = "";
+        message_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_Envelope_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.Envelope getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.Envelope.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.Envelope build() {
-        eu.novusmc.athena.common.Protocol.Envelope result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed build() {
+        eu.novusmc.athena.common.Protocol.PacketServiceStartFailed result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -2681,39 +13631,44 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.Envelope buildPartial() {
-        eu.novusmc.athena.common.Protocol.Envelope result = new eu.novusmc.athena.common.Protocol.Envelope(this);
+      public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketServiceStartFailed result = new eu.novusmc.athena.common.Protocol.PacketServiceStartFailed(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.Envelope result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceStartFailed result) {
         int from_bitField0_ = bitField0_;
-        int to_bitField0_ = 0;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.payload_ = payloadBuilder_ == null
-              ? payload_
-              : payloadBuilder_.build();
-          to_bitField0_ |= 0x00000001;
+          result.serviceName_ = serviceName_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.message_ = message_;
         }
-        result.bitField0_ |= to_bitField0_;
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.Envelope) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.Envelope)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceStartFailed) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceStartFailed)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.Envelope other) {
-        if (other == eu.novusmc.athena.common.Protocol.Envelope.getDefaultInstance()) return this;
-        if (other.hasPayload()) {
-          mergePayload(other.getPayload());
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceStartFailed other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.getDefaultInstance()) return this;
+        if (!other.getServiceName().isEmpty()) {
+          serviceName_ = other.serviceName_;
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        if (!other.getMessage().isEmpty()) {
+          message_ = other.message_;
+          bitField0_ |= 0x00000002;
+          onChanged();
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -2742,12 +13697,15 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                input.readMessage(
-                    getPayloadFieldBuilder().getBuilder(),
-                    extensionRegistry);
+                serviceName_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
+              case 18: {
+                message_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -2765,144 +13723,167 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private com.google.protobuf.Any payload_;
-      private com.google.protobuf.SingleFieldBuilder<
-          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> payloadBuilder_;
-      /**
-       * <code>.google.protobuf.Any payload = 1;</code>
-       * @return Whether the payload field is set.
-       */
-      public boolean hasPayload() {
-        return ((bitField0_ & 0x00000001) != 0);
-      }
+      private java.lang.Object serviceName_ = "";
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
-       * @return The payload.
+       * <code>string service_name = 1;</code>
+       * @return The serviceName.
        */
-      public com.google.protobuf.Any getPayload() {
-        if (payloadBuilder_ == null) {
-          return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+      public java.lang.String getServiceName() {
+        java.lang.Object ref = serviceName_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          serviceName_ = s;
+          return s;
         } else {
-          return payloadBuilder_.getMessage();
+          return (java.lang.String) ref;
         }
       }
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
+       * <code>string service_name = 1;</code>
+       * @return The bytes for serviceName.
        */
-      public Builder setPayload(com.google.protobuf.Any value) {
-        if (payloadBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          payload_ = value;
+      public com.google.protobuf.ByteString
+          getServiceNameBytes() {
+        java.lang.Object ref = serviceName_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          serviceName_ = b;
+          return b;
         } else {
-          payloadBuilder_.setMessage(value);
+          return (com.google.protobuf.ByteString) ref;
         }
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @param value The serviceName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setServiceName(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
+       * <code>string service_name = 1;</code>
+       * @return This builder for chaining.
        */
-      public Builder setPayload(
-          com.google.protobuf.Any.Builder builderForValue) {
-        if (payloadBuilder_ == null) {
-          payload_ = builderForValue.build();
-        } else {
-          payloadBuilder_.setMessage(builderForValue.build());
-        }
+      public Builder clearServiceName() {
+        serviceName_ = getDefaultInstance().getServiceName();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @param value The bytes for serviceName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setServiceNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
+
+      private java.lang.Object message_ = "";
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
+       * <code>string message = 2;</code>
+       * @return The message.
        */
-      public Builder mergePayload(com.google.protobuf.Any value) {
-        if (payloadBuilder_ == null) {
-          if (((bitField0_ & 0x00000001) != 0) &&
-            payload_ != null &&
-            payload_ != com.google.protobuf.Any.getDefaultInstance()) {
-            getPayloadBuilder().mergeFrom(value);
-          } else {
-            payload_ = value;
-          }
+      public java.lang.String getMessage() {
+        java.lang.Object ref = message_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          message_ = s;
+          return s;
         } else {
-          payloadBuilder_.mergeFrom(value);
-        }
-        if (payload_ != null) {
-          bitField0_ |= 0x00000001;
-          onChanged();
+          return (java.lang.String) ref;
         }
-        return this;
       }
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
+       * <code>string message = 2;</code>
+       * @return The bytes for message.
        */
-      public Builder clearPayload() {
-        bitField0_ = (bitField0_ & ~0x00000001);
-        payload_ = null;
-        if (payloadBuilder_ != null) {
-          payloadBuilder_.dispose();
-          payloadBuilder_ = null;
+      public com.google.protobuf.ByteString
+          getMessageBytes() {
+        java.lang.Object ref = message_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          message_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
         }
-        onChanged();
-        return this;
       }
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
+       * <code>string message = 2;</code>
+       * @param value The message to set.
+       * @return This builder for chaining.
        */
-      public com.google.protobuf.Any.Builder getPayloadBuilder() {
-        bitField0_ |= 0x00000001;
+      public Builder setMessage(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        message_ = value;
+        bitField0_ |= 0x00000002;
         onChanged();
-        return getPayloadFieldBuilder().getBuilder();
+        return this;
       }
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
+       * <code>string message = 2;</code>
+       * @return This builder for chaining.
        */
-      public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
-        if (payloadBuilder_ != null) {
-          return payloadBuilder_.getMessageOrBuilder();
-        } else {
-          return payload_ == null ?
-              com.google.protobuf.Any.getDefaultInstance() : payload_;
-        }
+      public Builder clearMessage() {
+        message_ = getDefaultInstance().getMessage();
+        bitField0_ = (bitField0_ & ~0x00000002);
+        onChanged();
+        return this;
       }
       /**
-       * <code>.google.protobuf.Any payload = 1;</code>
+       * <code>string message = 2;</code>
+       * @param value The bytes for message to set.
+       * @return This builder for chaining.
        */
-      private com.google.protobuf.SingleFieldBuilder<
-          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> 
-          getPayloadFieldBuilder() {
-        if (payloadBuilder_ == null) {
-          payloadBuilder_ = new com.google.protobuf.SingleFieldBuilder<
-              com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder>(
-                  getPayload(),
-                  getParentForChildren(),
-                  isClean());
-          payload_ = null;
-        }
-        return payloadBuilder_;
+      public Builder setMessageBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        message_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.Envelope)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceStartFailed)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.Envelope)
-    private static final eu.novusmc.athena.common.Protocol.Envelope DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketServiceStartFailed)
+    private static final eu.novusmc.athena.common.Protocol.PacketServiceStartFailed DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.Envelope();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceStartFailed();
     }
 
-    public static eu.novusmc.athena.common.Protocol.Envelope getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<Envelope>
-        PARSER = new com.google.protobuf.AbstractParser<Envelope>() {
+    private static final com.google.protobuf.Parser<PacketServiceStartFailed>
+        PARSER = new com.google.protobuf.AbstractParser<PacketServiceStartFailed>() {
       @java.lang.Override
-      public Envelope parsePartialFrom(
+      public PacketServiceStartFailed parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -2921,24 +13902,24 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<Envelope> parser() {
+    public static com.google.protobuf.Parser<PacketServiceStartFailed> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<Envelope> getParserForType() {
+    public com.google.protobuf.Parser<PacketServiceStartFailed> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.Envelope getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface ServiceEnvelopeOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.ServiceEnvelope)
+  public interface PacketServiceStoppedOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceStopped)
       com.google.protobuf.MessageOrBuilder {
 
     /**
@@ -2952,29 +13933,14 @@ public final class Protocol {
      */
     com.google.protobuf.ByteString
         getServiceNameBytes();
-
-    /**
-     * <code>.google.protobuf.Any payload = 2;</code>
-     * @return Whether the payload field is set.
-     */
-    boolean hasPayload();
-    /**
-     * <code>.google.protobuf.Any payload = 2;</code>
-     * @return The payload.
-     */
-    com.google.protobuf.Any getPayload();
-    /**
-     * <code>.google.protobuf.Any payload = 2;</code>
-     */
-    com.google.protobuf.AnyOrBuilder getPayloadOrBuilder();
   }
   /**
-   * Protobuf type {@code protocol.ServiceEnvelope}
+   * Protobuf type {@code protocol.PacketServiceStopped}
    */
-  public static final class ServiceEnvelope extends
+  public static final class PacketServiceStopped extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.ServiceEnvelope)
-      ServiceEnvelopeOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketServiceStopped)
+      PacketServiceStoppedOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -2983,30 +13949,29 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        ServiceEnvelope.class.getName());
+        PacketServiceStopped.class.getName());
     }
-    // Use ServiceEnvelope.newBuilder() to construct.
-    private ServiceEnvelope(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketServiceStopped.newBuilder() to construct.
+    private PacketServiceStopped(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private ServiceEnvelope() {
+    private PacketServiceStopped() {
       serviceName_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.ServiceEnvelope.class, eu.novusmc.athena.common.Protocol.ServiceEnvelope.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketServiceStopped.class, eu.novusmc.athena.common.Protocol.PacketServiceStopped.Builder.class);
     }
 
-    private int bitField0_;
     public static final int SERVICE_NAME_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
     private volatile java.lang.Object serviceName_ = "";
@@ -3040,36 +14005,10 @@ public final class Protocol {
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
         serviceName_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    public static final int PAYLOAD_FIELD_NUMBER = 2;
-    private com.google.protobuf.Any payload_;
-    /**
-     * <code>.google.protobuf.Any payload = 2;</code>
-     * @return Whether the payload field is set.
-     */
-    @java.lang.Override
-    public boolean hasPayload() {
-      return ((bitField0_ & 0x00000001) != 0);
-    }
-    /**
-     * <code>.google.protobuf.Any payload = 2;</code>
-     * @return The payload.
-     */
-    @java.lang.Override
-    public com.google.protobuf.Any getPayload() {
-      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
-    }
-    /**
-     * <code>.google.protobuf.Any payload = 2;</code>
-     */
-    @java.lang.Override
-    public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
-      return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
 
     private byte memoizedIsInitialized = -1;
@@ -3089,9 +14028,6 @@ public final class Protocol {
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
         com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
       }
-      if (((bitField0_ & 0x00000001) != 0)) {
-        output.writeMessage(2, getPayload());
-      }
       getUnknownFields().writeTo(output);
     }
 
@@ -3104,10 +14040,6 @@ public final class Protocol {
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
         size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
       }
-      if (((bitField0_ & 0x00000001) != 0)) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeMessageSize(2, getPayload());
-      }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
       return size;
@@ -3118,18 +14050,13 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.ServiceEnvelope)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceStopped)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.ServiceEnvelope other = (eu.novusmc.athena.common.Protocol.ServiceEnvelope) obj;
+      eu.novusmc.athena.common.Protocol.PacketServiceStopped other = (eu.novusmc.athena.common.Protocol.PacketServiceStopped) obj;
 
       if (!getServiceName()
           .equals(other.getServiceName())) return false;
-      if (hasPayload() != other.hasPayload()) return false;
-      if (hasPayload()) {
-        if (!getPayload()
-            .equals(other.getPayload())) return false;
-      }
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -3143,53 +14070,49 @@ public final class Protocol {
       hash = (19 * hash) + getDescriptor().hashCode();
       hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
       hash = (53 * hash) + getServiceName().hashCode();
-      if (hasPayload()) {
-        hash = (37 * hash) + PAYLOAD_FIELD_NUMBER;
-        hash = (53 * hash) + getPayload().hashCode();
-      }
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -3197,26 +14120,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -3229,7 +14152,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.ServiceEnvelope prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceStopped prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -3245,68 +14168,57 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.ServiceEnvelope}
+     * Protobuf type {@code protocol.PacketServiceStopped}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.ServiceEnvelope)
-        eu.novusmc.athena.common.Protocol.ServiceEnvelopeOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceStopped)
+        eu.novusmc.athena.common.Protocol.PacketServiceStoppedOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.ServiceEnvelope.class, eu.novusmc.athena.common.Protocol.ServiceEnvelope.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketServiceStopped.class, eu.novusmc.athena.common.Protocol.PacketServiceStopped.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.ServiceEnvelope.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceStopped.newBuilder()
       private Builder() {
-        maybeForceBuilderInitialization();
+
       }
 
       private Builder(
           com.google.protobuf.GeneratedMessage.BuilderParent parent) {
         super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessage
-                .alwaysUseFieldBuilders) {
-          getPayloadFieldBuilder();
-        }
+
       }
       @java.lang.Override
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
         serviceName_ = "";
-        payload_ = null;
-        if (payloadBuilder_ != null) {
-          payloadBuilder_.dispose();
-          payloadBuilder_ = null;
-        }
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_ServiceEnvelope_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.ServiceEnvelope getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.ServiceEnvelope.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketServiceStopped getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketServiceStopped.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.ServiceEnvelope build() {
-        eu.novusmc.athena.common.Protocol.ServiceEnvelope result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketServiceStopped build() {
+        eu.novusmc.athena.common.Protocol.PacketServiceStopped result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -3314,48 +14226,37 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.ServiceEnvelope buildPartial() {
-        eu.novusmc.athena.common.Protocol.ServiceEnvelope result = new eu.novusmc.athena.common.Protocol.ServiceEnvelope(this);
+      public eu.novusmc.athena.common.Protocol.PacketServiceStopped buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketServiceStopped result = new eu.novusmc.athena.common.Protocol.PacketServiceStopped(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.ServiceEnvelope result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceStopped result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
           result.serviceName_ = serviceName_;
         }
-        int to_bitField0_ = 0;
-        if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.payload_ = payloadBuilder_ == null
-              ? payload_
-              : payloadBuilder_.build();
-          to_bitField0_ |= 0x00000001;
-        }
-        result.bitField0_ |= to_bitField0_;
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.ServiceEnvelope) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.ServiceEnvelope)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceStopped) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceStopped)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.ServiceEnvelope other) {
-        if (other == eu.novusmc.athena.common.Protocol.ServiceEnvelope.getDefaultInstance()) return this;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceStopped other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketServiceStopped.getDefaultInstance()) return this;
         if (!other.getServiceName().isEmpty()) {
           serviceName_ = other.serviceName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
-        if (other.hasPayload()) {
-          mergePayload(other.getPayload());
-        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -3387,13 +14288,6 @@ public final class Protocol {
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
-              case 18: {
-                input.readMessage(
-                    getPayloadFieldBuilder().getBuilder(),
-                    extensionRegistry);
-                bitField0_ |= 0x00000002;
-                break;
-              } // case 18
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -3483,144 +14377,23 @@ public final class Protocol {
         return this;
       }
 
-      private com.google.protobuf.Any payload_;
-      private com.google.protobuf.SingleFieldBuilder<
-          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> payloadBuilder_;
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       * @return Whether the payload field is set.
-       */
-      public boolean hasPayload() {
-        return ((bitField0_ & 0x00000002) != 0);
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       * @return The payload.
-       */
-      public com.google.protobuf.Any getPayload() {
-        if (payloadBuilder_ == null) {
-          return payload_ == null ? com.google.protobuf.Any.getDefaultInstance() : payload_;
-        } else {
-          return payloadBuilder_.getMessage();
-        }
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       */
-      public Builder setPayload(com.google.protobuf.Any value) {
-        if (payloadBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          payload_ = value;
-        } else {
-          payloadBuilder_.setMessage(value);
-        }
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       */
-      public Builder setPayload(
-          com.google.protobuf.Any.Builder builderForValue) {
-        if (payloadBuilder_ == null) {
-          payload_ = builderForValue.build();
-        } else {
-          payloadBuilder_.setMessage(builderForValue.build());
-        }
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       */
-      public Builder mergePayload(com.google.protobuf.Any value) {
-        if (payloadBuilder_ == null) {
-          if (((bitField0_ & 0x00000002) != 0) &&
-            payload_ != null &&
-            payload_ != com.google.protobuf.Any.getDefaultInstance()) {
-            getPayloadBuilder().mergeFrom(value);
-          } else {
-            payload_ = value;
-          }
-        } else {
-          payloadBuilder_.mergeFrom(value);
-        }
-        if (payload_ != null) {
-          bitField0_ |= 0x00000002;
-          onChanged();
-        }
-        return this;
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       */
-      public Builder clearPayload() {
-        bitField0_ = (bitField0_ & ~0x00000002);
-        payload_ = null;
-        if (payloadBuilder_ != null) {
-          payloadBuilder_.dispose();
-          payloadBuilder_ = null;
-        }
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       */
-      public com.google.protobuf.Any.Builder getPayloadBuilder() {
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return getPayloadFieldBuilder().getBuilder();
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       */
-      public com.google.protobuf.AnyOrBuilder getPayloadOrBuilder() {
-        if (payloadBuilder_ != null) {
-          return payloadBuilder_.getMessageOrBuilder();
-        } else {
-          return payload_ == null ?
-              com.google.protobuf.Any.getDefaultInstance() : payload_;
-        }
-      }
-      /**
-       * <code>.google.protobuf.Any payload = 2;</code>
-       */
-      private com.google.protobuf.SingleFieldBuilder<
-          com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder> 
-          getPayloadFieldBuilder() {
-        if (payloadBuilder_ == null) {
-          payloadBuilder_ = new com.google.protobuf.SingleFieldBuilder<
-              com.google.protobuf.Any, com.google.protobuf.Any.Builder, com.google.protobuf.AnyOrBuilder>(
-                  getPayload(),
-                  getParentForChildren(),
-                  isClean());
-          payload_ = null;
-        }
-        return payloadBuilder_;
-      }
-
-      // @@protoc_insertion_point(builder_scope:protocol.ServiceEnvelope)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceStopped)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.ServiceEnvelope)
-    private static final eu.novusmc.athena.common.Protocol.ServiceEnvelope DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketServiceStopped)
+    private static final eu.novusmc.athena.common.Protocol.PacketServiceStopped DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.ServiceEnvelope();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceStopped();
     }
 
-    public static eu.novusmc.athena.common.Protocol.ServiceEnvelope getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<ServiceEnvelope>
-        PARSER = new com.google.protobuf.AbstractParser<ServiceEnvelope>() {
+    private static final com.google.protobuf.Parser<PacketServiceStopped>
+        PARSER = new com.google.protobuf.AbstractParser<PacketServiceStopped>() {
       @java.lang.Override
-      public ServiceEnvelope parsePartialFrom(
+      public PacketServiceStopped parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -3639,63 +14412,51 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<ServiceEnvelope> parser() {
+    public static com.google.protobuf.Parser<PacketServiceStopped> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<ServiceEnvelope> getParserForType() {
+    public com.google.protobuf.Parser<PacketServiceStopped> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.ServiceEnvelope getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketServiceStopped getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketAuthenticateOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketAuthenticate)
+  public interface PacketServiceOnlineOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceOnline)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string slave_name = 1;</code>
-     * @return The slaveName.
-     */
-    java.lang.String getSlaveName();
-    /**
-     * <code>string slave_name = 1;</code>
-     * @return The bytes for slaveName.
-     */
-    com.google.protobuf.ByteString
-        getSlaveNameBytes();
-
-    /**
-     * <code>string secret_key = 2;</code>
-     * @return The secretKey.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
-    java.lang.String getSecretKey();
+    java.lang.String getServiceName();
     /**
-     * <code>string secret_key = 2;</code>
-     * @return The bytes for secretKey.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     com.google.protobuf.ByteString
-        getSecretKeyBytes();
+        getServiceNameBytes();
 
     /**
-     * <code>int32 memory = 3;</code>
-     * @return The memory.
+     * <code>int32 port = 2;</code>
+     * @return The port.
      */
-    int getMemory();
+    int getPort();
   }
   /**
-   * Protobuf type {@code protocol.PacketAuthenticate}
+   * Protobuf type {@code protocol.PacketServiceOnline}
    */
-  public static final class PacketAuthenticate extends
+  public static final class PacketServiceOnline extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketAuthenticate)
-      PacketAuthenticateOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketServiceOnline)
+      PacketServiceOnlineOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -3704,117 +14465,77 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketAuthenticate.class.getName());
+        PacketServiceOnline.class.getName());
     }
-    // Use PacketAuthenticate.newBuilder() to construct.
-    private PacketAuthenticate(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketServiceOnline.newBuilder() to construct.
+    private PacketServiceOnline(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketAuthenticate() {
-      slaveName_ = "";
-      secretKey_ = "";
+    private PacketServiceOnline() {
+      serviceName_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketAuthenticate.class, eu.novusmc.athena.common.Protocol.PacketAuthenticate.Builder.class);
-    }
-
-    public static final int SLAVE_NAME_FIELD_NUMBER = 1;
-    @SuppressWarnings("serial")
-    private volatile java.lang.Object slaveName_ = "";
-    /**
-     * <code>string slave_name = 1;</code>
-     * @return The slaveName.
-     */
-    @java.lang.Override
-    public java.lang.String getSlaveName() {
-      java.lang.Object ref = slaveName_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        slaveName_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string slave_name = 1;</code>
-     * @return The bytes for slaveName.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getSlaveNameBytes() {
-      java.lang.Object ref = slaveName_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        slaveName_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+              eu.novusmc.athena.common.Protocol.PacketServiceOnline.class, eu.novusmc.athena.common.Protocol.PacketServiceOnline.Builder.class);
     }
 
-    public static final int SECRET_KEY_FIELD_NUMBER = 2;
+    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object secretKey_ = "";
+    private volatile java.lang.Object serviceName_ = "";
     /**
-     * <code>string secret_key = 2;</code>
-     * @return The secretKey.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
     @java.lang.Override
-    public java.lang.String getSecretKey() {
-      java.lang.Object ref = secretKey_;
+    public java.lang.String getServiceName() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        secretKey_ = s;
+        serviceName_ = s;
         return s;
       }
     }
     /**
-     * <code>string secret_key = 2;</code>
-     * @return The bytes for secretKey.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getSecretKeyBytes() {
-      java.lang.Object ref = secretKey_;
+        getServiceNameBytes() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        secretKey_ = b;
+        serviceName_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
       }
     }
 
-    public static final int MEMORY_FIELD_NUMBER = 3;
-    private int memory_ = 0;
+    public static final int PORT_FIELD_NUMBER = 2;
+    private int port_ = 0;
     /**
-     * <code>int32 memory = 3;</code>
-     * @return The memory.
+     * <code>int32 port = 2;</code>
+     * @return The port.
      */
     @java.lang.Override
-    public int getMemory() {
-      return memory_;
+    public int getPort() {
+      return port_;
     }
 
     private byte memoizedIsInitialized = -1;
@@ -3831,14 +14552,11 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(slaveName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, slaveName_);
-      }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(secretKey_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 2, secretKey_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
       }
-      if (memory_ != 0) {
-        output.writeInt32(3, memory_);
+      if (port_ != 0) {
+        output.writeInt32(2, port_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -3849,15 +14567,12 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(slaveName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, slaveName_);
-      }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(secretKey_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, secretKey_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
       }
-      if (memory_ != 0) {
+      if (port_ != 0) {
         size += com.google.protobuf.CodedOutputStream
-          .computeInt32Size(3, memory_);
+          .computeInt32Size(2, port_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -3869,17 +14584,15 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAuthenticate)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceOnline)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketAuthenticate other = (eu.novusmc.athena.common.Protocol.PacketAuthenticate) obj;
+      eu.novusmc.athena.common.Protocol.PacketServiceOnline other = (eu.novusmc.athena.common.Protocol.PacketServiceOnline) obj;
 
-      if (!getSlaveName()
-          .equals(other.getSlaveName())) return false;
-      if (!getSecretKey()
-          .equals(other.getSecretKey())) return false;
-      if (getMemory()
-          != other.getMemory()) return false;
+      if (!getServiceName()
+          .equals(other.getServiceName())) return false;
+      if (getPort()
+          != other.getPort()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -3891,55 +14604,53 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SLAVE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getSlaveName().hashCode();
-      hash = (37 * hash) + SECRET_KEY_FIELD_NUMBER;
-      hash = (53 * hash) + getSecretKey().hashCode();
-      hash = (37 * hash) + MEMORY_FIELD_NUMBER;
-      hash = (53 * hash) + getMemory();
+      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServiceName().hashCode();
+      hash = (37 * hash) + PORT_FIELD_NUMBER;
+      hash = (53 * hash) + getPort();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -3947,26 +14658,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -3979,7 +14690,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAuthenticate prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceOnline prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -3995,26 +14706,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketAuthenticate}
+     * Protobuf type {@code protocol.PacketServiceOnline}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketAuthenticate)
-        eu.novusmc.athena.common.Protocol.PacketAuthenticateOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceOnline)
+        eu.novusmc.athena.common.Protocol.PacketServiceOnlineOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketAuthenticate.class, eu.novusmc.athena.common.Protocol.PacketAuthenticate.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketServiceOnline.class, eu.novusmc.athena.common.Protocol.PacketServiceOnline.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketAuthenticate.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceOnline.newBuilder()
       private Builder() {
 
       }
@@ -4028,26 +14739,25 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        slaveName_ = "";
-        secretKey_ = "";
-        memory_ = 0;
+        serviceName_ = "";
+        port_ = 0;
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthenticate_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthenticate getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketAuthenticate.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketServiceOnline getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketServiceOnline.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthenticate build() {
-        eu.novusmc.athena.common.Protocol.PacketAuthenticate result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketServiceOnline build() {
+        eu.novusmc.athena.common.Protocol.PacketServiceOnline result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -4055,50 +14765,42 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthenticate buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketAuthenticate result = new eu.novusmc.athena.common.Protocol.PacketAuthenticate(this);
+      public eu.novusmc.athena.common.Protocol.PacketServiceOnline buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketServiceOnline result = new eu.novusmc.athena.common.Protocol.PacketServiceOnline(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketAuthenticate result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceOnline result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.slaveName_ = slaveName_;
-        }
-        if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.secretKey_ = secretKey_;
+          result.serviceName_ = serviceName_;
         }
-        if (((from_bitField0_ & 0x00000004) != 0)) {
-          result.memory_ = memory_;
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.port_ = port_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAuthenticate) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAuthenticate)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceOnline) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceOnline)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAuthenticate other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketAuthenticate.getDefaultInstance()) return this;
-        if (!other.getSlaveName().isEmpty()) {
-          slaveName_ = other.slaveName_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceOnline other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketServiceOnline.getDefaultInstance()) return this;
+        if (!other.getServiceName().isEmpty()) {
+          serviceName_ = other.serviceName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
-        if (!other.getSecretKey().isEmpty()) {
-          secretKey_ = other.secretKey_;
-          bitField0_ |= 0x00000002;
-          onChanged();
-        }
-        if (other.getMemory() != 0) {
-          setMemory(other.getMemory());
+        if (other.getPort() != 0) {
+          setPort(other.getPort());
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -4127,20 +14829,15 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                slaveName_ = input.readStringRequireUtf8();
+                serviceName_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
-              case 18: {
-                secretKey_ = input.readStringRequireUtf8();
+              case 16: {
+                port_ = input.readInt32();
                 bitField0_ |= 0x00000002;
                 break;
-              } // case 18
-              case 24: {
-                memory_ = input.readInt32();
-                bitField0_ |= 0x00000004;
-                break;
-              } // case 24
+              } // case 16
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -4158,199 +14855,127 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object slaveName_ = "";
+      private java.lang.Object serviceName_ = "";
       /**
-       * <code>string slave_name = 1;</code>
-       * @return The slaveName.
+       * <code>string service_name = 1;</code>
+       * @return The serviceName.
        */
-      public java.lang.String getSlaveName() {
-        java.lang.Object ref = slaveName_;
+      public java.lang.String getServiceName() {
+        java.lang.Object ref = serviceName_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          slaveName_ = s;
+          serviceName_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string slave_name = 1;</code>
-       * @return The bytes for slaveName.
+       * <code>string service_name = 1;</code>
+       * @return The bytes for serviceName.
        */
       public com.google.protobuf.ByteString
-          getSlaveNameBytes() {
-        java.lang.Object ref = slaveName_;
+          getServiceNameBytes() {
+        java.lang.Object ref = serviceName_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          slaveName_ = b;
+          serviceName_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string slave_name = 1;</code>
-       * @param value The slaveName to set.
+       * <code>string service_name = 1;</code>
+       * @param value The serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setSlaveName(
+      public Builder setServiceName(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        slaveName_ = value;
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string slave_name = 1;</code>
+       * <code>string service_name = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearSlaveName() {
-        slaveName_ = getDefaultInstance().getSlaveName();
+      public Builder clearServiceName() {
+        serviceName_ = getDefaultInstance().getServiceName();
         bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string slave_name = 1;</code>
-       * @param value The bytes for slaveName to set.
+       * <code>string service_name = 1;</code>
+       * @param value The bytes for serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setSlaveNameBytes(
+      public Builder setServiceNameBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        slaveName_ = value;
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      private java.lang.Object secretKey_ = "";
-      /**
-       * <code>string secret_key = 2;</code>
-       * @return The secretKey.
-       */
-      public java.lang.String getSecretKey() {
-        java.lang.Object ref = secretKey_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          secretKey_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string secret_key = 2;</code>
-       * @return The bytes for secretKey.
-       */
-      public com.google.protobuf.ByteString
-          getSecretKeyBytes() {
-        java.lang.Object ref = secretKey_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          secretKey_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string secret_key = 2;</code>
-       * @param value The secretKey to set.
-       * @return This builder for chaining.
-       */
-      public Builder setSecretKey(
-          java.lang.String value) {
-        if (value == null) { throw new NullPointerException(); }
-        secretKey_ = value;
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string secret_key = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearSecretKey() {
-        secretKey_ = getDefaultInstance().getSecretKey();
-        bitField0_ = (bitField0_ & ~0x00000002);
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string secret_key = 2;</code>
-       * @param value The bytes for secretKey to set.
-       * @return This builder for chaining.
-       */
-      public Builder setSecretKeyBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) { throw new NullPointerException(); }
-        checkByteStringIsUtf8(value);
-        secretKey_ = value;
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-
-      private int memory_ ;
+      private int port_ ;
       /**
-       * <code>int32 memory = 3;</code>
-       * @return The memory.
+       * <code>int32 port = 2;</code>
+       * @return The port.
        */
       @java.lang.Override
-      public int getMemory() {
-        return memory_;
+      public int getPort() {
+        return port_;
       }
       /**
-       * <code>int32 memory = 3;</code>
-       * @param value The memory to set.
+       * <code>int32 port = 2;</code>
+       * @param value The port to set.
        * @return This builder for chaining.
        */
-      public Builder setMemory(int value) {
+      public Builder setPort(int value) {
 
-        memory_ = value;
-        bitField0_ |= 0x00000004;
+        port_ = value;
+        bitField0_ |= 0x00000002;
         onChanged();
         return this;
       }
       /**
-       * <code>int32 memory = 3;</code>
+       * <code>int32 port = 2;</code>
        * @return This builder for chaining.
        */
-      public Builder clearMemory() {
-        bitField0_ = (bitField0_ & ~0x00000004);
-        memory_ = 0;
+      public Builder clearPort() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        port_ = 0;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketAuthenticate)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceOnline)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketAuthenticate)
-    private static final eu.novusmc.athena.common.Protocol.PacketAuthenticate DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketServiceOnline)
+    private static final eu.novusmc.athena.common.Protocol.PacketServiceOnline DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAuthenticate();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceOnline();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthenticate getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketAuthenticate>
-        PARSER = new com.google.protobuf.AbstractParser<PacketAuthenticate>() {
+    private static final com.google.protobuf.Parser<PacketServiceOnline>
+        PARSER = new com.google.protobuf.AbstractParser<PacketServiceOnline>() {
       @java.lang.Override
-      public PacketAuthenticate parsePartialFrom(
+      public PacketServiceOnline parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -4369,33 +14994,51 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketAuthenticate> parser() {
+    public static com.google.protobuf.Parser<PacketServiceOnline> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketAuthenticate> getParserForType() {
+    public com.google.protobuf.Parser<PacketServiceOnline> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketAuthenticate getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketServiceOnline getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketAuthSuccessOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketAuthSuccess)
+  public interface PacketServicePlayerCountOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketServicePlayerCount)
       com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
+     */
+    java.lang.String getServiceName();
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
+     */
+    com.google.protobuf.ByteString
+        getServiceNameBytes();
+
+    /**
+     * <code>int32 players = 2;</code>
+     * @return The players.
+     */
+    int getPlayers();
   }
   /**
-   * Protobuf type {@code protocol.PacketAuthSuccess}
+   * Protobuf type {@code protocol.PacketServicePlayerCount}
    */
-  public static final class PacketAuthSuccess extends
+  public static final class PacketServicePlayerCount extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketAuthSuccess)
-      PacketAuthSuccessOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketServicePlayerCount)
+      PacketServicePlayerCountOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -4404,26 +15047,77 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketAuthSuccess.class.getName());
+        PacketServicePlayerCount.class.getName());
     }
-    // Use PacketAuthSuccess.newBuilder() to construct.
-    private PacketAuthSuccess(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketServicePlayerCount.newBuilder() to construct.
+    private PacketServicePlayerCount(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketAuthSuccess() {
+    private PacketServicePlayerCount() {
+      serviceName_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServicePlayerCount_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServicePlayerCount_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketServicePlayerCount.class, eu.novusmc.athena.common.Protocol.PacketServicePlayerCount.Builder.class);
+    }
+
+    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object serviceName_ = "";
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
+     */
+    @java.lang.Override
+    public java.lang.String getServiceName() {
+      java.lang.Object ref = serviceName_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        serviceName_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getServiceNameBytes() {
+      java.lang.Object ref = serviceName_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        serviceName_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
 
+    public static final int PLAYERS_FIELD_NUMBER = 2;
+    private int players_ = 0;
+    /**
+     * <code>int32 players = 2;</code>
+     * @return The players.
+     */
     @java.lang.Override
-    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketAuthSuccess.class, eu.novusmc.athena.common.Protocol.PacketAuthSuccess.Builder.class);
+    public int getPlayers() {
+      return players_;
     }
 
     private byte memoizedIsInitialized = -1;
@@ -4440,6 +15134,12 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      }
+      if (players_ != 0) {
+        output.writeInt32(2, players_);
+      }
       getUnknownFields().writeTo(output);
     }
 
@@ -4449,6 +15149,13 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      }
+      if (players_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(2, players_);
+      }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
       return size;
@@ -4459,11 +15166,15 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAuthSuccess)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServicePlayerCount)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketAuthSuccess other = (eu.novusmc.athena.common.Protocol.PacketAuthSuccess) obj;
+      eu.novusmc.athena.common.Protocol.PacketServicePlayerCount other = (eu.novusmc.athena.common.Protocol.PacketServicePlayerCount) obj;
 
+      if (!getServiceName()
+          .equals(other.getServiceName())) return false;
+      if (getPlayers()
+          != other.getPlayers()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -4475,49 +15186,53 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServiceName().hashCode();
+      hash = (37 * hash) + PLAYERS_FIELD_NUMBER;
+      hash = (53 * hash) + getPlayers();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -4525,26 +15240,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -4557,7 +15272,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAuthSuccess prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServicePlayerCount prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -4573,26 +15288,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketAuthSuccess}
+     * Protobuf type {@code protocol.PacketServicePlayerCount}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketAuthSuccess)
-        eu.novusmc.athena.common.Protocol.PacketAuthSuccessOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketServicePlayerCount)
+        eu.novusmc.athena.common.Protocol.PacketServicePlayerCountOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServicePlayerCount_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServicePlayerCount_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketAuthSuccess.class, eu.novusmc.athena.common.Protocol.PacketAuthSuccess.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketServicePlayerCount.class, eu.novusmc.athena.common.Protocol.PacketServicePlayerCount.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketAuthSuccess.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketServicePlayerCount.newBuilder()
       private Builder() {
 
       }
@@ -4605,23 +15320,26 @@ public final class Protocol {
       @java.lang.Override
       public Builder clear() {
         super.clear();
+        bitField0_ = 0;
+        serviceName_ = "";
+        players_ = 0;
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthSuccess_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServicePlayerCount_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthSuccess getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketAuthSuccess.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketServicePlayerCount getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketServicePlayerCount.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthSuccess build() {
-        eu.novusmc.athena.common.Protocol.PacketAuthSuccess result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketServicePlayerCount build() {
+        eu.novusmc.athena.common.Protocol.PacketServicePlayerCount result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -4629,24 +15347,43 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthSuccess buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketAuthSuccess result = new eu.novusmc.athena.common.Protocol.PacketAuthSuccess(this);
+      public eu.novusmc.athena.common.Protocol.PacketServicePlayerCount buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketServicePlayerCount result = new eu.novusmc.athena.common.Protocol.PacketServicePlayerCount(this);
+        if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServicePlayerCount result) {
+        int from_bitField0_ = bitField0_;
+        if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.serviceName_ = serviceName_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.players_ = players_;
+        }
+      }
+
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAuthSuccess) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAuthSuccess)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServicePlayerCount) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServicePlayerCount)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAuthSuccess other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketAuthSuccess.getDefaultInstance()) return this;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServicePlayerCount other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketServicePlayerCount.getDefaultInstance()) return this;
+        if (!other.getServiceName().isEmpty()) {
+          serviceName_ = other.serviceName_;
+          bitField0_ |= 0x00000001;
+          onChanged();
+        }
+        if (other.getPlayers() != 0) {
+          setPlayers(other.getPlayers());
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -4673,6 +15410,16 @@ public final class Protocol {
               case 0:
                 done = true;
                 break;
+              case 10: {
+                serviceName_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              case 16: {
+                players_ = input.readInt32();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 16
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -4688,24 +15435,129 @@ public final class Protocol {
         } // finally
         return this;
       }
+      private int bitField0_;
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketAuthSuccess)
+      private java.lang.Object serviceName_ = "";
+      /**
+       * <code>string service_name = 1;</code>
+       * @return The serviceName.
+       */
+      public java.lang.String getServiceName() {
+        java.lang.Object ref = serviceName_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          serviceName_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @return The bytes for serviceName.
+       */
+      public com.google.protobuf.ByteString
+          getServiceNameBytes() {
+        java.lang.Object ref = serviceName_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          serviceName_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @param value The serviceName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setServiceName(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        serviceName_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearServiceName() {
+        serviceName_ = getDefaultInstance().getServiceName();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string service_name = 1;</code>
+       * @param value The bytes for serviceName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setServiceNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        serviceName_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+
+      private int players_ ;
+      /**
+       * <code>int32 players = 2;</code>
+       * @return The players.
+       */
+      @java.lang.Override
+      public int getPlayers() {
+        return players_;
+      }
+      /**
+       * <code>int32 players = 2;</code>
+       * @param value The players to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPlayers(int value) {
+
+        players_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 players = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPlayers() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        players_ = 0;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketServicePlayerCount)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketAuthSuccess)
-    private static final eu.novusmc.athena.common.Protocol.PacketAuthSuccess DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketServicePlayerCount)
+    private static final eu.novusmc.athena.common.Protocol.PacketServicePlayerCount DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAuthSuccess();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServicePlayerCount();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthSuccess getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketServicePlayerCount getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketAuthSuccess>
-        PARSER = new com.google.protobuf.AbstractParser<PacketAuthSuccess>() {
+    private static final com.google.protobuf.Parser<PacketServicePlayerCount>
+        PARSER = new com.google.protobuf.AbstractParser<PacketServicePlayerCount>() {
       @java.lang.Override
-      public PacketAuthSuccess parsePartialFrom(
+      public PacketServicePlayerCount parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -4724,45 +15576,71 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketAuthSuccess> parser() {
+    public static com.google.protobuf.Parser<PacketServicePlayerCount> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketAuthSuccess> getParserForType() {
+    public com.google.protobuf.Parser<PacketServicePlayerCount> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketAuthSuccess getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketServicePlayerCount getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketAuthFailedOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketAuthFailed)
+  public interface PacketSlaveMemoryUsageOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketSlaveMemoryUsage)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string message = 1;</code>
-     * @return The message.
+     * <code>int32 used_memory = 1;</code>
+     * @return The usedMemory.
      */
-    java.lang.String getMessage();
+    int getUsedMemory();
+
     /**
-     * <code>string message = 1;</code>
-     * @return The bytes for message.
+     * <code>map&lt;string, int32&gt; services = 2;</code>
      */
-    com.google.protobuf.ByteString
-        getMessageBytes();
+    int getServicesCount();
+    /**
+     * <code>map&lt;string, int32&gt; services = 2;</code>
+     */
+    boolean containsServices(
+        java.lang.String key);
+    /**
+     * Use {@link #getServicesMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.String, java.lang.Integer>
+    getServices();
+    /**
+     * <code>map&lt;string, int32&gt; services = 2;</code>
+     */
+    java.util.Map<java.lang.String, java.lang.Integer>
+    getServicesMap();
+    /**
+     * <code>map&lt;string, int32&gt; services = 2;</code>
+     */
+    int getServicesOrDefault(
+        java.lang.String key,
+        int defaultValue);
+    /**
+     * <code>map&lt;string, int32&gt; services = 2;</code>
+     */
+    int getServicesOrThrow(
+        java.lang.String key);
   }
   /**
-   * Protobuf type {@code protocol.PacketAuthFailed}
+   * Protobuf type {@code protocol.PacketSlaveMemoryUsage}
    */
-  public static final class PacketAuthFailed extends
+  public static final class PacketSlaveMemoryUsage extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketAuthFailed)
-      PacketAuthFailedOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketSlaveMemoryUsage)
+      PacketSlaveMemoryUsageOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -4771,66 +15649,126 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketAuthFailed.class.getName());
+        PacketSlaveMemoryUsage.class.getName());
     }
-    // Use PacketAuthFailed.newBuilder() to construct.
-    private PacketAuthFailed(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketSlaveMemoryUsage.newBuilder() to construct.
+    private PacketSlaveMemoryUsage(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketAuthFailed() {
-      message_ = "";
+    private PacketSlaveMemoryUsage() {
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSlaveMemoryUsage_descriptor;
     }
 
+    @SuppressWarnings({"rawtypes"})
+    @java.lang.Override
+    protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+        int number) {
+      switch (number) {
+        case 2:
+          return internalGetServices();
+        default:
+          throw new RuntimeException(
+              "Invalid map field number: " + number);
+      }
+    }
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSlaveMemoryUsage_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketAuthFailed.class, eu.novusmc.athena.common.Protocol.PacketAuthFailed.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage.class, eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage.Builder.class);
+    }
+
+    public static final int USED_MEMORY_FIELD_NUMBER = 1;
+    private int usedMemory_ = 0;
+    /**
+     * <code>int32 used_memory = 1;</code>
+     * @return The usedMemory.
+     */
+    @java.lang.Override
+    public int getUsedMemory() {
+      return usedMemory_;
+    }
+
+    public static final int SERVICES_FIELD_NUMBER = 2;
+    private static final class ServicesDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.String, java.lang.Integer> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.String, java.lang.Integer>newDefaultInstance(
+                  eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSlaveMemoryUsage_ServicesEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.STRING,
+                  "",
+                  com.google.protobuf.WireFormat.FieldType.INT32,
+                  0);
+    }
+    @SuppressWarnings("serial")
+    private com.google.protobuf.MapField<
+        java.lang.String, java.lang.Integer> services_;
+    private com.google.protobuf.MapField<java.lang.String, java.lang.Integer>
+    internalGetServices() {
+      if (services_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            ServicesDefaultEntryHolder.defaultEntry);
+      }
+      return services_;
+    }
+    public int getServicesCount() {
+      return internalGetServices().getMap().size();
+    }
+    /**
+     * <code>map&lt;string, int32&gt; services = 2;</code>
+     */
+    @java.lang.Override
+    public boolean containsServices(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      return internalGetServices().getMap().containsKey(key);
+    }
+    /**
+     * Use {@link #getServicesMap()} instead.
+     */
+    @java.lang.Override
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.String, java.lang.Integer> getServices() {
+      return getServicesMap();
+    }
+    /**
+     * <code>map&lt;string, int32&gt; services = 2;</code>
+     */
+    @java.lang.Override
+    public java.util.Map<java.lang.String, java.lang.Integer> getServicesMap() {
+      return internalGetServices().getMap();
     }
-
-    public static final int MESSAGE_FIELD_NUMBER = 1;
-    @SuppressWarnings("serial")
-    private volatile java.lang.Object message_ = "";
     /**
-     * <code>string message = 1;</code>
-     * @return The message.
+     * <code>map&lt;string, int32&gt; services = 2;</code>
      */
     @java.lang.Override
-    public java.lang.String getMessage() {
-      java.lang.Object ref = message_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        message_ = s;
-        return s;
-      }
+    public int getServicesOrDefault(
+        java.lang.String key,
+        int defaultValue) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.Integer> map =
+          internalGetServices().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
     }
     /**
-     * <code>string message = 1;</code>
-     * @return The bytes for message.
+     * <code>map&lt;string, int32&gt; services = 2;</code>
      */
     @java.lang.Override
-    public com.google.protobuf.ByteString
-        getMessageBytes() {
-      java.lang.Object ref = message_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        message_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
+    public int getServicesOrThrow(
+        java.lang.String key) {
+      if (key == null) { throw new NullPointerException("map key"); }
+      java.util.Map<java.lang.String, java.lang.Integer> map =
+          internalGetServices().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
       }
+      return map.get(key);
     }
 
     private byte memoizedIsInitialized = -1;
@@ -4847,9 +15785,15 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, message_);
-      }
+      if (usedMemory_ != 0) {
+        output.writeInt32(1, usedMemory_);
+      }
+      com.google.protobuf.GeneratedMessage
+        .serializeStringMapTo(
+          output,
+          internalGetServices(),
+          ServicesDefaultEntryHolder.defaultEntry,
+          2);
       getUnknownFields().writeTo(output);
     }
 
@@ -4859,8 +15803,19 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, message_);
+      if (usedMemory_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(1, usedMemory_);
+      }
+      for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry
+           : internalGetServices().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.String, java.lang.Integer>
+        services__ = ServicesDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
+        size += com.google.protobuf.CodedOutputStream
+            .computeMessageSize(2, services__);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -4872,13 +15827,15 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAuthFailed)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketAuthFailed other = (eu.novusmc.athena.common.Protocol.PacketAuthFailed) obj;
+      eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage other = (eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage) obj;
 
-      if (!getMessage()
-          .equals(other.getMessage())) return false;
+      if (getUsedMemory()
+          != other.getUsedMemory()) return false;
+      if (!internalGetServices().equals(
+          other.internalGetServices())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -4890,51 +15847,55 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + MESSAGE_FIELD_NUMBER;
-      hash = (53 * hash) + getMessage().hashCode();
+      hash = (37 * hash) + USED_MEMORY_FIELD_NUMBER;
+      hash = (53 * hash) + getUsedMemory();
+      if (!internalGetServices().getMap().isEmpty()) {
+        hash = (37 * hash) + SERVICES_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetServices().hashCode();
+      }
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -4942,26 +15903,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -4974,7 +15935,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAuthFailed prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -4990,26 +15951,48 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketAuthFailed}
+     * Protobuf type {@code protocol.PacketSlaveMemoryUsage}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketAuthFailed)
-        eu.novusmc.athena.common.Protocol.PacketAuthFailedOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketSlaveMemoryUsage)
+        eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsageOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSlaveMemoryUsage_descriptor;
+      }
+
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 2:
+            return internalGetServices();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapFieldReflectionAccessor internalGetMutableMapFieldReflection(
+          int number) {
+        switch (number) {
+          case 2:
+            return internalGetMutableServices();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
       }
-
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSlaveMemoryUsage_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketAuthFailed.class, eu.novusmc.athena.common.Protocol.PacketAuthFailed.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage.class, eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketAuthFailed.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage.newBuilder()
       private Builder() {
 
       }
@@ -5023,24 +16006,25 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        message_ = "";
+        usedMemory_ = 0;
+        internalGetMutableServices().clear();
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAuthFailed_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSlaveMemoryUsage_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthFailed getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketAuthFailed.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthFailed build() {
-        eu.novusmc.athena.common.Protocol.PacketAuthFailed result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage build() {
+        eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -5048,37 +16032,42 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAuthFailed buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketAuthFailed result = new eu.novusmc.athena.common.Protocol.PacketAuthFailed(this);
+      public eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage result = new eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketAuthFailed result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.message_ = message_;
+          result.usedMemory_ = usedMemory_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.services_ = internalGetServices();
+          result.services_.makeImmutable();
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAuthFailed) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAuthFailed)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAuthFailed other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketAuthFailed.getDefaultInstance()) return this;
-        if (!other.getMessage().isEmpty()) {
-          message_ = other.message_;
-          bitField0_ |= 0x00000001;
-          onChanged();
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage.getDefaultInstance()) return this;
+        if (other.getUsedMemory() != 0) {
+          setUsedMemory(other.getUsedMemory());
         }
+        internalGetMutableServices().mergeFrom(
+            other.internalGetServices());
+        bitField0_ |= 0x00000002;
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -5105,11 +16094,20 @@ public final class Protocol {
               case 0:
                 done = true;
                 break;
-              case 10: {
-                message_ = input.readStringRequireUtf8();
+              case 8: {
+                usedMemory_ = input.readInt32();
                 bitField0_ |= 0x00000001;
                 break;
-              } // case 10
+              } // case 8
+              case 18: {
+                com.google.protobuf.MapEntry<java.lang.String, java.lang.Integer>
+                services__ = input.readMessage(
+                    ServicesDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+                internalGetMutableServices().getMutableMap().put(
+                    services__.getKey(), services__.getValue());
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -5127,95 +16125,180 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object message_ = "";
-      /**
-       * <code>string message = 1;</code>
-       * @return The message.
-       */
-      public java.lang.String getMessage() {
-        java.lang.Object ref = message_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          message_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
+      private int usedMemory_ ;
       /**
-       * <code>string message = 1;</code>
-       * @return The bytes for message.
+       * <code>int32 used_memory = 1;</code>
+       * @return The usedMemory.
        */
-      public com.google.protobuf.ByteString
-          getMessageBytes() {
-        java.lang.Object ref = message_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          message_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
+      @java.lang.Override
+      public int getUsedMemory() {
+        return usedMemory_;
       }
       /**
-       * <code>string message = 1;</code>
-       * @param value The message to set.
+       * <code>int32 used_memory = 1;</code>
+       * @param value The usedMemory to set.
        * @return This builder for chaining.
        */
-      public Builder setMessage(
-          java.lang.String value) {
-        if (value == null) { throw new NullPointerException(); }
-        message_ = value;
+      public Builder setUsedMemory(int value) {
+
+        usedMemory_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string message = 1;</code>
+       * <code>int32 used_memory = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearMessage() {
-        message_ = getDefaultInstance().getMessage();
+      public Builder clearUsedMemory() {
         bitField0_ = (bitField0_ & ~0x00000001);
+        usedMemory_ = 0;
         onChanged();
         return this;
       }
+
+      private com.google.protobuf.MapField<
+          java.lang.String, java.lang.Integer> services_;
+      private com.google.protobuf.MapField<java.lang.String, java.lang.Integer>
+          internalGetServices() {
+        if (services_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              ServicesDefaultEntryHolder.defaultEntry);
+        }
+        return services_;
+      }
+      private com.google.protobuf.MapField<java.lang.String, java.lang.Integer>
+          internalGetMutableServices() {
+        if (services_ == null) {
+          services_ = com.google.protobuf.MapField.newMapField(
+              ServicesDefaultEntryHolder.defaultEntry);
+        }
+        if (!services_.isMutable()) {
+          services_ = services_.copy();
+        }
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return services_;
+      }
+      public int getServicesCount() {
+        return internalGetServices().getMap().size();
+      }
       /**
-       * <code>string message = 1;</code>
-       * @param value The bytes for message to set.
-       * @return This builder for chaining.
+       * <code>map&lt;string, int32&gt; services = 2;</code>
        */
-      public Builder setMessageBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) { throw new NullPointerException(); }
-        checkByteStringIsUtf8(value);
-        message_ = value;
-        bitField0_ |= 0x00000001;
-        onChanged();
+      @java.lang.Override
+      public boolean containsServices(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        return internalGetServices().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getServicesMap()} instead.
+       */
+      @java.lang.Override
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.Integer> getServices() {
+        return getServicesMap();
+      }
+      /**
+       * <code>map&lt;string, int32&gt; services = 2;</code>
+       */
+      @java.lang.Override
+      public java.util.Map<java.lang.String, java.lang.Integer> getServicesMap() {
+        return internalGetServices().getMap();
+      }
+      /**
+       * <code>map&lt;string, int32&gt; services = 2;</code>
+       */
+      @java.lang.Override
+      public int getServicesOrDefault(
+          java.lang.String key,
+          int defaultValue) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.Integer> map =
+            internalGetServices().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <code>map&lt;string, int32&gt; services = 2;</code>
+       */
+      @java.lang.Override
+      public int getServicesOrThrow(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        java.util.Map<java.lang.String, java.lang.Integer> map =
+            internalGetServices().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+      public Builder clearServices() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        internalGetMutableServices().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <code>map&lt;string, int32&gt; services = 2;</code>
+       */
+      public Builder removeServices(
+          java.lang.String key) {
+        if (key == null) { throw new NullPointerException("map key"); }
+        internalGetMutableServices().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.String, java.lang.Integer>
+          getMutableServices() {
+        bitField0_ |= 0x00000002;
+        return internalGetMutableServices().getMutableMap();
+      }
+      /**
+       * <code>map&lt;string, int32&gt; services = 2;</code>
+       */
+      public Builder putServices(
+          java.lang.String key,
+          int value) {
+        if (key == null) { throw new NullPointerException("map key"); }
+
+        internalGetMutableServices().getMutableMap()
+            .put(key, value);
+        bitField0_ |= 0x00000002;
+        return this;
+      }
+      /**
+       * <code>map&lt;string, int32&gt; services = 2;</code>
+       */
+      public Builder putAllServices(
+          java.util.Map<java.lang.String, java.lang.Integer> values) {
+        internalGetMutableServices().getMutableMap()
+            .putAll(values);
+        bitField0_ |= 0x00000002;
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketAuthFailed)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketSlaveMemoryUsage)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketAuthFailed)
-    private static final eu.novusmc.athena.common.Protocol.PacketAuthFailed DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketSlaveMemoryUsage)
+    private static final eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAuthFailed();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAuthFailed getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketAuthFailed>
-        PARSER = new com.google.protobuf.AbstractParser<PacketAuthFailed>() {
+    private static final com.google.protobuf.Parser<PacketSlaveMemoryUsage>
+        PARSER = new com.google.protobuf.AbstractParser<PacketSlaveMemoryUsage>() {
       @java.lang.Override
-      public PacketAuthFailed parsePartialFrom(
+      public PacketSlaveMemoryUsage parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -5234,63 +16317,76 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketAuthFailed> parser() {
+    public static com.google.protobuf.Parser<PacketSlaveMemoryUsage> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketAuthFailed> getParserForType() {
+    public com.google.protobuf.Parser<PacketSlaveMemoryUsage> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketAuthFailed getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketSlaveMemoryUsage getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketScheduleServiceRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketScheduleServiceRequest)
+  public interface PacketServiceConnectOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceConnect)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>.protocol.Service service = 1;</code>
-     * @return Whether the service field is set.
+     * <code>string key = 1;</code>
+     * @return The key.
      */
-    boolean hasService();
+    java.lang.String getKey();
     /**
-     * <code>.protocol.Service service = 1;</code>
-     * @return The service.
+     * <code>string key = 1;</code>
+     * @return The bytes for key.
      */
-    eu.novusmc.athena.common.Protocol.Service getService();
+    com.google.protobuf.ByteString
+        getKeyBytes();
+
     /**
-     * <code>.protocol.Service service = 1;</code>
+     * <code>int32 protocol_version = 2;</code>
+     * @return The protocolVersion.
      */
-    eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServiceOrBuilder();
+    int getProtocolVersion();
 
     /**
-     * <code>.protocol.Group group = 2;</code>
-     * @return Whether the group field is set.
+     * <code>repeated string capabilities = 3;</code>
+     * @return A list containing the capabilities.
      */
-    boolean hasGroup();
+    java.util.List<java.lang.String>
+        getCapabilitiesList();
     /**
-     * <code>.protocol.Group group = 2;</code>
-     * @return The group.
+     * <code>repeated string capabilities = 3;</code>
+     * @return The count of capabilities.
      */
-    eu.novusmc.athena.common.Protocol.Group getGroup();
+    int getCapabilitiesCount();
     /**
-     * <code>.protocol.Group group = 2;</code>
+     * <code>repeated string capabilities = 3;</code>
+     * @param index The index of the element to return.
+     * @return The capabilities at the given index.
      */
-    eu.novusmc.athena.common.Protocol.GroupOrBuilder getGroupOrBuilder();
+    java.lang.String getCapabilities(int index);
+    /**
+     * <code>repeated string capabilities = 3;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the capabilities at the given index.
+     */
+    com.google.protobuf.ByteString
+        getCapabilitiesBytes(int index);
   }
   /**
-   * Protobuf type {@code protocol.PacketScheduleServiceRequest}
+   * Protobuf type {@code protocol.PacketServiceConnect}
    */
-  public static final class PacketScheduleServiceRequest extends
+  public static final class PacketServiceConnect extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketScheduleServiceRequest)
-      PacketScheduleServiceRequestOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketServiceConnect)
+      PacketServiceConnectOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -5299,79 +16395,116 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketScheduleServiceRequest.class.getName());
-    }
-    // Use PacketScheduleServiceRequest.newBuilder() to construct.
-    private PacketScheduleServiceRequest(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+        PacketServiceConnect.class.getName());
+    }
+    // Use PacketServiceConnect.newBuilder() to construct.
+    private PacketServiceConnect(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketScheduleServiceRequest() {
+    private PacketServiceConnect() {
+      key_ = "";
+      capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.class, eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketServiceConnect.class, eu.novusmc.athena.common.Protocol.PacketServiceConnect.Builder.class);
     }
 
-    private int bitField0_;
-    public static final int SERVICE_FIELD_NUMBER = 1;
-    private eu.novusmc.athena.common.Protocol.Service service_;
+    public static final int KEY_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object key_ = "";
     /**
-     * <code>.protocol.Service service = 1;</code>
-     * @return Whether the service field is set.
+     * <code>string key = 1;</code>
+     * @return The key.
      */
     @java.lang.Override
-    public boolean hasService() {
-      return ((bitField0_ & 0x00000001) != 0);
+    public java.lang.String getKey() {
+      java.lang.Object ref = key_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        key_ = s;
+        return s;
+      }
     }
     /**
-     * <code>.protocol.Service service = 1;</code>
-     * @return The service.
+     * <code>string key = 1;</code>
+     * @return The bytes for key.
      */
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.Service getService() {
-      return service_ == null ? eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
+    public com.google.protobuf.ByteString
+        getKeyBytes() {
+      java.lang.Object ref = key_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        key_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
+
+    public static final int PROTOCOL_VERSION_FIELD_NUMBER = 2;
+    private int protocolVersion_ = 0;
     /**
-     * <code>.protocol.Service service = 1;</code>
+     * <code>int32 protocol_version = 2;</code>
+     * @return The protocolVersion.
      */
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServiceOrBuilder() {
-      return service_ == null ? eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
+    public int getProtocolVersion() {
+      return protocolVersion_;
     }
 
-    public static final int GROUP_FIELD_NUMBER = 2;
-    private eu.novusmc.athena.common.Protocol.Group group_;
+    public static final int CAPABILITIES_FIELD_NUMBER = 3;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList capabilities_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
     /**
-     * <code>.protocol.Group group = 2;</code>
-     * @return Whether the group field is set.
+     * <code>repeated string capabilities = 3;</code>
+     * @return A list containing the capabilities.
      */
-    @java.lang.Override
-    public boolean hasGroup() {
-      return ((bitField0_ & 0x00000002) != 0);
+    public com.google.protobuf.ProtocolStringList
+        getCapabilitiesList() {
+      return capabilities_;
     }
     /**
-     * <code>.protocol.Group group = 2;</code>
-     * @return The group.
+     * <code>repeated string capabilities = 3;</code>
+     * @return The count of capabilities.
      */
-    @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.Group getGroup() {
-      return group_ == null ? eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
+    public int getCapabilitiesCount() {
+      return capabilities_.size();
     }
     /**
-     * <code>.protocol.Group group = 2;</code>
+     * <code>repeated string capabilities = 3;</code>
+     * @param index The index of the element to return.
+     * @return The capabilities at the given index.
      */
-    @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.GroupOrBuilder getGroupOrBuilder() {
-      return group_ == null ? eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
+    public java.lang.String getCapabilities(int index) {
+      return capabilities_.get(index);
+    }
+    /**
+     * <code>repeated string capabilities = 3;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the capabilities at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getCapabilitiesBytes(int index) {
+      return capabilities_.getByteString(index);
     }
 
     private byte memoizedIsInitialized = -1;
@@ -5388,11 +16521,14 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (((bitField0_ & 0x00000001) != 0)) {
-        output.writeMessage(1, getService());
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(key_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, key_);
       }
-      if (((bitField0_ & 0x00000002) != 0)) {
-        output.writeMessage(2, getGroup());
+      if (protocolVersion_ != 0) {
+        output.writeInt32(2, protocolVersion_);
+      }
+      for (int i = 0; i < capabilities_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 3, capabilities_.getRaw(i));
       }
       getUnknownFields().writeTo(output);
     }
@@ -5403,13 +16539,20 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (((bitField0_ & 0x00000001) != 0)) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeMessageSize(1, getService());
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(key_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, key_);
       }
-      if (((bitField0_ & 0x00000002) != 0)) {
+      if (protocolVersion_ != 0) {
         size += com.google.protobuf.CodedOutputStream
-          .computeMessageSize(2, getGroup());
+          .computeInt32Size(2, protocolVersion_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < capabilities_.size(); i++) {
+          dataSize += computeStringSizeNoTag(capabilities_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getCapabilitiesList().size();
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -5421,21 +16564,17 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceConnect)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest other = (eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest) obj;
+      eu.novusmc.athena.common.Protocol.PacketServiceConnect other = (eu.novusmc.athena.common.Protocol.PacketServiceConnect) obj;
 
-      if (hasService() != other.hasService()) return false;
-      if (hasService()) {
-        if (!getService()
-            .equals(other.getService())) return false;
-      }
-      if (hasGroup() != other.hasGroup()) return false;
-      if (hasGroup()) {
-        if (!getGroup()
-            .equals(other.getGroup())) return false;
-      }
+      if (!getKey()
+          .equals(other.getKey())) return false;
+      if (getProtocolVersion()
+          != other.getProtocolVersion()) return false;
+      if (!getCapabilitiesList()
+          .equals(other.getCapabilitiesList())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -5447,57 +16586,57 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      if (hasService()) {
-        hash = (37 * hash) + SERVICE_FIELD_NUMBER;
-        hash = (53 * hash) + getService().hashCode();
-      }
-      if (hasGroup()) {
-        hash = (37 * hash) + GROUP_FIELD_NUMBER;
-        hash = (53 * hash) + getGroup().hashCode();
+      hash = (37 * hash) + KEY_FIELD_NUMBER;
+      hash = (53 * hash) + getKey().hashCode();
+      hash = (37 * hash) + PROTOCOL_VERSION_FIELD_NUMBER;
+      hash = (53 * hash) + getProtocolVersion();
+      if (getCapabilitiesCount() > 0) {
+        hash = (37 * hash) + CAPABILITIES_FIELD_NUMBER;
+        hash = (53 * hash) + getCapabilitiesList().hashCode();
       }
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -5505,26 +16644,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -5537,7 +16676,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceConnect prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -5553,73 +16692,60 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketScheduleServiceRequest}
+     * Protobuf type {@code protocol.PacketServiceConnect}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketScheduleServiceRequest)
-        eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequestOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceConnect)
+        eu.novusmc.athena.common.Protocol.PacketServiceConnectOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.class, eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketServiceConnect.class, eu.novusmc.athena.common.Protocol.PacketServiceConnect.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceConnect.newBuilder()
       private Builder() {
-        maybeForceBuilderInitialization();
+
       }
 
       private Builder(
           com.google.protobuf.GeneratedMessage.BuilderParent parent) {
         super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessage
-                .alwaysUseFieldBuilders) {
-          getServiceFieldBuilder();
-          getGroupFieldBuilder();
-        }
+
       }
       @java.lang.Override
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        service_ = null;
-        if (serviceBuilder_ != null) {
-          serviceBuilder_.dispose();
-          serviceBuilder_ = null;
-        }
-        group_ = null;
-        if (groupBuilder_ != null) {
-          groupBuilder_.dispose();
-          groupBuilder_ = null;
-        }
+        key_ = "";
+        protocolVersion_ = 0;
+        capabilities_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScheduleServiceRequest_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketServiceConnect getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketServiceConnect.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest build() {
-        eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketServiceConnect build() {
+        eu.novusmc.athena.common.Protocol.PacketServiceConnect result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -5627,48 +16753,56 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest result = new eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest(this);
+      public eu.novusmc.athena.common.Protocol.PacketServiceConnect buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketServiceConnect result = new eu.novusmc.athena.common.Protocol.PacketServiceConnect(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceConnect result) {
         int from_bitField0_ = bitField0_;
-        int to_bitField0_ = 0;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.service_ = serviceBuilder_ == null
-              ? service_
-              : serviceBuilder_.build();
-          to_bitField0_ |= 0x00000001;
+          result.key_ = key_;
         }
         if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.group_ = groupBuilder_ == null
-              ? group_
-              : groupBuilder_.build();
-          to_bitField0_ |= 0x00000002;
+          result.protocolVersion_ = protocolVersion_;
+        }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          capabilities_.makeImmutable();
+          result.capabilities_ = capabilities_;
         }
-        result.bitField0_ |= to_bitField0_;
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceConnect) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceConnect)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest.getDefaultInstance()) return this;
-        if (other.hasService()) {
-          mergeService(other.getService());
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceConnect other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketServiceConnect.getDefaultInstance()) return this;
+        if (!other.getKey().isEmpty()) {
+          key_ = other.key_;
+          bitField0_ |= 0x00000001;
+          onChanged();
         }
-        if (other.hasGroup()) {
-          mergeGroup(other.getGroup());
+        if (other.getProtocolVersion() != 0) {
+          setProtocolVersion(other.getProtocolVersion());
+        }
+        if (!other.capabilities_.isEmpty()) {
+          if (capabilities_.isEmpty()) {
+            capabilities_ = other.capabilities_;
+            bitField0_ |= 0x00000004;
+          } else {
+            ensureCapabilitiesIsMutable();
+            capabilities_.addAll(other.capabilities_);
+          }
+          onChanged();
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -5697,19 +16831,21 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                input.readMessage(
-                    getServiceFieldBuilder().getBuilder(),
-                    extensionRegistry);
+                key_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
-              case 18: {
-                input.readMessage(
-                    getGroupFieldBuilder().getBuilder(),
-                    extensionRegistry);
+              case 16: {
+                protocolVersion_ = input.readInt32();
                 bitField0_ |= 0x00000002;
                 break;
-              } // case 18
+              } // case 16
+              case 26: {
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureCapabilitiesIsMutable();
+                capabilities_.add(s);
+                break;
+              } // case 26
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -5727,265 +16863,238 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private eu.novusmc.athena.common.Protocol.Service service_;
-      private com.google.protobuf.SingleFieldBuilder<
-          eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder> serviceBuilder_;
-      /**
-       * <code>.protocol.Service service = 1;</code>
-       * @return Whether the service field is set.
-       */
-      public boolean hasService() {
-        return ((bitField0_ & 0x00000001) != 0);
-      }
+      private java.lang.Object key_ = "";
       /**
-       * <code>.protocol.Service service = 1;</code>
-       * @return The service.
+       * <code>string key = 1;</code>
+       * @return The key.
        */
-      public eu.novusmc.athena.common.Protocol.Service getService() {
-        if (serviceBuilder_ == null) {
-          return service_ == null ? eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
+      public java.lang.String getKey() {
+        java.lang.Object ref = key_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          key_ = s;
+          return s;
         } else {
-          return serviceBuilder_.getMessage();
+          return (java.lang.String) ref;
         }
       }
       /**
-       * <code>.protocol.Service service = 1;</code>
+       * <code>string key = 1;</code>
+       * @return The bytes for key.
        */
-      public Builder setService(eu.novusmc.athena.common.Protocol.Service value) {
-        if (serviceBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          service_ = value;
+      public com.google.protobuf.ByteString
+          getKeyBytes() {
+        java.lang.Object ref = key_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          key_ = b;
+          return b;
         } else {
-          serviceBuilder_.setMessage(value);
+          return (com.google.protobuf.ByteString) ref;
         }
-        bitField0_ |= 0x00000001;
-        onChanged();
-        return this;
       }
       /**
-       * <code>.protocol.Service service = 1;</code>
+       * <code>string key = 1;</code>
+       * @param value The key to set.
+       * @return This builder for chaining.
        */
-      public Builder setService(
-          eu.novusmc.athena.common.Protocol.Service.Builder builderForValue) {
-        if (serviceBuilder_ == null) {
-          service_ = builderForValue.build();
-        } else {
-          serviceBuilder_.setMessage(builderForValue.build());
-        }
+      public Builder setKey(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        key_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>.protocol.Service service = 1;</code>
+       * <code>string key = 1;</code>
+       * @return This builder for chaining.
        */
-      public Builder mergeService(eu.novusmc.athena.common.Protocol.Service value) {
-        if (serviceBuilder_ == null) {
-          if (((bitField0_ & 0x00000001) != 0) &&
-            service_ != null &&
-            service_ != eu.novusmc.athena.common.Protocol.Service.getDefaultInstance()) {
-            getServiceBuilder().mergeFrom(value);
-          } else {
-            service_ = value;
-          }
-        } else {
-          serviceBuilder_.mergeFrom(value);
-        }
-        if (service_ != null) {
-          bitField0_ |= 0x00000001;
-          onChanged();
-        }
+      public Builder clearKey() {
+        key_ = getDefaultInstance().getKey();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
         return this;
       }
       /**
-       * <code>.protocol.Service service = 1;</code>
+       * <code>string key = 1;</code>
+       * @param value The bytes for key to set.
+       * @return This builder for chaining.
        */
-      public Builder clearService() {
-        bitField0_ = (bitField0_ & ~0x00000001);
-        service_ = null;
-        if (serviceBuilder_ != null) {
-          serviceBuilder_.dispose();
-          serviceBuilder_ = null;
-        }
+      public Builder setKeyBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        key_ = value;
+        bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
+
+      private int protocolVersion_ ;
       /**
-       * <code>.protocol.Service service = 1;</code>
+       * <code>int32 protocol_version = 2;</code>
+       * @return The protocolVersion.
        */
-      public eu.novusmc.athena.common.Protocol.Service.Builder getServiceBuilder() {
-        bitField0_ |= 0x00000001;
-        onChanged();
-        return getServiceFieldBuilder().getBuilder();
+      @java.lang.Override
+      public int getProtocolVersion() {
+        return protocolVersion_;
       }
       /**
-       * <code>.protocol.Service service = 1;</code>
+       * <code>int32 protocol_version = 2;</code>
+       * @param value The protocolVersion to set.
+       * @return This builder for chaining.
        */
-      public eu.novusmc.athena.common.Protocol.ServiceOrBuilder getServiceOrBuilder() {
-        if (serviceBuilder_ != null) {
-          return serviceBuilder_.getMessageOrBuilder();
-        } else {
-          return service_ == null ?
-              eu.novusmc.athena.common.Protocol.Service.getDefaultInstance() : service_;
-        }
+      public Builder setProtocolVersion(int value) {
+
+        protocolVersion_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
       }
       /**
-       * <code>.protocol.Service service = 1;</code>
+       * <code>int32 protocol_version = 2;</code>
+       * @return This builder for chaining.
        */
-      private com.google.protobuf.SingleFieldBuilder<
-          eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder> 
-          getServiceFieldBuilder() {
-        if (serviceBuilder_ == null) {
-          serviceBuilder_ = new com.google.protobuf.SingleFieldBuilder<
-              eu.novusmc.athena.common.Protocol.Service, eu.novusmc.athena.common.Protocol.Service.Builder, eu.novusmc.athena.common.Protocol.ServiceOrBuilder>(
-                  getService(),
-                  getParentForChildren(),
-                  isClean());
-          service_ = null;
-        }
-        return serviceBuilder_;
+      public Builder clearProtocolVersion() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        protocolVersion_ = 0;
+        onChanged();
+        return this;
       }
 
-      private eu.novusmc.athena.common.Protocol.Group group_;
-      private com.google.protobuf.SingleFieldBuilder<
-          eu.novusmc.athena.common.Protocol.Group, eu.novusmc.athena.common.Protocol.Group.Builder, eu.novusmc.athena.common.Protocol.GroupOrBuilder> groupBuilder_;
+      private com.google.protobuf.LazyStringArrayList capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureCapabilitiesIsMutable() {
+        if (!capabilities_.isModifiable()) {
+          capabilities_ = new com.google.protobuf.LazyStringArrayList(capabilities_);
+        }
+        bitField0_ |= 0x00000004;
+      }
       /**
-       * <code>.protocol.Group group = 2;</code>
-       * @return Whether the group field is set.
+       * <code>repeated string capabilities = 3;</code>
+       * @return A list containing the capabilities.
        */
-      public boolean hasGroup() {
-        return ((bitField0_ & 0x00000002) != 0);
+      public com.google.protobuf.ProtocolStringList
+          getCapabilitiesList() {
+        capabilities_.makeImmutable();
+        return capabilities_;
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
-       * @return The group.
+       * <code>repeated string capabilities = 3;</code>
+       * @return The count of capabilities.
        */
-      public eu.novusmc.athena.common.Protocol.Group getGroup() {
-        if (groupBuilder_ == null) {
-          return group_ == null ? eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
-        } else {
-          return groupBuilder_.getMessage();
-        }
+      public int getCapabilitiesCount() {
+        return capabilities_.size();
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
+       * <code>repeated string capabilities = 3;</code>
+       * @param index The index of the element to return.
+       * @return The capabilities at the given index.
        */
-      public Builder setGroup(eu.novusmc.athena.common.Protocol.Group value) {
-        if (groupBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          group_ = value;
-        } else {
-          groupBuilder_.setMessage(value);
-        }
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
+      public java.lang.String getCapabilities(int index) {
+        return capabilities_.get(index);
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
+       * <code>repeated string capabilities = 3;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the capabilities at the given index.
        */
-      public Builder setGroup(
-          eu.novusmc.athena.common.Protocol.Group.Builder builderForValue) {
-        if (groupBuilder_ == null) {
-          group_ = builderForValue.build();
-        } else {
-          groupBuilder_.setMessage(builderForValue.build());
-        }
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
+      public com.google.protobuf.ByteString
+          getCapabilitiesBytes(int index) {
+        return capabilities_.getByteString(index);
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
+       * <code>repeated string capabilities = 3;</code>
+       * @param index The index to set the value at.
+       * @param value The capabilities to set.
+       * @return This builder for chaining.
        */
-      public Builder mergeGroup(eu.novusmc.athena.common.Protocol.Group value) {
-        if (groupBuilder_ == null) {
-          if (((bitField0_ & 0x00000002) != 0) &&
-            group_ != null &&
-            group_ != eu.novusmc.athena.common.Protocol.Group.getDefaultInstance()) {
-            getGroupBuilder().mergeFrom(value);
-          } else {
-            group_ = value;
-          }
-        } else {
-          groupBuilder_.mergeFrom(value);
-        }
-        if (group_ != null) {
-          bitField0_ |= 0x00000002;
-          onChanged();
-        }
+      public Builder setCapabilities(
+          int index, java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureCapabilitiesIsMutable();
+        capabilities_.set(index, value);
+        bitField0_ |= 0x00000004;
+        onChanged();
         return this;
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
+       * <code>repeated string capabilities = 3;</code>
+       * @param value The capabilities to add.
+       * @return This builder for chaining.
        */
-      public Builder clearGroup() {
-        bitField0_ = (bitField0_ & ~0x00000002);
-        group_ = null;
-        if (groupBuilder_ != null) {
-          groupBuilder_.dispose();
-          groupBuilder_ = null;
-        }
+      public Builder addCapabilities(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureCapabilitiesIsMutable();
+        capabilities_.add(value);
+        bitField0_ |= 0x00000004;
         onChanged();
         return this;
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
+       * <code>repeated string capabilities = 3;</code>
+       * @param values The capabilities to add.
+       * @return This builder for chaining.
        */
-      public eu.novusmc.athena.common.Protocol.Group.Builder getGroupBuilder() {
-        bitField0_ |= 0x00000002;
+      public Builder addAllCapabilities(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureCapabilitiesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, capabilities_);
+        bitField0_ |= 0x00000004;
         onChanged();
-        return getGroupFieldBuilder().getBuilder();
+        return this;
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
+       * <code>repeated string capabilities = 3;</code>
+       * @return This builder for chaining.
        */
-      public eu.novusmc.athena.common.Protocol.GroupOrBuilder getGroupOrBuilder() {
-        if (groupBuilder_ != null) {
-          return groupBuilder_.getMessageOrBuilder();
-        } else {
-          return group_ == null ?
-              eu.novusmc.athena.common.Protocol.Group.getDefaultInstance() : group_;
-        }
+      public Builder clearCapabilities() {
+        capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000004);;
+        onChanged();
+        return this;
       }
       /**
-       * <code>.protocol.Group group = 2;</code>
+       * <code>repeated string capabilities = 3;</code>
+       * @param value The bytes of the capabilities to add.
+       * @return This builder for chaining.
        */
-      private com.google.protobuf.SingleFieldBuilder<
-          eu.novusmc.athena.common.Protocol.Group, eu.novusmc.athena.common.Protocol.Group.Builder, eu.novusmc.athena.common.Protocol.GroupOrBuilder> 
-          getGroupFieldBuilder() {
-        if (groupBuilder_ == null) {
-          groupBuilder_ = new com.google.protobuf.SingleFieldBuilder<
-              eu.novusmc.athena.common.Protocol.Group, eu.novusmc.athena.common.Protocol.Group.Builder, eu.novusmc.athena.common.Protocol.GroupOrBuilder>(
-                  getGroup(),
-                  getParentForChildren(),
-                  isClean());
-          group_ = null;
-        }
-        return groupBuilder_;
+      public Builder addCapabilitiesBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureCapabilitiesIsMutable();
+        capabilities_.add(value);
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketScheduleServiceRequest)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceConnect)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketScheduleServiceRequest)
-    private static final eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketServiceConnect)
+    private static final eu.novusmc.athena.common.Protocol.PacketServiceConnect DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceConnect();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketScheduleServiceRequest>
-        PARSER = new com.google.protobuf.AbstractParser<PacketScheduleServiceRequest>() {
+    private static final com.google.protobuf.Parser<PacketServiceConnect>
+        PARSER = new com.google.protobuf.AbstractParser<PacketServiceConnect>() {
       @java.lang.Override
-      public PacketScheduleServiceRequest parsePartialFrom(
+      public PacketServiceConnect parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -6004,24 +17113,24 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketScheduleServiceRequest> parser() {
+    public static com.google.protobuf.Parser<PacketServiceConnect> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketScheduleServiceRequest> getParserForType() {
+    public com.google.protobuf.Parser<PacketServiceConnect> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketScheduleServiceRequest getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketServiceConnect getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketServiceStartFailedOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceStartFailed)
+  public interface PacketStopServiceOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketStopService)
       com.google.protobuf.MessageOrBuilder {
 
     /**
@@ -6035,26 +17144,14 @@ public final class Protocol {
      */
     com.google.protobuf.ByteString
         getServiceNameBytes();
-
-    /**
-     * <code>string message = 2;</code>
-     * @return The message.
-     */
-    java.lang.String getMessage();
-    /**
-     * <code>string message = 2;</code>
-     * @return The bytes for message.
-     */
-    com.google.protobuf.ByteString
-        getMessageBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketServiceStartFailed}
+   * Protobuf type {@code protocol.PacketStopService}
    */
-  public static final class PacketServiceStartFailed extends
+  public static final class PacketStopService extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketServiceStartFailed)
-      PacketServiceStartFailedOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketStopService)
+      PacketStopServiceOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -6063,28 +17160,27 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketServiceStartFailed.class.getName());
+        PacketStopService.class.getName());
     }
-    // Use PacketServiceStartFailed.newBuilder() to construct.
-    private PacketServiceStartFailed(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketStopService.newBuilder() to construct.
+    private PacketStopService(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketServiceStartFailed() {
+    private PacketStopService() {
       serviceName_ = "";
-      message_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.class, eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketStopService.class, eu.novusmc.athena.common.Protocol.PacketStopService.Builder.class);
     }
 
     public static final int SERVICE_NAME_FIELD_NUMBER = 1;
@@ -6126,45 +17222,6 @@ public final class Protocol {
       }
     }
 
-    public static final int MESSAGE_FIELD_NUMBER = 2;
-    @SuppressWarnings("serial")
-    private volatile java.lang.Object message_ = "";
-    /**
-     * <code>string message = 2;</code>
-     * @return The message.
-     */
-    @java.lang.Override
-    public java.lang.String getMessage() {
-      java.lang.Object ref = message_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        message_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string message = 2;</code>
-     * @return The bytes for message.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getMessageBytes() {
-      java.lang.Object ref = message_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        message_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -6182,9 +17239,6 @@ public final class Protocol {
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
         com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
       }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 2, message_);
-      }
       getUnknownFields().writeTo(output);
     }
 
@@ -6197,9 +17251,6 @@ public final class Protocol {
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
         size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
       }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(message_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, message_);
-      }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
       return size;
@@ -6210,15 +17261,13 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceStartFailed)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketStopService)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketServiceStartFailed other = (eu.novusmc.athena.common.Protocol.PacketServiceStartFailed) obj;
+      eu.novusmc.athena.common.Protocol.PacketStopService other = (eu.novusmc.athena.common.Protocol.PacketStopService) obj;
 
       if (!getServiceName()
           .equals(other.getServiceName())) return false;
-      if (!getMessage()
-          .equals(other.getMessage())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -6232,51 +17281,49 @@ public final class Protocol {
       hash = (19 * hash) + getDescriptor().hashCode();
       hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
       hash = (53 * hash) + getServiceName().hashCode();
-      hash = (37 * hash) + MESSAGE_FIELD_NUMBER;
-      hash = (53 * hash) + getMessage().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -6284,26 +17331,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -6316,7 +17363,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceStartFailed prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketStopService prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -6332,26 +17379,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketServiceStartFailed}
+     * Protobuf type {@code protocol.PacketStopService}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceStartFailed)
-        eu.novusmc.athena.common.Protocol.PacketServiceStartFailedOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketStopService)
+        eu.novusmc.athena.common.Protocol.PacketStopServiceOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.class, eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketStopService.class, eu.novusmc.athena.common.Protocol.PacketStopService.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketStopService.newBuilder()
       private Builder() {
 
       }
@@ -6366,24 +17413,23 @@ public final class Protocol {
         super.clear();
         bitField0_ = 0;
         serviceName_ = "";
-        message_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStartFailed_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketStopService getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketStopService.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed build() {
-        eu.novusmc.athena.common.Protocol.PacketServiceStartFailed result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketStopService build() {
+        eu.novusmc.athena.common.Protocol.PacketStopService result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -6391,45 +17437,37 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketServiceStartFailed result = new eu.novusmc.athena.common.Protocol.PacketServiceStartFailed(this);
+      public eu.novusmc.athena.common.Protocol.PacketStopService buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketStopService result = new eu.novusmc.athena.common.Protocol.PacketStopService(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceStartFailed result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketStopService result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
           result.serviceName_ = serviceName_;
         }
-        if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.message_ = message_;
-        }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceStartFailed) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceStartFailed)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketStopService) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketStopService)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceStartFailed other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketServiceStartFailed.getDefaultInstance()) return this;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketStopService other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketStopService.getDefaultInstance()) return this;
         if (!other.getServiceName().isEmpty()) {
           serviceName_ = other.serviceName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
-        if (!other.getMessage().isEmpty()) {
-          message_ = other.message_;
-          bitField0_ |= 0x00000002;
-          onChanged();
-        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -6461,11 +17499,6 @@ public final class Protocol {
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
-              case 18: {
-                message_ = input.readStringRequireUtf8();
-                bitField0_ |= 0x00000002;
-                break;
-              } // case 18
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -6555,95 +17588,23 @@ public final class Protocol {
         return this;
       }
 
-      private java.lang.Object message_ = "";
-      /**
-       * <code>string message = 2;</code>
-       * @return The message.
-       */
-      public java.lang.String getMessage() {
-        java.lang.Object ref = message_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          message_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string message = 2;</code>
-       * @return The bytes for message.
-       */
-      public com.google.protobuf.ByteString
-          getMessageBytes() {
-        java.lang.Object ref = message_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          message_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string message = 2;</code>
-       * @param value The message to set.
-       * @return This builder for chaining.
-       */
-      public Builder setMessage(
-          java.lang.String value) {
-        if (value == null) { throw new NullPointerException(); }
-        message_ = value;
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string message = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearMessage() {
-        message_ = getDefaultInstance().getMessage();
-        bitField0_ = (bitField0_ & ~0x00000002);
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string message = 2;</code>
-       * @param value The bytes for message to set.
-       * @return This builder for chaining.
-       */
-      public Builder setMessageBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) { throw new NullPointerException(); }
-        checkByteStringIsUtf8(value);
-        message_ = value;
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-
-      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceStartFailed)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketStopService)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketServiceStartFailed)
-    private static final eu.novusmc.athena.common.Protocol.PacketServiceStartFailed DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketStopService)
+    private static final eu.novusmc.athena.common.Protocol.PacketStopService DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceStartFailed();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketStopService();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStartFailed getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketStopService getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketServiceStartFailed>
-        PARSER = new com.google.protobuf.AbstractParser<PacketServiceStartFailed>() {
+    private static final com.google.protobuf.Parser<PacketStopService>
+        PARSER = new com.google.protobuf.AbstractParser<PacketStopService>() {
       @java.lang.Override
-      public PacketServiceStartFailed parsePartialFrom(
+      public PacketStopService parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -6662,45 +17623,63 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketServiceStartFailed> parser() {
+    public static com.google.protobuf.Parser<PacketStopService> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketServiceStartFailed> getParserForType() {
+    public com.google.protobuf.Parser<PacketStopService> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketServiceStartFailed getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketStopService getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketServiceStoppedOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceStopped)
+  public interface PacketProxyRegisterServerOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketProxyRegisterServer)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>string server_name = 1;</code>
+     * @return The serverName.
      */
-    java.lang.String getServiceName();
+    java.lang.String getServerName();
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>string server_name = 1;</code>
+     * @return The bytes for serverName.
      */
     com.google.protobuf.ByteString
-        getServiceNameBytes();
+        getServerNameBytes();
+
+    /**
+     * <code>string host = 2;</code>
+     * @return The host.
+     */
+    java.lang.String getHost();
+    /**
+     * <code>string host = 2;</code>
+     * @return The bytes for host.
+     */
+    com.google.protobuf.ByteString
+        getHostBytes();
+
+    /**
+     * <code>int32 port = 3;</code>
+     * @return The port.
+     */
+    int getPort();
   }
   /**
-   * Protobuf type {@code protocol.PacketServiceStopped}
+   * Protobuf type {@code protocol.PacketProxyRegisterServer}
    */
-  public static final class PacketServiceStopped extends
+  public static final class PacketProxyRegisterServer extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketServiceStopped)
-      PacketServiceStoppedOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketProxyRegisterServer)
+      PacketProxyRegisterServerOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -6709,68 +17688,119 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketServiceStopped.class.getName());
+        PacketProxyRegisterServer.class.getName());
     }
-    // Use PacketServiceStopped.newBuilder() to construct.
-    private PacketServiceStopped(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketProxyRegisterServer.newBuilder() to construct.
+    private PacketProxyRegisterServer(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketServiceStopped() {
-      serviceName_ = "";
+    private PacketProxyRegisterServer() {
+      serverName_ = "";
+      host_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketServiceStopped.class, eu.novusmc.athena.common.Protocol.PacketServiceStopped.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.Builder.class);
     }
 
-    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    public static final int SERVER_NAME_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object serviceName_ = "";
+    private volatile java.lang.Object serverName_ = "";
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>string server_name = 1;</code>
+     * @return The serverName.
      */
     @java.lang.Override
-    public java.lang.String getServiceName() {
-      java.lang.Object ref = serviceName_;
+    public java.lang.String getServerName() {
+      java.lang.Object ref = serverName_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        serviceName_ = s;
+        serverName_ = s;
         return s;
       }
     }
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>string server_name = 1;</code>
+     * @return The bytes for serverName.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getServiceNameBytes() {
-      java.lang.Object ref = serviceName_;
+        getServerNameBytes() {
+      java.lang.Object ref = serverName_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        serviceName_ = b;
+        serverName_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int HOST_FIELD_NUMBER = 2;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object host_ = "";
+    /**
+     * <code>string host = 2;</code>
+     * @return The host.
+     */
+    @java.lang.Override
+    public java.lang.String getHost() {
+      java.lang.Object ref = host_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        host_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string host = 2;</code>
+     * @return The bytes for host.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getHostBytes() {
+      java.lang.Object ref = host_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        host_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
       }
     }
 
+    public static final int PORT_FIELD_NUMBER = 3;
+    private int port_ = 0;
+    /**
+     * <code>int32 port = 3;</code>
+     * @return The port.
+     */
+    @java.lang.Override
+    public int getPort() {
+      return port_;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -6785,8 +17815,14 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serverName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(host_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 2, host_);
+      }
+      if (port_ != 0) {
+        output.writeInt32(3, port_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -6797,8 +17833,15 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serverName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(host_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, host_);
+      }
+      if (port_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(3, port_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -6810,13 +17853,17 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceStopped)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketServiceStopped other = (eu.novusmc.athena.common.Protocol.PacketServiceStopped) obj;
+      eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer other = (eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer) obj;
 
-      if (!getServiceName()
-          .equals(other.getServiceName())) return false;
+      if (!getServerName()
+          .equals(other.getServerName())) return false;
+      if (!getHost()
+          .equals(other.getHost())) return false;
+      if (getPort()
+          != other.getPort()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -6828,51 +17875,55 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getServiceName().hashCode();
+      hash = (37 * hash) + SERVER_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServerName().hashCode();
+      hash = (37 * hash) + HOST_FIELD_NUMBER;
+      hash = (53 * hash) + getHost().hashCode();
+      hash = (37 * hash) + PORT_FIELD_NUMBER;
+      hash = (53 * hash) + getPort();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -6880,26 +17931,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -6912,7 +17963,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceStopped prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -6928,26 +17979,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketServiceStopped}
+     * Protobuf type {@code protocol.PacketProxyRegisterServer}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceStopped)
-        eu.novusmc.athena.common.Protocol.PacketServiceStoppedOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketProxyRegisterServer)
+        eu.novusmc.athena.common.Protocol.PacketProxyRegisterServerOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketServiceStopped.class, eu.novusmc.athena.common.Protocol.PacketServiceStopped.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceStopped.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.newBuilder()
       private Builder() {
 
       }
@@ -6961,24 +18012,26 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        serviceName_ = "";
+        serverName_ = "";
+        host_ = "";
+        port_ = 0;
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceStopped_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceStopped getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketServiceStopped.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceStopped build() {
-        eu.novusmc.athena.common.Protocol.PacketServiceStopped result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer build() {
+        eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -6986,37 +18039,51 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceStopped buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketServiceStopped result = new eu.novusmc.athena.common.Protocol.PacketServiceStopped(this);
+      public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer result = new eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceStopped result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.serviceName_ = serviceName_;
+          result.serverName_ = serverName_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.host_ = host_;
+        }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          result.port_ = port_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceStopped) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceStopped)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceStopped other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketServiceStopped.getDefaultInstance()) return this;
-        if (!other.getServiceName().isEmpty()) {
-          serviceName_ = other.serviceName_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.getDefaultInstance()) return this;
+        if (!other.getServerName().isEmpty()) {
+          serverName_ = other.serverName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
+        if (!other.getHost().isEmpty()) {
+          host_ = other.host_;
+          bitField0_ |= 0x00000002;
+          onChanged();
+        }
+        if (other.getPort() != 0) {
+          setPort(other.getPort());
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -7044,10 +18111,20 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                serviceName_ = input.readStringRequireUtf8();
+                serverName_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
+              case 18: {
+                host_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
+              case 24: {
+                port_ = input.readInt32();
+                bitField0_ |= 0x00000004;
+                break;
+              } // case 24
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -7065,95 +18142,199 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object serviceName_ = "";
+      private java.lang.Object serverName_ = "";
       /**
-       * <code>string service_name = 1;</code>
-       * @return The serviceName.
+       * <code>string server_name = 1;</code>
+       * @return The serverName.
        */
-      public java.lang.String getServiceName() {
-        java.lang.Object ref = serviceName_;
+      public java.lang.String getServerName() {
+        java.lang.Object ref = serverName_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          serviceName_ = s;
+          serverName_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @return The bytes for serviceName.
+       * <code>string server_name = 1;</code>
+       * @return The bytes for serverName.
        */
       public com.google.protobuf.ByteString
-          getServiceNameBytes() {
-        java.lang.Object ref = serviceName_;
+          getServerNameBytes() {
+        java.lang.Object ref = serverName_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          serviceName_ = b;
+          serverName_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The serviceName to set.
+       * <code>string server_name = 1;</code>
+       * @param value The serverName to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceName(
+      public Builder setServerName(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        serviceName_ = value;
+        serverName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string server_name = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearServiceName() {
-        serviceName_ = getDefaultInstance().getServiceName();
+      public Builder clearServerName() {
+        serverName_ = getDefaultInstance().getServerName();
         bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The bytes for serviceName to set.
+       * <code>string server_name = 1;</code>
+       * @param value The bytes for serverName to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceNameBytes(
+      public Builder setServerNameBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        serviceName_ = value;
+        serverName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceStopped)
+      private java.lang.Object host_ = "";
+      /**
+       * <code>string host = 2;</code>
+       * @return The host.
+       */
+      public java.lang.String getHost() {
+        java.lang.Object ref = host_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          host_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string host = 2;</code>
+       * @return The bytes for host.
+       */
+      public com.google.protobuf.ByteString
+          getHostBytes() {
+        java.lang.Object ref = host_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          host_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string host = 2;</code>
+       * @param value The host to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHost(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        host_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string host = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearHost() {
+        host_ = getDefaultInstance().getHost();
+        bitField0_ = (bitField0_ & ~0x00000002);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string host = 2;</code>
+       * @param value The bytes for host to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHostBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        host_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+
+      private int port_ ;
+      /**
+       * <code>int32 port = 3;</code>
+       * @return The port.
+       */
+      @java.lang.Override
+      public int getPort() {
+        return port_;
+      }
+      /**
+       * <code>int32 port = 3;</code>
+       * @param value The port to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPort(int value) {
+
+        port_ = value;
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 port = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPort() {
+        bitField0_ = (bitField0_ & ~0x00000004);
+        port_ = 0;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketProxyRegisterServer)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketServiceStopped)
-    private static final eu.novusmc.athena.common.Protocol.PacketServiceStopped DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketProxyRegisterServer)
+    private static final eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceStopped();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceStopped getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketServiceStopped>
-        PARSER = new com.google.protobuf.AbstractParser<PacketServiceStopped>() {
+    private static final com.google.protobuf.Parser<PacketProxyRegisterServer>
+        PARSER = new com.google.protobuf.AbstractParser<PacketProxyRegisterServer>() {
       @java.lang.Override
-      public PacketServiceStopped parsePartialFrom(
+      public PacketProxyRegisterServer parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -7172,51 +18353,63 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketServiceStopped> parser() {
+    public static com.google.protobuf.Parser<PacketProxyRegisterServer> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketServiceStopped> getParserForType() {
+    public com.google.protobuf.Parser<PacketProxyRegisterServer> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketServiceStopped getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketServiceOnlineOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceOnline)
+  public interface PacketServiceDrainOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceDrain)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>repeated string fallback_servers = 1;</code>
+     * @return A list containing the fallbackServers.
      */
-    java.lang.String getServiceName();
+    java.util.List<java.lang.String>
+        getFallbackServersList();
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>repeated string fallback_servers = 1;</code>
+     * @return The count of fallbackServers.
      */
-    com.google.protobuf.ByteString
-        getServiceNameBytes();
-
+    int getFallbackServersCount();
     /**
-     * <code>int32 port = 2;</code>
-     * @return The port.
+     * <code>repeated string fallback_servers = 1;</code>
+     * @param index The index of the element to return.
+     * @return The fallbackServers at the given index.
      */
-    int getPort();
+    java.lang.String getFallbackServers(int index);
+    /**
+     * <code>repeated string fallback_servers = 1;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the fallbackServers at the given index.
+     */
+    com.google.protobuf.ByteString
+        getFallbackServersBytes(int index);
   }
   /**
-   * Protobuf type {@code protocol.PacketServiceOnline}
+   * <pre>
+   * PacketServiceDrain asks a server to move its players to the fallback servers
+   * before it is stopped.
+   * </pre>
+   *
+   * Protobuf type {@code protocol.PacketServiceDrain}
    */
-  public static final class PacketServiceOnline extends
+  public static final class PacketServiceDrain extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketServiceOnline)
-      PacketServiceOnlineOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketServiceDrain)
+      PacketServiceDrainOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -7225,77 +18418,65 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketServiceOnline.class.getName());
+        PacketServiceDrain.class.getName());
     }
-    // Use PacketServiceOnline.newBuilder() to construct.
-    private PacketServiceOnline(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketServiceDrain.newBuilder() to construct.
+    private PacketServiceDrain(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketServiceOnline() {
-      serviceName_ = "";
+    private PacketServiceDrain() {
+      fallbackServers_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceDrain_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketServiceOnline.class, eu.novusmc.athena.common.Protocol.PacketServiceOnline.Builder.class);
-    }
-
-    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
-    @SuppressWarnings("serial")
-    private volatile java.lang.Object serviceName_ = "";
-    /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
-     */
-    @java.lang.Override
-    public java.lang.String getServiceName() {
-      java.lang.Object ref = serviceName_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        serviceName_ = s;
-        return s;
-      }
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceDrain_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketServiceDrain.class, eu.novusmc.athena.common.Protocol.PacketServiceDrain.Builder.class);
     }
+
+    public static final int FALLBACK_SERVERS_FIELD_NUMBER = 1;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList fallbackServers_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>repeated string fallback_servers = 1;</code>
+     * @return A list containing the fallbackServers.
      */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getServiceNameBytes() {
-      java.lang.Object ref = serviceName_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        serviceName_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+    public com.google.protobuf.ProtocolStringList
+        getFallbackServersList() {
+      return fallbackServers_;
     }
-
-    public static final int PORT_FIELD_NUMBER = 2;
-    private int port_ = 0;
     /**
-     * <code>int32 port = 2;</code>
-     * @return The port.
+     * <code>repeated string fallback_servers = 1;</code>
+     * @return The count of fallbackServers.
      */
-    @java.lang.Override
-    public int getPort() {
-      return port_;
+    public int getFallbackServersCount() {
+      return fallbackServers_.size();
+    }
+    /**
+     * <code>repeated string fallback_servers = 1;</code>
+     * @param index The index of the element to return.
+     * @return The fallbackServers at the given index.
+     */
+    public java.lang.String getFallbackServers(int index) {
+      return fallbackServers_.get(index);
+    }
+    /**
+     * <code>repeated string fallback_servers = 1;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the fallbackServers at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getFallbackServersBytes(int index) {
+      return fallbackServers_.getByteString(index);
     }
 
     private byte memoizedIsInitialized = -1;
@@ -7312,11 +18493,8 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
-      }
-      if (port_ != 0) {
-        output.writeInt32(2, port_);
+      for (int i = 0; i < fallbackServers_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, fallbackServers_.getRaw(i));
       }
       getUnknownFields().writeTo(output);
     }
@@ -7327,12 +18505,13 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
-      }
-      if (port_ != 0) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeInt32Size(2, port_);
+      {
+        int dataSize = 0;
+        for (int i = 0; i < fallbackServers_.size(); i++) {
+          dataSize += computeStringSizeNoTag(fallbackServers_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getFallbackServersList().size();
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -7344,15 +18523,13 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceOnline)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceDrain)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketServiceOnline other = (eu.novusmc.athena.common.Protocol.PacketServiceOnline) obj;
+      eu.novusmc.athena.common.Protocol.PacketServiceDrain other = (eu.novusmc.athena.common.Protocol.PacketServiceDrain) obj;
 
-      if (!getServiceName()
-          .equals(other.getServiceName())) return false;
-      if (getPort()
-          != other.getPort()) return false;
+      if (!getFallbackServersList()
+          .equals(other.getFallbackServersList())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -7364,53 +18541,53 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getServiceName().hashCode();
-      hash = (37 * hash) + PORT_FIELD_NUMBER;
-      hash = (53 * hash) + getPort();
+      if (getFallbackServersCount() > 0) {
+        hash = (37 * hash) + FALLBACK_SERVERS_FIELD_NUMBER;
+        hash = (53 * hash) + getFallbackServersList().hashCode();
+      }
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -7418,26 +18595,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -7450,7 +18627,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceOnline prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceDrain prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -7466,26 +18643,31 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketServiceOnline}
+     * <pre>
+     * PacketServiceDrain asks a server to move its players to the fallback servers
+     * before it is stopped.
+     * </pre>
+     *
+     * Protobuf type {@code protocol.PacketServiceDrain}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceOnline)
-        eu.novusmc.athena.common.Protocol.PacketServiceOnlineOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceDrain)
+        eu.novusmc.athena.common.Protocol.PacketServiceDrainOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceDrain_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceDrain_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketServiceOnline.class, eu.novusmc.athena.common.Protocol.PacketServiceOnline.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketServiceDrain.class, eu.novusmc.athena.common.Protocol.PacketServiceDrain.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceOnline.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceDrain.newBuilder()
       private Builder() {
 
       }
@@ -7499,25 +18681,25 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        serviceName_ = "";
-        port_ = 0;
+        fallbackServers_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceOnline_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceDrain_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceOnline getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketServiceOnline.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketServiceDrain getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketServiceDrain.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceOnline build() {
-        eu.novusmc.athena.common.Protocol.PacketServiceOnline result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketServiceDrain build() {
+        eu.novusmc.athena.common.Protocol.PacketServiceDrain result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -7525,43 +18707,43 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceOnline buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketServiceOnline result = new eu.novusmc.athena.common.Protocol.PacketServiceOnline(this);
+      public eu.novusmc.athena.common.Protocol.PacketServiceDrain buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketServiceDrain result = new eu.novusmc.athena.common.Protocol.PacketServiceDrain(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceOnline result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceDrain result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.serviceName_ = serviceName_;
-        }
-        if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.port_ = port_;
+          fallbackServers_.makeImmutable();
+          result.fallbackServers_ = fallbackServers_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceOnline) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceOnline)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceDrain) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceDrain)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceOnline other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketServiceOnline.getDefaultInstance()) return this;
-        if (!other.getServiceName().isEmpty()) {
-          serviceName_ = other.serviceName_;
-          bitField0_ |= 0x00000001;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceDrain other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketServiceDrain.getDefaultInstance()) return this;
+        if (!other.fallbackServers_.isEmpty()) {
+          if (fallbackServers_.isEmpty()) {
+            fallbackServers_ = other.fallbackServers_;
+            bitField0_ |= 0x00000001;
+          } else {
+            ensureFallbackServersIsMutable();
+            fallbackServers_.addAll(other.fallbackServers_);
+          }
           onChanged();
         }
-        if (other.getPort() != 0) {
-          setPort(other.getPort());
-        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -7589,15 +18771,11 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                serviceName_ = input.readStringRequireUtf8();
-                bitField0_ |= 0x00000001;
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureFallbackServersIsMutable();
+                fallbackServers_.add(s);
                 break;
               } // case 10
-              case 16: {
-                port_ = input.readInt32();
-                bitField0_ |= 0x00000002;
-                break;
-              } // case 16
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -7615,127 +18793,134 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object serviceName_ = "";
+      private com.google.protobuf.LazyStringArrayList fallbackServers_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureFallbackServersIsMutable() {
+        if (!fallbackServers_.isModifiable()) {
+          fallbackServers_ = new com.google.protobuf.LazyStringArrayList(fallbackServers_);
+        }
+        bitField0_ |= 0x00000001;
+      }
       /**
-       * <code>string service_name = 1;</code>
-       * @return The serviceName.
+       * <code>repeated string fallback_servers = 1;</code>
+       * @return A list containing the fallbackServers.
        */
-      public java.lang.String getServiceName() {
-        java.lang.Object ref = serviceName_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          serviceName_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
+      public com.google.protobuf.ProtocolStringList
+          getFallbackServersList() {
+        fallbackServers_.makeImmutable();
+        return fallbackServers_;
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @return The bytes for serviceName.
+       * <code>repeated string fallback_servers = 1;</code>
+       * @return The count of fallbackServers.
+       */
+      public int getFallbackServersCount() {
+        return fallbackServers_.size();
+      }
+      /**
+       * <code>repeated string fallback_servers = 1;</code>
+       * @param index The index of the element to return.
+       * @return The fallbackServers at the given index.
+       */
+      public java.lang.String getFallbackServers(int index) {
+        return fallbackServers_.get(index);
+      }
+      /**
+       * <code>repeated string fallback_servers = 1;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the fallbackServers at the given index.
        */
       public com.google.protobuf.ByteString
-          getServiceNameBytes() {
-        java.lang.Object ref = serviceName_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          serviceName_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
+          getFallbackServersBytes(int index) {
+        return fallbackServers_.getByteString(index);
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The serviceName to set.
+       * <code>repeated string fallback_servers = 1;</code>
+       * @param index The index to set the value at.
+       * @param value The fallbackServers to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceName(
-          java.lang.String value) {
+      public Builder setFallbackServers(
+          int index, java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        serviceName_ = value;
+        ensureFallbackServersIsMutable();
+        fallbackServers_.set(index, value);
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>repeated string fallback_servers = 1;</code>
+       * @param value The fallbackServers to add.
        * @return This builder for chaining.
        */
-      public Builder clearServiceName() {
-        serviceName_ = getDefaultInstance().getServiceName();
-        bitField0_ = (bitField0_ & ~0x00000001);
+      public Builder addFallbackServers(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureFallbackServersIsMutable();
+        fallbackServers_.add(value);
+        bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The bytes for serviceName to set.
+       * <code>repeated string fallback_servers = 1;</code>
+       * @param values The fallbackServers to add.
        * @return This builder for chaining.
        */
-      public Builder setServiceNameBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) { throw new NullPointerException(); }
-        checkByteStringIsUtf8(value);
-        serviceName_ = value;
+      public Builder addAllFallbackServers(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureFallbackServersIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, fallbackServers_);
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
-
-      private int port_ ;
-      /**
-       * <code>int32 port = 2;</code>
-       * @return The port.
-       */
-      @java.lang.Override
-      public int getPort() {
-        return port_;
-      }
       /**
-       * <code>int32 port = 2;</code>
-       * @param value The port to set.
+       * <code>repeated string fallback_servers = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder setPort(int value) {
-
-        port_ = value;
-        bitField0_ |= 0x00000002;
+      public Builder clearFallbackServers() {
+        fallbackServers_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000001);;
         onChanged();
         return this;
       }
       /**
-       * <code>int32 port = 2;</code>
+       * <code>repeated string fallback_servers = 1;</code>
+       * @param value The bytes of the fallbackServers to add.
        * @return This builder for chaining.
        */
-      public Builder clearPort() {
-        bitField0_ = (bitField0_ & ~0x00000002);
-        port_ = 0;
+      public Builder addFallbackServersBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureFallbackServersIsMutable();
+        fallbackServers_.add(value);
+        bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceOnline)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceDrain)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketServiceOnline)
-    private static final eu.novusmc.athena.common.Protocol.PacketServiceOnline DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketServiceDrain)
+    private static final eu.novusmc.athena.common.Protocol.PacketServiceDrain DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceOnline();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceDrain();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceOnline getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketServiceDrain getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketServiceOnline>
-        PARSER = new com.google.protobuf.AbstractParser<PacketServiceOnline>() {
+    private static final com.google.protobuf.Parser<PacketServiceDrain>
+        PARSER = new com.google.protobuf.AbstractParser<PacketServiceDrain>() {
       @java.lang.Override
-      public PacketServiceOnline parsePartialFrom(
+      public PacketServiceDrain parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -7754,45 +18939,45 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketServiceOnline> parser() {
+    public static com.google.protobuf.Parser<PacketServiceDrain> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketServiceOnline> getParserForType() {
+    public com.google.protobuf.Parser<PacketServiceDrain> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketServiceOnline getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketServiceDrain getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketServiceConnectOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceConnect)
+  public interface PacketProxyUnregisterServerOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketProxyUnregisterServer)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string key = 1;</code>
-     * @return The key.
+     * <code>string server_name = 1;</code>
+     * @return The serverName.
      */
-    java.lang.String getKey();
+    java.lang.String getServerName();
     /**
-     * <code>string key = 1;</code>
-     * @return The bytes for key.
+     * <code>string server_name = 1;</code>
+     * @return The bytes for serverName.
      */
     com.google.protobuf.ByteString
-        getKeyBytes();
+        getServerNameBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketServiceConnect}
+   * Protobuf type {@code protocol.PacketProxyUnregisterServer}
    */
-  public static final class PacketServiceConnect extends
+  public static final class PacketProxyUnregisterServer extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketServiceConnect)
-      PacketServiceConnectOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketProxyUnregisterServer)
+      PacketProxyUnregisterServerOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -7801,62 +18986,62 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketServiceConnect.class.getName());
+        PacketProxyUnregisterServer.class.getName());
     }
-    // Use PacketServiceConnect.newBuilder() to construct.
-    private PacketServiceConnect(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketProxyUnregisterServer.newBuilder() to construct.
+    private PacketProxyUnregisterServer(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketServiceConnect() {
-      key_ = "";
+    private PacketProxyUnregisterServer() {
+      serverName_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketServiceConnect.class, eu.novusmc.athena.common.Protocol.PacketServiceConnect.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.Builder.class);
     }
 
-    public static final int KEY_FIELD_NUMBER = 1;
+    public static final int SERVER_NAME_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object key_ = "";
+    private volatile java.lang.Object serverName_ = "";
     /**
-     * <code>string key = 1;</code>
-     * @return The key.
+     * <code>string server_name = 1;</code>
+     * @return The serverName.
      */
     @java.lang.Override
-    public java.lang.String getKey() {
-      java.lang.Object ref = key_;
+    public java.lang.String getServerName() {
+      java.lang.Object ref = serverName_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        key_ = s;
+        serverName_ = s;
         return s;
       }
     }
     /**
-     * <code>string key = 1;</code>
-     * @return The bytes for key.
+     * <code>string server_name = 1;</code>
+     * @return The bytes for serverName.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getKeyBytes() {
-      java.lang.Object ref = key_;
+        getServerNameBytes() {
+      java.lang.Object ref = serverName_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        key_ = b;
+        serverName_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
@@ -7877,8 +19062,8 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(key_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, key_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serverName_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -7889,8 +19074,8 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(key_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, key_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serverName_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -7902,13 +19087,13 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceConnect)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketServiceConnect other = (eu.novusmc.athena.common.Protocol.PacketServiceConnect) obj;
+      eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer other = (eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer) obj;
 
-      if (!getKey()
-          .equals(other.getKey())) return false;
+      if (!getServerName()
+          .equals(other.getServerName())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -7920,51 +19105,51 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + KEY_FIELD_NUMBER;
-      hash = (53 * hash) + getKey().hashCode();
+      hash = (37 * hash) + SERVER_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServerName().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -7972,26 +19157,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -8004,7 +19189,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceConnect prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -8020,26 +19205,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketServiceConnect}
+     * Protobuf type {@code protocol.PacketProxyUnregisterServer}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceConnect)
-        eu.novusmc.athena.common.Protocol.PacketServiceConnectOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketProxyUnregisterServer)
+        eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServerOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketServiceConnect.class, eu.novusmc.athena.common.Protocol.PacketServiceConnect.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceConnect.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.newBuilder()
       private Builder() {
 
       }
@@ -8053,24 +19238,24 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        key_ = "";
+        serverName_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceConnect_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceConnect getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketServiceConnect.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceConnect build() {
-        eu.novusmc.athena.common.Protocol.PacketServiceConnect result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer build() {
+        eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -8078,34 +19263,34 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketServiceConnect buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketServiceConnect result = new eu.novusmc.athena.common.Protocol.PacketServiceConnect(this);
+      public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer result = new eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceConnect result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.key_ = key_;
+          result.serverName_ = serverName_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceConnect) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceConnect)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceConnect other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketServiceConnect.getDefaultInstance()) return this;
-        if (!other.getKey().isEmpty()) {
-          key_ = other.key_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.getDefaultInstance()) return this;
+        if (!other.getServerName().isEmpty()) {
+          serverName_ = other.serverName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
@@ -8136,7 +19321,7 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                key_ = input.readStringRequireUtf8();
+                serverName_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
@@ -8157,95 +19342,95 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object key_ = "";
+      private java.lang.Object serverName_ = "";
       /**
-       * <code>string key = 1;</code>
-       * @return The key.
+       * <code>string server_name = 1;</code>
+       * @return The serverName.
        */
-      public java.lang.String getKey() {
-        java.lang.Object ref = key_;
+      public java.lang.String getServerName() {
+        java.lang.Object ref = serverName_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          key_ = s;
+          serverName_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string key = 1;</code>
-       * @return The bytes for key.
+       * <code>string server_name = 1;</code>
+       * @return The bytes for serverName.
        */
       public com.google.protobuf.ByteString
-          getKeyBytes() {
-        java.lang.Object ref = key_;
+          getServerNameBytes() {
+        java.lang.Object ref = serverName_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          key_ = b;
+          serverName_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string key = 1;</code>
-       * @param value The key to set.
+       * <code>string server_name = 1;</code>
+       * @param value The serverName to set.
        * @return This builder for chaining.
        */
-      public Builder setKey(
+      public Builder setServerName(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        key_ = value;
+        serverName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string key = 1;</code>
+       * <code>string server_name = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearKey() {
-        key_ = getDefaultInstance().getKey();
+      public Builder clearServerName() {
+        serverName_ = getDefaultInstance().getServerName();
         bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string key = 1;</code>
-       * @param value The bytes for key to set.
+       * <code>string server_name = 1;</code>
+       * @param value The bytes for serverName to set.
        * @return This builder for chaining.
        */
-      public Builder setKeyBytes(
+      public Builder setServerNameBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        key_ = value;
+        serverName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceConnect)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketProxyUnregisterServer)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketServiceConnect)
-    private static final eu.novusmc.athena.common.Protocol.PacketServiceConnect DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketProxyUnregisterServer)
+    private static final eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceConnect();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketServiceConnect getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketServiceConnect>
-        PARSER = new com.google.protobuf.AbstractParser<PacketServiceConnect>() {
+    private static final com.google.protobuf.Parser<PacketProxyUnregisterServer>
+        PARSER = new com.google.protobuf.AbstractParser<PacketProxyUnregisterServer>() {
       @java.lang.Override
-      public PacketServiceConnect parsePartialFrom(
+      public PacketProxyUnregisterServer parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -8264,45 +19449,45 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketServiceConnect> parser() {
+    public static com.google.protobuf.Parser<PacketProxyUnregisterServer> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketServiceConnect> getParserForType() {
+    public com.google.protobuf.Parser<PacketProxyUnregisterServer> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketServiceConnect getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketStopServiceOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketStopService)
+  public interface PacketScreenLineOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketScreenLine)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>string line = 1;</code>
+     * @return The line.
      */
-    java.lang.String getServiceName();
+    java.lang.String getLine();
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>string line = 1;</code>
+     * @return The bytes for line.
      */
     com.google.protobuf.ByteString
-        getServiceNameBytes();
+        getLineBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketStopService}
+   * Protobuf type {@code protocol.PacketScreenLine}
    */
-  public static final class PacketStopService extends
+  public static final class PacketScreenLine extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketStopService)
-      PacketStopServiceOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketScreenLine)
+      PacketScreenLineOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -8311,62 +19496,62 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketStopService.class.getName());
+        PacketScreenLine.class.getName());
     }
-    // Use PacketStopService.newBuilder() to construct.
-    private PacketStopService(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketScreenLine.newBuilder() to construct.
+    private PacketScreenLine(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketStopService() {
-      serviceName_ = "";
+    private PacketScreenLine() {
+      line_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketStopService.class, eu.novusmc.athena.common.Protocol.PacketStopService.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketScreenLine.class, eu.novusmc.athena.common.Protocol.PacketScreenLine.Builder.class);
     }
 
-    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    public static final int LINE_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object serviceName_ = "";
+    private volatile java.lang.Object line_ = "";
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>string line = 1;</code>
+     * @return The line.
      */
     @java.lang.Override
-    public java.lang.String getServiceName() {
-      java.lang.Object ref = serviceName_;
+    public java.lang.String getLine() {
+      java.lang.Object ref = line_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        serviceName_ = s;
+        line_ = s;
         return s;
       }
     }
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>string line = 1;</code>
+     * @return The bytes for line.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getServiceNameBytes() {
-      java.lang.Object ref = serviceName_;
+        getLineBytes() {
+      java.lang.Object ref = line_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        serviceName_ = b;
+        line_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
@@ -8387,8 +19572,8 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(line_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, line_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -8399,8 +19584,8 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(line_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, line_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -8412,13 +19597,13 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketStopService)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketScreenLine)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketStopService other = (eu.novusmc.athena.common.Protocol.PacketStopService) obj;
+      eu.novusmc.athena.common.Protocol.PacketScreenLine other = (eu.novusmc.athena.common.Protocol.PacketScreenLine) obj;
 
-      if (!getServiceName()
-          .equals(other.getServiceName())) return false;
+      if (!getLine()
+          .equals(other.getLine())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -8430,51 +19615,51 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getServiceName().hashCode();
+      hash = (37 * hash) + LINE_FIELD_NUMBER;
+      hash = (53 * hash) + getLine().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -8482,26 +19667,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketStopService parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -8514,7 +19699,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketStopService prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketScreenLine prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -8530,26 +19715,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketStopService}
+     * Protobuf type {@code protocol.PacketScreenLine}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketStopService)
-        eu.novusmc.athena.common.Protocol.PacketStopServiceOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketScreenLine)
+        eu.novusmc.athena.common.Protocol.PacketScreenLineOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketStopService.class, eu.novusmc.athena.common.Protocol.PacketStopService.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketScreenLine.class, eu.novusmc.athena.common.Protocol.PacketScreenLine.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketStopService.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketScreenLine.newBuilder()
       private Builder() {
 
       }
@@ -8563,24 +19748,24 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        serviceName_ = "";
+        line_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketStopService_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketStopService getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketStopService.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketScreenLine getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketScreenLine.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketStopService build() {
-        eu.novusmc.athena.common.Protocol.PacketStopService result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketScreenLine build() {
+        eu.novusmc.athena.common.Protocol.PacketScreenLine result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -8588,34 +19773,34 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketStopService buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketStopService result = new eu.novusmc.athena.common.Protocol.PacketStopService(this);
+      public eu.novusmc.athena.common.Protocol.PacketScreenLine buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketScreenLine result = new eu.novusmc.athena.common.Protocol.PacketScreenLine(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketStopService result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketScreenLine result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.serviceName_ = serviceName_;
+          result.line_ = line_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketStopService) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketStopService)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketScreenLine) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketScreenLine)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketStopService other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketStopService.getDefaultInstance()) return this;
-        if (!other.getServiceName().isEmpty()) {
-          serviceName_ = other.serviceName_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketScreenLine other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketScreenLine.getDefaultInstance()) return this;
+        if (!other.getLine().isEmpty()) {
+          line_ = other.line_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
@@ -8646,7 +19831,7 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                serviceName_ = input.readStringRequireUtf8();
+                line_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
@@ -8667,95 +19852,95 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object serviceName_ = "";
+      private java.lang.Object line_ = "";
       /**
-       * <code>string service_name = 1;</code>
-       * @return The serviceName.
+       * <code>string line = 1;</code>
+       * @return The line.
        */
-      public java.lang.String getServiceName() {
-        java.lang.Object ref = serviceName_;
+      public java.lang.String getLine() {
+        java.lang.Object ref = line_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          serviceName_ = s;
+          line_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @return The bytes for serviceName.
+       * <code>string line = 1;</code>
+       * @return The bytes for line.
        */
       public com.google.protobuf.ByteString
-          getServiceNameBytes() {
-        java.lang.Object ref = serviceName_;
+          getLineBytes() {
+        java.lang.Object ref = line_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          serviceName_ = b;
+          line_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The serviceName to set.
+       * <code>string line = 1;</code>
+       * @param value The line to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceName(
+      public Builder setLine(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        serviceName_ = value;
+        line_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string line = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearServiceName() {
-        serviceName_ = getDefaultInstance().getServiceName();
+      public Builder clearLine() {
+        line_ = getDefaultInstance().getLine();
         bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The bytes for serviceName to set.
+       * <code>string line = 1;</code>
+       * @param value The bytes for line to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceNameBytes(
+      public Builder setLineBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        serviceName_ = value;
+        line_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketStopService)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketScreenLine)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketStopService)
-    private static final eu.novusmc.athena.common.Protocol.PacketStopService DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketScreenLine)
+    private static final eu.novusmc.athena.common.Protocol.PacketScreenLine DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketStopService();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketScreenLine();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketStopService getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketScreenLine getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketStopService>
-        PARSER = new com.google.protobuf.AbstractParser<PacketStopService>() {
+    private static final com.google.protobuf.Parser<PacketScreenLine>
+        PARSER = new com.google.protobuf.AbstractParser<PacketScreenLine>() {
       @java.lang.Override
-      public PacketStopService parsePartialFrom(
+      public PacketScreenLine parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -8774,63 +19959,45 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketStopService> parser() {
+    public static com.google.protobuf.Parser<PacketScreenLine> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketStopService> getParserForType() {
+    public com.google.protobuf.Parser<PacketScreenLine> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketStopService getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketScreenLine getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketProxyRegisterServerOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketProxyRegisterServer)
+  public interface PacketAttachScreenOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketAttachScreen)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string server_name = 1;</code>
-     * @return The serverName.
-     */
-    java.lang.String getServerName();
-    /**
-     * <code>string server_name = 1;</code>
-     * @return The bytes for serverName.
-     */
-    com.google.protobuf.ByteString
-        getServerNameBytes();
-
-    /**
-     * <code>string host = 2;</code>
-     * @return The host.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
-    java.lang.String getHost();
+    java.lang.String getServiceName();
     /**
-     * <code>string host = 2;</code>
-     * @return The bytes for host.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     com.google.protobuf.ByteString
-        getHostBytes();
-
-    /**
-     * <code>int32 port = 3;</code>
-     * @return The port.
-     */
-    int getPort();
+        getServiceNameBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketProxyRegisterServer}
+   * Protobuf type {@code protocol.PacketAttachScreen}
    */
-  public static final class PacketProxyRegisterServer extends
+  public static final class PacketAttachScreen extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketProxyRegisterServer)
-      PacketProxyRegisterServerOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketAttachScreen)
+      PacketAttachScreenOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -8839,119 +20006,68 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketProxyRegisterServer.class.getName());
+        PacketAttachScreen.class.getName());
     }
-    // Use PacketProxyRegisterServer.newBuilder() to construct.
-    private PacketProxyRegisterServer(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketAttachScreen.newBuilder() to construct.
+    private PacketAttachScreen(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketProxyRegisterServer() {
-      serverName_ = "";
-      host_ = "";
+    private PacketAttachScreen() {
+      serviceName_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.Builder.class);
-    }
-
-    public static final int SERVER_NAME_FIELD_NUMBER = 1;
-    @SuppressWarnings("serial")
-    private volatile java.lang.Object serverName_ = "";
-    /**
-     * <code>string server_name = 1;</code>
-     * @return The serverName.
-     */
-    @java.lang.Override
-    public java.lang.String getServerName() {
-      java.lang.Object ref = serverName_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        serverName_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string server_name = 1;</code>
-     * @return The bytes for serverName.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getServerNameBytes() {
-      java.lang.Object ref = serverName_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        serverName_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+              eu.novusmc.athena.common.Protocol.PacketAttachScreen.class, eu.novusmc.athena.common.Protocol.PacketAttachScreen.Builder.class);
     }
 
-    public static final int HOST_FIELD_NUMBER = 2;
+    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object host_ = "";
+    private volatile java.lang.Object serviceName_ = "";
     /**
-     * <code>string host = 2;</code>
-     * @return The host.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
     @java.lang.Override
-    public java.lang.String getHost() {
-      java.lang.Object ref = host_;
+    public java.lang.String getServiceName() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        host_ = s;
+        serviceName_ = s;
         return s;
       }
     }
     /**
-     * <code>string host = 2;</code>
-     * @return The bytes for host.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getHostBytes() {
-      java.lang.Object ref = host_;
+        getServiceNameBytes() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        host_ = b;
+        serviceName_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
       }
     }
 
-    public static final int PORT_FIELD_NUMBER = 3;
-    private int port_ = 0;
-    /**
-     * <code>int32 port = 3;</code>
-     * @return The port.
-     */
-    @java.lang.Override
-    public int getPort() {
-      return port_;
-    }
-
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -8966,14 +20082,8 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serverName_);
-      }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(host_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 2, host_);
-      }
-      if (port_ != 0) {
-        output.writeInt32(3, port_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -8984,15 +20094,8 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serverName_);
-      }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(host_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, host_);
-      }
-      if (port_ != 0) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeInt32Size(3, port_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -9004,17 +20107,13 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAttachScreen)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer other = (eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer) obj;
+      eu.novusmc.athena.common.Protocol.PacketAttachScreen other = (eu.novusmc.athena.common.Protocol.PacketAttachScreen) obj;
 
-      if (!getServerName()
-          .equals(other.getServerName())) return false;
-      if (!getHost()
-          .equals(other.getHost())) return false;
-      if (getPort()
-          != other.getPort()) return false;
+      if (!getServiceName()
+          .equals(other.getServiceName())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -9026,55 +20125,51 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SERVER_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getServerName().hashCode();
-      hash = (37 * hash) + HOST_FIELD_NUMBER;
-      hash = (53 * hash) + getHost().hashCode();
-      hash = (37 * hash) + PORT_FIELD_NUMBER;
-      hash = (53 * hash) + getPort();
+      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServiceName().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -9082,26 +20177,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -9114,7 +20209,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAttachScreen prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -9130,26 +20225,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketProxyRegisterServer}
+     * Protobuf type {@code protocol.PacketAttachScreen}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketProxyRegisterServer)
-        eu.novusmc.athena.common.Protocol.PacketProxyRegisterServerOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketAttachScreen)
+        eu.novusmc.athena.common.Protocol.PacketAttachScreenOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketAttachScreen.class, eu.novusmc.athena.common.Protocol.PacketAttachScreen.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketAttachScreen.newBuilder()
       private Builder() {
 
       }
@@ -9163,26 +20258,24 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        serverName_ = "";
-        host_ = "";
-        port_ = 0;
+        serviceName_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyRegisterServer_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketAttachScreen getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketAttachScreen.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer build() {
-        eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketAttachScreen build() {
+        eu.novusmc.athena.common.Protocol.PacketAttachScreen result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -9190,51 +20283,37 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer result = new eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer(this);
+      public eu.novusmc.athena.common.Protocol.PacketAttachScreen buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketAttachScreen result = new eu.novusmc.athena.common.Protocol.PacketAttachScreen(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketAttachScreen result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.serverName_ = serverName_;
-        }
-        if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.host_ = host_;
-        }
-        if (((from_bitField0_ & 0x00000004) != 0)) {
-          result.port_ = port_;
+          result.serviceName_ = serviceName_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAttachScreen) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAttachScreen)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer.getDefaultInstance()) return this;
-        if (!other.getServerName().isEmpty()) {
-          serverName_ = other.serverName_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAttachScreen other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketAttachScreen.getDefaultInstance()) return this;
+        if (!other.getServiceName().isEmpty()) {
+          serviceName_ = other.serviceName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
-        if (!other.getHost().isEmpty()) {
-          host_ = other.host_;
-          bitField0_ |= 0x00000002;
-          onChanged();
-        }
-        if (other.getPort() != 0) {
-          setPort(other.getPort());
-        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -9248,244 +20327,130 @@ public final class Protocol {
       @java.lang.Override
       public Builder mergeFrom(
           com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        if (extensionRegistry == null) {
-          throw new java.lang.NullPointerException();
-        }
-        try {
-          boolean done = false;
-          while (!done) {
-            int tag = input.readTag();
-            switch (tag) {
-              case 0:
-                done = true;
-                break;
-              case 10: {
-                serverName_ = input.readStringRequireUtf8();
-                bitField0_ |= 0x00000001;
-                break;
-              } // case 10
-              case 18: {
-                host_ = input.readStringRequireUtf8();
-                bitField0_ |= 0x00000002;
-                break;
-              } // case 18
-              case 24: {
-                port_ = input.readInt32();
-                bitField0_ |= 0x00000004;
-                break;
-              } // case 24
-              default: {
-                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
-                  done = true; // was an endgroup tag
-                }
-                break;
-              } // default:
-            } // switch (tag)
-          } // while (!done)
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          throw e.unwrapIOException();
-        } finally {
-          onChanged();
-        } // finally
-        return this;
-      }
-      private int bitField0_;
-
-      private java.lang.Object serverName_ = "";
-      /**
-       * <code>string server_name = 1;</code>
-       * @return The serverName.
-       */
-      public java.lang.String getServerName() {
-        java.lang.Object ref = serverName_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          serverName_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string server_name = 1;</code>
-       * @return The bytes for serverName.
-       */
-      public com.google.protobuf.ByteString
-          getServerNameBytes() {
-        java.lang.Object ref = serverName_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          serverName_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string server_name = 1;</code>
-       * @param value The serverName to set.
-       * @return This builder for chaining.
-       */
-      public Builder setServerName(
-          java.lang.String value) {
-        if (value == null) { throw new NullPointerException(); }
-        serverName_ = value;
-        bitField0_ |= 0x00000001;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string server_name = 1;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearServerName() {
-        serverName_ = getDefaultInstance().getServerName();
-        bitField0_ = (bitField0_ & ~0x00000001);
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string server_name = 1;</code>
-       * @param value The bytes for serverName to set.
-       * @return This builder for chaining.
-       */
-      public Builder setServerNameBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) { throw new NullPointerException(); }
-        checkByteStringIsUtf8(value);
-        serverName_ = value;
-        bitField0_ |= 0x00000001;
-        onChanged();
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                serviceName_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000001;
+                break;
+              } // case 10
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
         return this;
       }
+      private int bitField0_;
 
-      private java.lang.Object host_ = "";
+      private java.lang.Object serviceName_ = "";
       /**
-       * <code>string host = 2;</code>
-       * @return The host.
+       * <code>string service_name = 1;</code>
+       * @return The serviceName.
        */
-      public java.lang.String getHost() {
-        java.lang.Object ref = host_;
+      public java.lang.String getServiceName() {
+        java.lang.Object ref = serviceName_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          host_ = s;
+          serviceName_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string host = 2;</code>
-       * @return The bytes for host.
+       * <code>string service_name = 1;</code>
+       * @return The bytes for serviceName.
        */
       public com.google.protobuf.ByteString
-          getHostBytes() {
-        java.lang.Object ref = host_;
+          getServiceNameBytes() {
+        java.lang.Object ref = serviceName_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          host_ = b;
+          serviceName_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string host = 2;</code>
-       * @param value The host to set.
+       * <code>string service_name = 1;</code>
+       * @param value The serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setHost(
+      public Builder setServiceName(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        host_ = value;
-        bitField0_ |= 0x00000002;
+        serviceName_ = value;
+        bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string host = 2;</code>
+       * <code>string service_name = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearHost() {
-        host_ = getDefaultInstance().getHost();
-        bitField0_ = (bitField0_ & ~0x00000002);
+      public Builder clearServiceName() {
+        serviceName_ = getDefaultInstance().getServiceName();
+        bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string host = 2;</code>
-       * @param value The bytes for host to set.
+       * <code>string service_name = 1;</code>
+       * @param value The bytes for serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setHostBytes(
+      public Builder setServiceNameBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        host_ = value;
-        bitField0_ |= 0x00000002;
-        onChanged();
-        return this;
-      }
-
-      private int port_ ;
-      /**
-       * <code>int32 port = 3;</code>
-       * @return The port.
-       */
-      @java.lang.Override
-      public int getPort() {
-        return port_;
-      }
-      /**
-       * <code>int32 port = 3;</code>
-       * @param value The port to set.
-       * @return This builder for chaining.
-       */
-      public Builder setPort(int value) {
-
-        port_ = value;
-        bitField0_ |= 0x00000004;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>int32 port = 3;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearPort() {
-        bitField0_ = (bitField0_ & ~0x00000004);
-        port_ = 0;
+        serviceName_ = value;
+        bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketProxyRegisterServer)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketAttachScreen)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketProxyRegisterServer)
-    private static final eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketAttachScreen)
+    private static final eu.novusmc.athena.common.Protocol.PacketAttachScreen DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAttachScreen();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketProxyRegisterServer>
-        PARSER = new com.google.protobuf.AbstractParser<PacketProxyRegisterServer>() {
+    private static final com.google.protobuf.Parser<PacketAttachScreen>
+        PARSER = new com.google.protobuf.AbstractParser<PacketAttachScreen>() {
       @java.lang.Override
-      public PacketProxyRegisterServer parsePartialFrom(
+      public PacketAttachScreen parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -9504,45 +20469,45 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketProxyRegisterServer> parser() {
+    public static com.google.protobuf.Parser<PacketAttachScreen> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketProxyRegisterServer> getParserForType() {
+    public com.google.protobuf.Parser<PacketAttachScreen> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketProxyRegisterServer getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketAttachScreen getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketProxyUnregisterServerOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketProxyUnregisterServer)
+  public interface PacketDetachScreenOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketDetachScreen)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string server_name = 1;</code>
-     * @return The serverName.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
-    java.lang.String getServerName();
+    java.lang.String getServiceName();
     /**
-     * <code>string server_name = 1;</code>
-     * @return The bytes for serverName.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     com.google.protobuf.ByteString
-        getServerNameBytes();
+        getServiceNameBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketProxyUnregisterServer}
+   * Protobuf type {@code protocol.PacketDetachScreen}
    */
-  public static final class PacketProxyUnregisterServer extends
+  public static final class PacketDetachScreen extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketProxyUnregisterServer)
-      PacketProxyUnregisterServerOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketDetachScreen)
+      PacketDetachScreenOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -9551,62 +20516,62 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketProxyUnregisterServer.class.getName());
+        PacketDetachScreen.class.getName());
     }
-    // Use PacketProxyUnregisterServer.newBuilder() to construct.
-    private PacketProxyUnregisterServer(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketDetachScreen.newBuilder() to construct.
+    private PacketDetachScreen(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketProxyUnregisterServer() {
-      serverName_ = "";
+    private PacketDetachScreen() {
+      serviceName_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketDetachScreen.class, eu.novusmc.athena.common.Protocol.PacketDetachScreen.Builder.class);
     }
 
-    public static final int SERVER_NAME_FIELD_NUMBER = 1;
+    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object serverName_ = "";
+    private volatile java.lang.Object serviceName_ = "";
     /**
-     * <code>string server_name = 1;</code>
-     * @return The serverName.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
     @java.lang.Override
-    public java.lang.String getServerName() {
-      java.lang.Object ref = serverName_;
+    public java.lang.String getServiceName() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        serverName_ = s;
+        serviceName_ = s;
         return s;
       }
     }
     /**
-     * <code>string server_name = 1;</code>
-     * @return The bytes for serverName.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getServerNameBytes() {
-      java.lang.Object ref = serverName_;
+        getServiceNameBytes() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        serverName_ = b;
+        serviceName_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
@@ -9627,8 +20592,8 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serverName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -9639,8 +20604,8 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serverName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serverName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -9652,13 +20617,13 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketDetachScreen)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer other = (eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer) obj;
+      eu.novusmc.athena.common.Protocol.PacketDetachScreen other = (eu.novusmc.athena.common.Protocol.PacketDetachScreen) obj;
 
-      if (!getServerName()
-          .equals(other.getServerName())) return false;
+      if (!getServiceName()
+          .equals(other.getServiceName())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -9670,51 +20635,51 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SERVER_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getServerName().hashCode();
+      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServiceName().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -9722,26 +20687,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -9754,7 +20719,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketDetachScreen prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -9770,26 +20735,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketProxyUnregisterServer}
+     * Protobuf type {@code protocol.PacketDetachScreen}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketProxyUnregisterServer)
-        eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServerOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketDetachScreen)
+        eu.novusmc.athena.common.Protocol.PacketDetachScreenOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.class, eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketDetachScreen.class, eu.novusmc.athena.common.Protocol.PacketDetachScreen.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketDetachScreen.newBuilder()
       private Builder() {
 
       }
@@ -9803,24 +20768,24 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        serverName_ = "";
+        serviceName_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketProxyUnregisterServer_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketDetachScreen getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketDetachScreen.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer build() {
-        eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketDetachScreen build() {
+        eu.novusmc.athena.common.Protocol.PacketDetachScreen result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -9828,34 +20793,34 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer result = new eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer(this);
+      public eu.novusmc.athena.common.Protocol.PacketDetachScreen buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketDetachScreen result = new eu.novusmc.athena.common.Protocol.PacketDetachScreen(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketDetachScreen result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.serverName_ = serverName_;
+          result.serviceName_ = serviceName_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketDetachScreen) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketDetachScreen)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer.getDefaultInstance()) return this;
-        if (!other.getServerName().isEmpty()) {
-          serverName_ = other.serverName_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketDetachScreen other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketDetachScreen.getDefaultInstance()) return this;
+        if (!other.getServiceName().isEmpty()) {
+          serviceName_ = other.serviceName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
@@ -9886,7 +20851,7 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                serverName_ = input.readStringRequireUtf8();
+                serviceName_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
@@ -9907,95 +20872,95 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object serverName_ = "";
+      private java.lang.Object serviceName_ = "";
       /**
-       * <code>string server_name = 1;</code>
-       * @return The serverName.
+       * <code>string service_name = 1;</code>
+       * @return The serviceName.
        */
-      public java.lang.String getServerName() {
-        java.lang.Object ref = serverName_;
+      public java.lang.String getServiceName() {
+        java.lang.Object ref = serviceName_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          serverName_ = s;
+          serviceName_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string server_name = 1;</code>
-       * @return The bytes for serverName.
+       * <code>string service_name = 1;</code>
+       * @return The bytes for serviceName.
        */
       public com.google.protobuf.ByteString
-          getServerNameBytes() {
-        java.lang.Object ref = serverName_;
+          getServiceNameBytes() {
+        java.lang.Object ref = serviceName_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          serverName_ = b;
+          serviceName_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string server_name = 1;</code>
-       * @param value The serverName to set.
+       * <code>string service_name = 1;</code>
+       * @param value The serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setServerName(
+      public Builder setServiceName(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        serverName_ = value;
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string server_name = 1;</code>
+       * <code>string service_name = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearServerName() {
-        serverName_ = getDefaultInstance().getServerName();
+      public Builder clearServiceName() {
+        serviceName_ = getDefaultInstance().getServiceName();
         bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string server_name = 1;</code>
-       * @param value The bytes for serverName to set.
+       * <code>string service_name = 1;</code>
+       * @param value The bytes for serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setServerNameBytes(
+      public Builder setServiceNameBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        serverName_ = value;
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketProxyUnregisterServer)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketDetachScreen)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketProxyUnregisterServer)
-    private static final eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketDetachScreen)
+    private static final eu.novusmc.athena.common.Protocol.PacketDetachScreen DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketDetachScreen();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketProxyUnregisterServer>
-        PARSER = new com.google.protobuf.AbstractParser<PacketProxyUnregisterServer>() {
+    private static final com.google.protobuf.Parser<PacketDetachScreen>
+        PARSER = new com.google.protobuf.AbstractParser<PacketDetachScreen>() {
       @java.lang.Override
-      public PacketProxyUnregisterServer parsePartialFrom(
+      public PacketDetachScreen parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -10014,45 +20979,57 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketProxyUnregisterServer> parser() {
+    public static com.google.protobuf.Parser<PacketDetachScreen> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketProxyUnregisterServer> getParserForType() {
+    public com.google.protobuf.Parser<PacketDetachScreen> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketProxyUnregisterServer getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketDetachScreen getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketScreenLineOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketScreenLine)
+  public interface PacketExecuteServiceCommandOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketExecuteServiceCommand)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string line = 1;</code>
-     * @return The line.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
-    java.lang.String getLine();
+    java.lang.String getServiceName();
     /**
-     * <code>string line = 1;</code>
-     * @return The bytes for line.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     com.google.protobuf.ByteString
-        getLineBytes();
+        getServiceNameBytes();
+
+    /**
+     * <code>string command = 2;</code>
+     * @return The command.
+     */
+    java.lang.String getCommand();
+    /**
+     * <code>string command = 2;</code>
+     * @return The bytes for command.
+     */
+    com.google.protobuf.ByteString
+        getCommandBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketScreenLine}
+   * Protobuf type {@code protocol.PacketExecuteServiceCommand}
    */
-  public static final class PacketScreenLine extends
+  public static final class PacketExecuteServiceCommand extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketScreenLine)
-      PacketScreenLineOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketExecuteServiceCommand)
+      PacketExecuteServiceCommandOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -10061,62 +21038,102 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketScreenLine.class.getName());
+        PacketExecuteServiceCommand.class.getName());
     }
-    // Use PacketScreenLine.newBuilder() to construct.
-    private PacketScreenLine(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketExecuteServiceCommand.newBuilder() to construct.
+    private PacketExecuteServiceCommand(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketScreenLine() {
-      line_ = "";
+    private PacketExecuteServiceCommand() {
+      serviceName_ = "";
+      command_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketScreenLine.class, eu.novusmc.athena.common.Protocol.PacketScreenLine.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.class, eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.Builder.class);
     }
 
-    public static final int LINE_FIELD_NUMBER = 1;
+    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object line_ = "";
+    private volatile java.lang.Object serviceName_ = "";
     /**
-     * <code>string line = 1;</code>
-     * @return The line.
+     * <code>string service_name = 1;</code>
+     * @return The serviceName.
      */
     @java.lang.Override
-    public java.lang.String getLine() {
-      java.lang.Object ref = line_;
+    public java.lang.String getServiceName() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        line_ = s;
+        serviceName_ = s;
         return s;
       }
     }
     /**
-     * <code>string line = 1;</code>
-     * @return The bytes for line.
+     * <code>string service_name = 1;</code>
+     * @return The bytes for serviceName.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getLineBytes() {
-      java.lang.Object ref = line_;
+        getServiceNameBytes() {
+      java.lang.Object ref = serviceName_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        line_ = b;
+        serviceName_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int COMMAND_FIELD_NUMBER = 2;
+    @SuppressWarnings("serial")
+    private volatile java.lang.Object command_ = "";
+    /**
+     * <code>string command = 2;</code>
+     * @return The command.
+     */
+    @java.lang.Override
+    public java.lang.String getCommand() {
+      java.lang.Object ref = command_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        command_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string command = 2;</code>
+     * @return The bytes for command.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getCommandBytes() {
+      java.lang.Object ref = command_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        command_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
@@ -10137,8 +21154,11 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(line_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, line_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(command_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 2, command_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -10149,8 +21169,11 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(line_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, line_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(command_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, command_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -10162,13 +21185,15 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketScreenLine)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketScreenLine other = (eu.novusmc.athena.common.Protocol.PacketScreenLine) obj;
+      eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand other = (eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand) obj;
 
-      if (!getLine()
-          .equals(other.getLine())) return false;
+      if (!getServiceName()
+          .equals(other.getServiceName())) return false;
+      if (!getCommand()
+          .equals(other.getCommand())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -10180,51 +21205,53 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + LINE_FIELD_NUMBER;
-      hash = (53 * hash) + getLine().hashCode();
+      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getServiceName().hashCode();
+      hash = (37 * hash) + COMMAND_FIELD_NUMBER;
+      hash = (53 * hash) + getCommand().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -10232,26 +21259,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -10264,7 +21291,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketScreenLine prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -10280,26 +21307,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketScreenLine}
+     * Protobuf type {@code protocol.PacketExecuteServiceCommand}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketScreenLine)
-        eu.novusmc.athena.common.Protocol.PacketScreenLineOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketExecuteServiceCommand)
+        eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommandOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketScreenLine.class, eu.novusmc.athena.common.Protocol.PacketScreenLine.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.class, eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketScreenLine.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.newBuilder()
       private Builder() {
 
       }
@@ -10313,24 +21340,25 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        line_ = "";
+        serviceName_ = "";
+        command_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketScreenLine_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketScreenLine getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketScreenLine.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketScreenLine build() {
-        eu.novusmc.athena.common.Protocol.PacketScreenLine result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand build() {
+        eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -10338,37 +21366,45 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketScreenLine buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketScreenLine result = new eu.novusmc.athena.common.Protocol.PacketScreenLine(this);
+      public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand result = new eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketScreenLine result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.line_ = line_;
+          result.serviceName_ = serviceName_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.command_ = command_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketScreenLine) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketScreenLine)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketScreenLine other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketScreenLine.getDefaultInstance()) return this;
-        if (!other.getLine().isEmpty()) {
-          line_ = other.line_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.getDefaultInstance()) return this;
+        if (!other.getServiceName().isEmpty()) {
+          serviceName_ = other.serviceName_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
+        if (!other.getCommand().isEmpty()) {
+          command_ = other.command_;
+          bitField0_ |= 0x00000002;
+          onChanged();
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -10396,10 +21432,15 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                line_ = input.readStringRequireUtf8();
+                serviceName_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
+              case 18: {
+                command_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -10417,95 +21458,167 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object line_ = "";
+      private java.lang.Object serviceName_ = "";
       /**
-       * <code>string line = 1;</code>
-       * @return The line.
+       * <code>string service_name = 1;</code>
+       * @return The serviceName.
        */
-      public java.lang.String getLine() {
-        java.lang.Object ref = line_;
+      public java.lang.String getServiceName() {
+        java.lang.Object ref = serviceName_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          line_ = s;
+          serviceName_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string line = 1;</code>
-       * @return The bytes for line.
+       * <code>string service_name = 1;</code>
+       * @return The bytes for serviceName.
        */
       public com.google.protobuf.ByteString
-          getLineBytes() {
-        java.lang.Object ref = line_;
+          getServiceNameBytes() {
+        java.lang.Object ref = serviceName_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          line_ = b;
+          serviceName_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string line = 1;</code>
-       * @param value The line to set.
+       * <code>string service_name = 1;</code>
+       * @param value The serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setLine(
+      public Builder setServiceName(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        line_ = value;
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string line = 1;</code>
+       * <code>string service_name = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearLine() {
-        line_ = getDefaultInstance().getLine();
+      public Builder clearServiceName() {
+        serviceName_ = getDefaultInstance().getServiceName();
         bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string line = 1;</code>
-       * @param value The bytes for line to set.
+       * <code>string service_name = 1;</code>
+       * @param value The bytes for serviceName to set.
        * @return This builder for chaining.
        */
-      public Builder setLineBytes(
+      public Builder setServiceNameBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        line_ = value;
+        serviceName_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketScreenLine)
+      private java.lang.Object command_ = "";
+      /**
+       * <code>string command = 2;</code>
+       * @return The command.
+       */
+      public java.lang.String getCommand() {
+        java.lang.Object ref = command_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          command_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @return The bytes for command.
+       */
+      public com.google.protobuf.ByteString
+          getCommandBytes() {
+        java.lang.Object ref = command_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          command_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @param value The command to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCommand(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        command_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCommand() {
+        command_ = getDefaultInstance().getCommand();
+        bitField0_ = (bitField0_ & ~0x00000002);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @param value The bytes for command to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCommandBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        command_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketExecuteServiceCommand)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketScreenLine)
-    private static final eu.novusmc.athena.common.Protocol.PacketScreenLine DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketExecuteServiceCommand)
+    private static final eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketScreenLine();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketScreenLine getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketScreenLine>
-        PARSER = new com.google.protobuf.AbstractParser<PacketScreenLine>() {
+    private static final com.google.protobuf.Parser<PacketExecuteServiceCommand>
+        PARSER = new com.google.protobuf.AbstractParser<PacketExecuteServiceCommand>() {
       @java.lang.Override
-      public PacketScreenLine parsePartialFrom(
+      public PacketExecuteServiceCommand parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -10524,45 +21637,102 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketScreenLine> parser() {
+    public static com.google.protobuf.Parser<PacketExecuteServiceCommand> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketScreenLine> getParserForType() {
+    public com.google.protobuf.Parser<PacketExecuteServiceCommand> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketScreenLine getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketAttachScreenOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketAttachScreen)
+  public interface PacketSaveServiceOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketSaveService)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string service_name = 1;</code>
+     * <code>uint64 save_id = 1;</code>
+     * @return The saveId.
+     */
+    long getSaveId();
+
+    /**
+     * <code>string service_name = 2;</code>
      * @return The serviceName.
      */
     java.lang.String getServiceName();
     /**
-     * <code>string service_name = 1;</code>
+     * <code>string service_name = 2;</code>
      * @return The bytes for serviceName.
      */
     com.google.protobuf.ByteString
         getServiceNameBytes();
+
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @return A list containing the excludes.
+     */
+    java.util.List<java.lang.String>
+        getExcludesList();
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @return The count of excludes.
+     */
+    int getExcludesCount();
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @param index The index of the element to return.
+     * @return The excludes at the given index.
+     */
+    java.lang.String getExcludes(int index);
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the excludes at the given index.
+     */
+    com.google.protobuf.ByteString
+        getExcludesBytes(int index);
+
+    /**
+     * <pre>
+     * save_all asks the server to flush its worlds before the directory is packed.
+     * </pre>
+     *
+     * <code>bool save_all = 4;</code>
+     * @return The saveAll.
+     */
+    boolean getSaveAll();
   }
   /**
-   * Protobuf type {@code protocol.PacketAttachScreen}
+   * Protobuf type {@code protocol.PacketSaveService}
    */
-  public static final class PacketAttachScreen extends
+  public static final class PacketSaveService extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketAttachScreen)
-      PacketAttachScreenOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketSaveService)
+      PacketSaveServiceOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -10571,34 +21741,47 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketAttachScreen.class.getName());
+        PacketSaveService.class.getName());
     }
-    // Use PacketAttachScreen.newBuilder() to construct.
-    private PacketAttachScreen(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketSaveService.newBuilder() to construct.
+    private PacketSaveService(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketAttachScreen() {
+    private PacketSaveService() {
       serviceName_ = "";
+      excludes_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSaveService_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSaveService_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketAttachScreen.class, eu.novusmc.athena.common.Protocol.PacketAttachScreen.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketSaveService.class, eu.novusmc.athena.common.Protocol.PacketSaveService.Builder.class);
+    }
+
+    public static final int SAVE_ID_FIELD_NUMBER = 1;
+    private long saveId_ = 0L;
+    /**
+     * <code>uint64 save_id = 1;</code>
+     * @return The saveId.
+     */
+    @java.lang.Override
+    public long getSaveId() {
+      return saveId_;
     }
 
-    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    public static final int SERVICE_NAME_FIELD_NUMBER = 2;
     @SuppressWarnings("serial")
     private volatile java.lang.Object serviceName_ = "";
     /**
-     * <code>string service_name = 1;</code>
+     * <code>string service_name = 2;</code>
      * @return The serviceName.
      */
     @java.lang.Override
@@ -10615,7 +21798,7 @@ public final class Protocol {
       }
     }
     /**
-     * <code>string service_name = 1;</code>
+     * <code>string service_name = 2;</code>
      * @return The bytes for serviceName.
      */
     @java.lang.Override
@@ -10633,6 +21816,74 @@ public final class Protocol {
       }
     }
 
+    public static final int EXCLUDES_FIELD_NUMBER = 3;
+    @SuppressWarnings("serial")
+    private com.google.protobuf.LazyStringArrayList excludes_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @return A list containing the excludes.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getExcludesList() {
+      return excludes_;
+    }
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @return The count of excludes.
+     */
+    public int getExcludesCount() {
+      return excludes_.size();
+    }
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @param index The index of the element to return.
+     * @return The excludes at the given index.
+     */
+    public java.lang.String getExcludes(int index) {
+      return excludes_.get(index);
+    }
+    /**
+     * <pre>
+     * excludes are glob patterns of paths that are not saved.
+     * </pre>
+     *
+     * <code>repeated string excludes = 3;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the excludes at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getExcludesBytes(int index) {
+      return excludes_.getByteString(index);
+    }
+
+    public static final int SAVE_ALL_FIELD_NUMBER = 4;
+    private boolean saveAll_ = false;
+    /**
+     * <pre>
+     * save_all asks the server to flush its worlds before the directory is packed.
+     * </pre>
+     *
+     * <code>bool save_all = 4;</code>
+     * @return The saveAll.
+     */
+    @java.lang.Override
+    public boolean getSaveAll() {
+      return saveAll_;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -10647,8 +21898,17 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
+      if (saveId_ != 0L) {
+        output.writeUInt64(1, saveId_);
+      }
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+        com.google.protobuf.GeneratedMessage.writeString(output, 2, serviceName_);
+      }
+      for (int i = 0; i < excludes_.size(); i++) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 3, excludes_.getRaw(i));
+      }
+      if (saveAll_ != false) {
+        output.writeBool(4, saveAll_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -10659,8 +21919,24 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
+      if (saveId_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(1, saveId_);
+      }
       if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, serviceName_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < excludes_.size(); i++) {
+          dataSize += computeStringSizeNoTag(excludes_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getExcludesList().size();
+      }
+      if (saveAll_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(4, saveAll_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -10672,13 +21948,19 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketAttachScreen)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketSaveService)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketAttachScreen other = (eu.novusmc.athena.common.Protocol.PacketAttachScreen) obj;
+      eu.novusmc.athena.common.Protocol.PacketSaveService other = (eu.novusmc.athena.common.Protocol.PacketSaveService) obj;
 
+      if (getSaveId()
+          != other.getSaveId()) return false;
       if (!getServiceName()
           .equals(other.getServiceName())) return false;
+      if (!getExcludesList()
+          .equals(other.getExcludesList())) return false;
+      if (getSaveAll()
+          != other.getSaveAll()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -10690,51 +21972,61 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + SAVE_ID_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getSaveId());
       hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
       hash = (53 * hash) + getServiceName().hashCode();
+      if (getExcludesCount() > 0) {
+        hash = (37 * hash) + EXCLUDES_FIELD_NUMBER;
+        hash = (53 * hash) + getExcludesList().hashCode();
+      }
+      hash = (37 * hash) + SAVE_ALL_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getSaveAll());
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -10742,26 +22034,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -10774,7 +22066,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketAttachScreen prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketSaveService prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -10790,26 +22082,26 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketAttachScreen}
+     * Protobuf type {@code protocol.PacketSaveService}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketAttachScreen)
-        eu.novusmc.athena.common.Protocol.PacketAttachScreenOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketSaveService)
+        eu.novusmc.athena.common.Protocol.PacketSaveServiceOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSaveService_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSaveService_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketAttachScreen.class, eu.novusmc.athena.common.Protocol.PacketAttachScreen.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketSaveService.class, eu.novusmc.athena.common.Protocol.PacketSaveService.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketAttachScreen.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketSaveService.newBuilder()
       private Builder() {
 
       }
@@ -10823,24 +22115,28 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
+        saveId_ = 0L;
         serviceName_ = "";
+        excludes_ =
+            com.google.protobuf.LazyStringArrayList.emptyList();
+        saveAll_ = false;
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketAttachScreen_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketSaveService_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAttachScreen getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketAttachScreen.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketSaveService getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketSaveService.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAttachScreen build() {
-        eu.novusmc.athena.common.Protocol.PacketAttachScreen result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketSaveService build() {
+        eu.novusmc.athena.common.Protocol.PacketSaveService result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -10848,37 +22144,63 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketAttachScreen buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketAttachScreen result = new eu.novusmc.athena.common.Protocol.PacketAttachScreen(this);
+      public eu.novusmc.athena.common.Protocol.PacketSaveService buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketSaveService result = new eu.novusmc.athena.common.Protocol.PacketSaveService(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketAttachScreen result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketSaveService result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
+          result.saveId_ = saveId_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
           result.serviceName_ = serviceName_;
         }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          excludes_.makeImmutable();
+          result.excludes_ = excludes_;
+        }
+        if (((from_bitField0_ & 0x00000008) != 0)) {
+          result.saveAll_ = saveAll_;
+        }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketAttachScreen) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketAttachScreen)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketSaveService) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketSaveService)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketAttachScreen other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketAttachScreen.getDefaultInstance()) return this;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketSaveService other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketSaveService.getDefaultInstance()) return this;
+        if (other.getSaveId() != 0L) {
+          setSaveId(other.getSaveId());
+        }
         if (!other.getServiceName().isEmpty()) {
           serviceName_ = other.serviceName_;
-          bitField0_ |= 0x00000001;
+          bitField0_ |= 0x00000002;
+          onChanged();
+        }
+        if (!other.excludes_.isEmpty()) {
+          if (excludes_.isEmpty()) {
+            excludes_ = other.excludes_;
+            bitField0_ |= 0x00000004;
+          } else {
+            ensureExcludesIsMutable();
+            excludes_.addAll(other.excludes_);
+          }
           onChanged();
         }
+        if (other.getSaveAll() != false) {
+          setSaveAll(other.getSaveAll());
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -10905,11 +22227,27 @@ public final class Protocol {
               case 0:
                 done = true;
                 break;
-              case 10: {
-                serviceName_ = input.readStringRequireUtf8();
+              case 8: {
+                saveId_ = input.readUInt64();
                 bitField0_ |= 0x00000001;
                 break;
-              } // case 10
+              } // case 8
+              case 18: {
+                serviceName_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
+              case 26: {
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureExcludesIsMutable();
+                excludes_.add(s);
+                break;
+              } // case 26
+              case 32: {
+                saveAll_ = input.readBool();
+                bitField0_ |= 0x00000008;
+                break;
+              } // case 32
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -10927,9 +22265,41 @@ public final class Protocol {
       }
       private int bitField0_;
 
+      private long saveId_ ;
+      /**
+       * <code>uint64 save_id = 1;</code>
+       * @return The saveId.
+       */
+      @java.lang.Override
+      public long getSaveId() {
+        return saveId_;
+      }
+      /**
+       * <code>uint64 save_id = 1;</code>
+       * @param value The saveId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSaveId(long value) {
+
+        saveId_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint64 save_id = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSaveId() {
+        bitField0_ = (bitField0_ & ~0x00000001);
+        saveId_ = 0L;
+        onChanged();
+        return this;
+      }
+
       private java.lang.Object serviceName_ = "";
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string service_name = 2;</code>
        * @return The serviceName.
        */
       public java.lang.String getServiceName() {
@@ -10945,7 +22315,7 @@ public final class Protocol {
         }
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string service_name = 2;</code>
        * @return The bytes for serviceName.
        */
       public com.google.protobuf.ByteString
@@ -10962,7 +22332,7 @@ public final class Protocol {
         }
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string service_name = 2;</code>
        * @param value The serviceName to set.
        * @return This builder for chaining.
        */
@@ -10970,22 +22340,22 @@ public final class Protocol {
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
         serviceName_ = value;
-        bitField0_ |= 0x00000001;
+        bitField0_ |= 0x00000002;
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string service_name = 2;</code>
        * @return This builder for chaining.
        */
       public Builder clearServiceName() {
         serviceName_ = getDefaultInstance().getServiceName();
-        bitField0_ = (bitField0_ & ~0x00000001);
+        bitField0_ = (bitField0_ & ~0x00000002);
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string service_name = 2;</code>
        * @param value The bytes for serviceName to set.
        * @return This builder for chaining.
        */
@@ -10994,28 +22364,219 @@ public final class Protocol {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
         serviceName_ = value;
-        bitField0_ |= 0x00000001;
+        bitField0_ |= 0x00000002;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketAttachScreen)
+      private com.google.protobuf.LazyStringArrayList excludes_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+      private void ensureExcludesIsMutable() {
+        if (!excludes_.isModifiable()) {
+          excludes_ = new com.google.protobuf.LazyStringArrayList(excludes_);
+        }
+        bitField0_ |= 0x00000004;
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @return A list containing the excludes.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getExcludesList() {
+        excludes_.makeImmutable();
+        return excludes_;
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @return The count of excludes.
+       */
+      public int getExcludesCount() {
+        return excludes_.size();
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @param index The index of the element to return.
+       * @return The excludes at the given index.
+       */
+      public java.lang.String getExcludes(int index) {
+        return excludes_.get(index);
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the excludes at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getExcludesBytes(int index) {
+        return excludes_.getByteString(index);
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @param index The index to set the value at.
+       * @param value The excludes to set.
+       * @return This builder for chaining.
+       */
+      public Builder setExcludes(
+          int index, java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureExcludesIsMutable();
+        excludes_.set(index, value);
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @param value The excludes to add.
+       * @return This builder for chaining.
+       */
+      public Builder addExcludes(
+          java.lang.String value) {
+        if (value == null) { throw new NullPointerException(); }
+        ensureExcludesIsMutable();
+        excludes_.add(value);
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @param values The excludes to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllExcludes(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureExcludesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, excludes_);
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearExcludes() {
+        excludes_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000004);;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * excludes are glob patterns of paths that are not saved.
+       * </pre>
+       *
+       * <code>repeated string excludes = 3;</code>
+       * @param value The bytes of the excludes to add.
+       * @return This builder for chaining.
+       */
+      public Builder addExcludesBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        checkByteStringIsUtf8(value);
+        ensureExcludesIsMutable();
+        excludes_.add(value);
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+
+      private boolean saveAll_ ;
+      /**
+       * <pre>
+       * save_all asks the server to flush its worlds before the directory is packed.
+       * </pre>
+       *
+       * <code>bool save_all = 4;</code>
+       * @return The saveAll.
+       */
+      @java.lang.Override
+      public boolean getSaveAll() {
+        return saveAll_;
+      }
+      /**
+       * <pre>
+       * save_all asks the server to flush its worlds before the directory is packed.
+       * </pre>
+       *
+       * <code>bool save_all = 4;</code>
+       * @param value The saveAll to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSaveAll(boolean value) {
+
+        saveAll_ = value;
+        bitField0_ |= 0x00000008;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * save_all asks the server to flush its worlds before the directory is packed.
+       * </pre>
+       *
+       * <code>bool save_all = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSaveAll() {
+        bitField0_ = (bitField0_ & ~0x00000008);
+        saveAll_ = false;
+        onChanged();
+        return this;
+      }
+
+      // @@protoc_insertion_point(builder_scope:protocol.PacketSaveService)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketAttachScreen)
-    private static final eu.novusmc.athena.common.Protocol.PacketAttachScreen DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketSaveService)
+    private static final eu.novusmc.athena.common.Protocol.PacketSaveService DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketAttachScreen();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketSaveService();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketAttachScreen getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketSaveService getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketAttachScreen>
-        PARSER = new com.google.protobuf.AbstractParser<PacketAttachScreen>() {
+    private static final com.google.protobuf.Parser<PacketSaveService>
+        PARSER = new com.google.protobuf.AbstractParser<PacketSaveService>() {
       @java.lang.Override
-      public PacketAttachScreen parsePartialFrom(
+      public PacketSaveService parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -11034,45 +22595,80 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketAttachScreen> parser() {
+    public static com.google.protobuf.Parser<PacketSaveService> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketAttachScreen> getParserForType() {
+    public com.google.protobuf.Parser<PacketSaveService> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketAttachScreen getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketSaveService getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketDetachScreenOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketDetachScreen)
+  public interface PacketServiceSaveChunkOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketServiceSaveChunk)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>uint64 save_id = 1;</code>
+     * @return The saveId.
      */
-    java.lang.String getServiceName();
+    long getSaveId();
+
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>bytes data = 2;</code>
+     * @return The data.
+     */
+    com.google.protobuf.ByteString getData();
+
+    /**
+     * <pre>
+     * last is set on the final chunk of a save.
+     * </pre>
+     *
+     * <code>bool last = 3;</code>
+     * @return The last.
+     */
+    boolean getLast();
+
+    /**
+     * <pre>
+     * error is set on the final chunk if the slave failed to pack the directory.
+     * </pre>
+     *
+     * <code>string error = 4;</code>
+     * @return The error.
+     */
+    java.lang.String getError();
+    /**
+     * <pre>
+     * error is set on the final chunk if the slave failed to pack the directory.
+     * </pre>
+     *
+     * <code>string error = 4;</code>
+     * @return The bytes for error.
      */
     com.google.protobuf.ByteString
-        getServiceNameBytes();
+        getErrorBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketDetachScreen}
+   * <pre>
+   * PacketServiceSaveChunk carries a part of the gzipped tar archive of a saved
+   * service directory.
+   * </pre>
+   *
+   * Protobuf type {@code protocol.PacketServiceSaveChunk}
    */
-  public static final class PacketDetachScreen extends
+  public static final class PacketServiceSaveChunk extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketDetachScreen)
-      PacketDetachScreenOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketServiceSaveChunk)
+      PacketServiceSaveChunkOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -11081,62 +22677,108 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketDetachScreen.class.getName());
+        PacketServiceSaveChunk.class.getName());
     }
-    // Use PacketDetachScreen.newBuilder() to construct.
-    private PacketDetachScreen(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketServiceSaveChunk.newBuilder() to construct.
+    private PacketServiceSaveChunk(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketDetachScreen() {
-      serviceName_ = "";
+    private PacketServiceSaveChunk() {
+      data_ = com.google.protobuf.ByteString.EMPTY;
+      error_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceSaveChunk_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceSaveChunk_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk.class, eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk.Builder.class);
+    }
+
+    public static final int SAVE_ID_FIELD_NUMBER = 1;
+    private long saveId_ = 0L;
+    /**
+     * <code>uint64 save_id = 1;</code>
+     * @return The saveId.
+     */
+    @java.lang.Override
+    public long getSaveId() {
+      return saveId_;
+    }
+
+    public static final int DATA_FIELD_NUMBER = 2;
+    private com.google.protobuf.ByteString data_ = com.google.protobuf.ByteString.EMPTY;
+    /**
+     * <code>bytes data = 2;</code>
+     * @return The data.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getData() {
+      return data_;
     }
 
+    public static final int LAST_FIELD_NUMBER = 3;
+    private boolean last_ = false;
+    /**
+     * <pre>
+     * last is set on the final chunk of a save.
+     * </pre>
+     *
+     * <code>bool last = 3;</code>
+     * @return The last.
+     */
     @java.lang.Override
-    protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketDetachScreen.class, eu.novusmc.athena.common.Protocol.PacketDetachScreen.Builder.class);
+    public boolean getLast() {
+      return last_;
     }
 
-    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    public static final int ERROR_FIELD_NUMBER = 4;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object serviceName_ = "";
+    private volatile java.lang.Object error_ = "";
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <pre>
+     * error is set on the final chunk if the slave failed to pack the directory.
+     * </pre>
+     *
+     * <code>string error = 4;</code>
+     * @return The error.
      */
     @java.lang.Override
-    public java.lang.String getServiceName() {
-      java.lang.Object ref = serviceName_;
+    public java.lang.String getError() {
+      java.lang.Object ref = error_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        serviceName_ = s;
+        error_ = s;
         return s;
       }
     }
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <pre>
+     * error is set on the final chunk if the slave failed to pack the directory.
+     * </pre>
+     *
+     * <code>string error = 4;</code>
+     * @return The bytes for error.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getServiceNameBytes() {
-      java.lang.Object ref = serviceName_;
+        getErrorBytes() {
+      java.lang.Object ref = error_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        serviceName_ = b;
+        error_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
@@ -11157,8 +22799,17 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      if (saveId_ != 0L) {
+        output.writeUInt64(1, saveId_);
+      }
+      if (!data_.isEmpty()) {
+        output.writeBytes(2, data_);
+      }
+      if (last_ != false) {
+        output.writeBool(3, last_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(error_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 4, error_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -11169,8 +22820,20 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      if (saveId_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(1, saveId_);
+      }
+      if (!data_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(2, data_);
+      }
+      if (last_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(3, last_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(error_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(4, error_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -11182,13 +22845,19 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketDetachScreen)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketDetachScreen other = (eu.novusmc.athena.common.Protocol.PacketDetachScreen) obj;
+      eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk other = (eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk) obj;
 
-      if (!getServiceName()
-          .equals(other.getServiceName())) return false;
+      if (getSaveId()
+          != other.getSaveId()) return false;
+      if (!getData()
+          .equals(other.getData())) return false;
+      if (getLast()
+          != other.getLast()) return false;
+      if (!getError()
+          .equals(other.getError())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -11200,51 +22869,59 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getServiceName().hashCode();
+      hash = (37 * hash) + SAVE_ID_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getSaveId());
+      hash = (37 * hash) + DATA_FIELD_NUMBER;
+      hash = (53 * hash) + getData().hashCode();
+      hash = (37 * hash) + LAST_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getLast());
+      hash = (37 * hash) + ERROR_FIELD_NUMBER;
+      hash = (53 * hash) + getError().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -11252,26 +22929,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -11284,7 +22961,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketDetachScreen prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -11300,26 +22977,31 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketDetachScreen}
+     * <pre>
+     * PacketServiceSaveChunk carries a part of the gzipped tar archive of a saved
+     * service directory.
+     * </pre>
+     *
+     * Protobuf type {@code protocol.PacketServiceSaveChunk}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketDetachScreen)
-        eu.novusmc.athena.common.Protocol.PacketDetachScreenOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketServiceSaveChunk)
+        eu.novusmc.athena.common.Protocol.PacketServiceSaveChunkOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceSaveChunk_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceSaveChunk_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketDetachScreen.class, eu.novusmc.athena.common.Protocol.PacketDetachScreen.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk.class, eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketDetachScreen.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk.newBuilder()
       private Builder() {
 
       }
@@ -11333,24 +23015,27 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        serviceName_ = "";
+        saveId_ = 0L;
+        data_ = com.google.protobuf.ByteString.EMPTY;
+        last_ = false;
+        error_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketDetachScreen_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketServiceSaveChunk_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketDetachScreen getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketDetachScreen.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketDetachScreen build() {
-        eu.novusmc.athena.common.Protocol.PacketDetachScreen result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk build() {
+        eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -11358,35 +23043,53 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketDetachScreen buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketDetachScreen result = new eu.novusmc.athena.common.Protocol.PacketDetachScreen(this);
+      public eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk result = new eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketDetachScreen result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.serviceName_ = serviceName_;
+          result.saveId_ = saveId_;
+        }
+        if (((from_bitField0_ & 0x00000002) != 0)) {
+          result.data_ = data_;
+        }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          result.last_ = last_;
+        }
+        if (((from_bitField0_ & 0x00000008) != 0)) {
+          result.error_ = error_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketDetachScreen) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketDetachScreen)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketDetachScreen other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketDetachScreen.getDefaultInstance()) return this;
-        if (!other.getServiceName().isEmpty()) {
-          serviceName_ = other.serviceName_;
-          bitField0_ |= 0x00000001;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk.getDefaultInstance()) return this;
+        if (other.getSaveId() != 0L) {
+          setSaveId(other.getSaveId());
+        }
+        if (other.getData() != com.google.protobuf.ByteString.EMPTY) {
+          setData(other.getData());
+        }
+        if (other.getLast() != false) {
+          setLast(other.getLast());
+        }
+        if (!other.getError().isEmpty()) {
+          error_ = other.error_;
+          bitField0_ |= 0x00000008;
           onChanged();
         }
         this.mergeUnknownFields(other.getUnknownFields());
@@ -11415,11 +23118,26 @@ public final class Protocol {
               case 0:
                 done = true;
                 break;
-              case 10: {
-                serviceName_ = input.readStringRequireUtf8();
+              case 8: {
+                saveId_ = input.readUInt64();
                 bitField0_ |= 0x00000001;
                 break;
-              } // case 10
+              } // case 8
+              case 18: {
+                data_ = input.readBytes();
+                bitField0_ |= 0x00000002;
+                break;
+              } // case 18
+              case 24: {
+                last_ = input.readBool();
+                bitField0_ |= 0x00000004;
+                break;
+              } // case 24
+              case 34: {
+                error_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000008;
+                break;
+              } // case 34
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -11437,95 +23155,223 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object serviceName_ = "";
+      private long saveId_ ;
       /**
-       * <code>string service_name = 1;</code>
-       * @return The serviceName.
+       * <code>uint64 save_id = 1;</code>
+       * @return The saveId.
        */
-      public java.lang.String getServiceName() {
-        java.lang.Object ref = serviceName_;
+      @java.lang.Override
+      public long getSaveId() {
+        return saveId_;
+      }
+      /**
+       * <code>uint64 save_id = 1;</code>
+       * @param value The saveId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSaveId(long value) {
+
+        saveId_ = value;
+        bitField0_ |= 0x00000001;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint64 save_id = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSaveId() {
+        bitField0_ = (bitField0_ & ~0x00000001);
+        saveId_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.ByteString data_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes data = 2;</code>
+       * @return The data.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getData() {
+        return data_;
+      }
+      /**
+       * <code>bytes data = 2;</code>
+       * @param value The data to set.
+       * @return This builder for chaining.
+       */
+      public Builder setData(com.google.protobuf.ByteString value) {
+        if (value == null) { throw new NullPointerException(); }
+        data_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes data = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearData() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        data_ = getDefaultInstance().getData();
+        onChanged();
+        return this;
+      }
+
+      private boolean last_ ;
+      /**
+       * <pre>
+       * last is set on the final chunk of a save.
+       * </pre>
+       *
+       * <code>bool last = 3;</code>
+       * @return The last.
+       */
+      @java.lang.Override
+      public boolean getLast() {
+        return last_;
+      }
+      /**
+       * <pre>
+       * last is set on the final chunk of a save.
+       * </pre>
+       *
+       * <code>bool last = 3;</code>
+       * @param value The last to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLast(boolean value) {
+
+        last_ = value;
+        bitField0_ |= 0x00000004;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * last is set on the final chunk of a save.
+       * </pre>
+       *
+       * <code>bool last = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLast() {
+        bitField0_ = (bitField0_ & ~0x00000004);
+        last_ = false;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object error_ = "";
+      /**
+       * <pre>
+       * error is set on the final chunk if the slave failed to pack the directory.
+       * </pre>
+       *
+       * <code>string error = 4;</code>
+       * @return The error.
+       */
+      public java.lang.String getError() {
+        java.lang.Object ref = error_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          serviceName_ = s;
+          error_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @return The bytes for serviceName.
+       * <pre>
+       * error is set on the final chunk if the slave failed to pack the directory.
+       * </pre>
+       *
+       * <code>string error = 4;</code>
+       * @return The bytes for error.
        */
       public com.google.protobuf.ByteString
-          getServiceNameBytes() {
-        java.lang.Object ref = serviceName_;
+          getErrorBytes() {
+        java.lang.Object ref = error_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          serviceName_ = b;
+          error_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The serviceName to set.
+       * <pre>
+       * error is set on the final chunk if the slave failed to pack the directory.
+       * </pre>
+       *
+       * <code>string error = 4;</code>
+       * @param value The error to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceName(
+      public Builder setError(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        serviceName_ = value;
-        bitField0_ |= 0x00000001;
+        error_ = value;
+        bitField0_ |= 0x00000008;
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <pre>
+       * error is set on the final chunk if the slave failed to pack the directory.
+       * </pre>
+       *
+       * <code>string error = 4;</code>
        * @return This builder for chaining.
        */
-      public Builder clearServiceName() {
-        serviceName_ = getDefaultInstance().getServiceName();
-        bitField0_ = (bitField0_ & ~0x00000001);
+      public Builder clearError() {
+        error_ = getDefaultInstance().getError();
+        bitField0_ = (bitField0_ & ~0x00000008);
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The bytes for serviceName to set.
+       * <pre>
+       * error is set on the final chunk if the slave failed to pack the directory.
+       * </pre>
+       *
+       * <code>string error = 4;</code>
+       * @param value The bytes for error to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceNameBytes(
+      public Builder setErrorBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        serviceName_ = value;
-        bitField0_ |= 0x00000001;
+        error_ = value;
+        bitField0_ |= 0x00000008;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketDetachScreen)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketServiceSaveChunk)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketDetachScreen)
-    private static final eu.novusmc.athena.common.Protocol.PacketDetachScreen DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketServiceSaveChunk)
+    private static final eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketDetachScreen();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketDetachScreen getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketDetachScreen>
-        PARSER = new com.google.protobuf.AbstractParser<PacketDetachScreen>() {
+    private static final com.google.protobuf.Parser<PacketServiceSaveChunk>
+        PARSER = new com.google.protobuf.AbstractParser<PacketServiceSaveChunk>() {
       @java.lang.Override
-      public PacketDetachScreen parsePartialFrom(
+      public PacketServiceSaveChunk parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -11544,57 +23390,68 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketDetachScreen> parser() {
+    public static com.google.protobuf.Parser<PacketServiceSaveChunk> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketDetachScreen> getParserForType() {
+    public com.google.protobuf.Parser<PacketServiceSaveChunk> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketDetachScreen getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketServiceSaveChunk getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface PacketExecuteServiceCommandOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:protocol.PacketExecuteServiceCommand)
+  public interface PacketTemplateChangedOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:protocol.PacketTemplateChanged)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>string template = 1;</code>
+     * @return The template.
      */
-    java.lang.String getServiceName();
+    java.lang.String getTemplate();
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>string template = 1;</code>
+     * @return The bytes for template.
      */
     com.google.protobuf.ByteString
-        getServiceNameBytes();
+        getTemplateBytes();
 
     /**
-     * <code>string command = 2;</code>
-     * @return The command.
+     * <code>int32 version = 2;</code>
+     * @return The version.
      */
-    java.lang.String getCommand();
+    int getVersion();
+
     /**
-     * <code>string command = 2;</code>
-     * @return The bytes for command.
+     * <code>string hash = 3;</code>
+     * @return The hash.
+     */
+    java.lang.String getHash();
+    /**
+     * <code>string hash = 3;</code>
+     * @return The bytes for hash.
      */
     com.google.protobuf.ByteString
-        getCommandBytes();
+        getHashBytes();
   }
   /**
-   * Protobuf type {@code protocol.PacketExecuteServiceCommand}
+   * <pre>
+   * PacketTemplateChanged notifies slaves that a new version of a template was
+   * created.
+   * </pre>
+   *
+   * Protobuf type {@code protocol.PacketTemplateChanged}
    */
-  public static final class PacketExecuteServiceCommand extends
+  public static final class PacketTemplateChanged extends
       com.google.protobuf.GeneratedMessage implements
-      // @@protoc_insertion_point(message_implements:protocol.PacketExecuteServiceCommand)
-      PacketExecuteServiceCommandOrBuilder {
+      // @@protoc_insertion_point(message_implements:protocol.PacketTemplateChanged)
+      PacketTemplateChangedOrBuilder {
   private static final long serialVersionUID = 0L;
     static {
       com.google.protobuf.RuntimeVersion.validateProtobufGencodeVersion(
@@ -11603,102 +23460,113 @@ public final class Protocol {
         /* minor= */ 29,
         /* patch= */ 1,
         /* suffix= */ "",
-        PacketExecuteServiceCommand.class.getName());
+        PacketTemplateChanged.class.getName());
     }
-    // Use PacketExecuteServiceCommand.newBuilder() to construct.
-    private PacketExecuteServiceCommand(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
+    // Use PacketTemplateChanged.newBuilder() to construct.
+    private PacketTemplateChanged(com.google.protobuf.GeneratedMessage.Builder<?> builder) {
       super(builder);
     }
-    private PacketExecuteServiceCommand() {
-      serviceName_ = "";
-      command_ = "";
+    private PacketTemplateChanged() {
+      template_ = "";
+      hash_ = "";
     }
 
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_descriptor;
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketTemplateChanged_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_fieldAccessorTable
+      return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketTemplateChanged_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.class, eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.Builder.class);
+              eu.novusmc.athena.common.Protocol.PacketTemplateChanged.class, eu.novusmc.athena.common.Protocol.PacketTemplateChanged.Builder.class);
     }
 
-    public static final int SERVICE_NAME_FIELD_NUMBER = 1;
+    public static final int TEMPLATE_FIELD_NUMBER = 1;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object serviceName_ = "";
+    private volatile java.lang.Object template_ = "";
     /**
-     * <code>string service_name = 1;</code>
-     * @return The serviceName.
+     * <code>string template = 1;</code>
+     * @return The template.
      */
     @java.lang.Override
-    public java.lang.String getServiceName() {
-      java.lang.Object ref = serviceName_;
+    public java.lang.String getTemplate() {
+      java.lang.Object ref = template_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        serviceName_ = s;
+        template_ = s;
         return s;
       }
     }
     /**
-     * <code>string service_name = 1;</code>
-     * @return The bytes for serviceName.
+     * <code>string template = 1;</code>
+     * @return The bytes for template.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getServiceNameBytes() {
-      java.lang.Object ref = serviceName_;
+        getTemplateBytes() {
+      java.lang.Object ref = template_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        serviceName_ = b;
+        template_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
       }
     }
 
-    public static final int COMMAND_FIELD_NUMBER = 2;
+    public static final int VERSION_FIELD_NUMBER = 2;
+    private int version_ = 0;
+    /**
+     * <code>int32 version = 2;</code>
+     * @return The version.
+     */
+    @java.lang.Override
+    public int getVersion() {
+      return version_;
+    }
+
+    public static final int HASH_FIELD_NUMBER = 3;
     @SuppressWarnings("serial")
-    private volatile java.lang.Object command_ = "";
+    private volatile java.lang.Object hash_ = "";
     /**
-     * <code>string command = 2;</code>
-     * @return The command.
+     * <code>string hash = 3;</code>
+     * @return The hash.
      */
     @java.lang.Override
-    public java.lang.String getCommand() {
-      java.lang.Object ref = command_;
+    public java.lang.String getHash() {
+      java.lang.Object ref = hash_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        command_ = s;
+        hash_ = s;
         return s;
       }
     }
     /**
-     * <code>string command = 2;</code>
-     * @return The bytes for command.
+     * <code>string hash = 3;</code>
+     * @return The bytes for hash.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getCommandBytes() {
-      java.lang.Object ref = command_;
+        getHashBytes() {
+      java.lang.Object ref = hash_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        command_ = b;
+        hash_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
@@ -11719,11 +23587,14 @@ public final class Protocol {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 1, serviceName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(template_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 1, template_);
       }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(command_)) {
-        com.google.protobuf.GeneratedMessage.writeString(output, 2, command_);
+      if (version_ != 0) {
+        output.writeInt32(2, version_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(hash_)) {
+        com.google.protobuf.GeneratedMessage.writeString(output, 3, hash_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -11734,11 +23605,15 @@ public final class Protocol {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(serviceName_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, serviceName_);
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(template_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(1, template_);
       }
-      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(command_)) {
-        size += com.google.protobuf.GeneratedMessage.computeStringSize(2, command_);
+      if (version_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(2, version_);
+      }
+      if (!com.google.protobuf.GeneratedMessage.isStringEmpty(hash_)) {
+        size += com.google.protobuf.GeneratedMessage.computeStringSize(3, hash_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -11750,15 +23625,17 @@ public final class Protocol {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand)) {
+      if (!(obj instanceof eu.novusmc.athena.common.Protocol.PacketTemplateChanged)) {
         return super.equals(obj);
       }
-      eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand other = (eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand) obj;
+      eu.novusmc.athena.common.Protocol.PacketTemplateChanged other = (eu.novusmc.athena.common.Protocol.PacketTemplateChanged) obj;
 
-      if (!getServiceName()
-          .equals(other.getServiceName())) return false;
-      if (!getCommand()
-          .equals(other.getCommand())) return false;
+      if (!getTemplate()
+          .equals(other.getTemplate())) return false;
+      if (getVersion()
+          != other.getVersion()) return false;
+      if (!getHash()
+          .equals(other.getHash())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -11770,53 +23647,55 @@ public final class Protocol {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + SERVICE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getServiceName().hashCode();
-      hash = (37 * hash) + COMMAND_FIELD_NUMBER;
-      hash = (53 * hash) + getCommand().hashCode();
+      hash = (37 * hash) + TEMPLATE_FIELD_NUMBER;
+      hash = (53 * hash) + getTemplate().hashCode();
+      hash = (37 * hash) + VERSION_FIELD_NUMBER;
+      hash = (53 * hash) + getVersion();
+      hash = (37 * hash) + HASH_FIELD_NUMBER;
+      hash = (53 * hash) + getHash().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(byte[] data)
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -11824,26 +23703,26 @@ public final class Protocol {
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseDelimitedFrom(java.io.InputStream input)
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input);
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseDelimitedFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessage
           .parseWithIOException(PARSER, input);
     }
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand parseFrom(
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -11856,7 +23735,7 @@ public final class Protocol {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand prototype) {
+    public static Builder newBuilder(eu.novusmc.athena.common.Protocol.PacketTemplateChanged prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -11872,26 +23751,31 @@ public final class Protocol {
       return builder;
     }
     /**
-     * Protobuf type {@code protocol.PacketExecuteServiceCommand}
+     * <pre>
+     * PacketTemplateChanged notifies slaves that a new version of a template was
+     * created.
+     * </pre>
+     *
+     * Protobuf type {@code protocol.PacketTemplateChanged}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessage.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:protocol.PacketExecuteServiceCommand)
-        eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommandOrBuilder {
+        // @@protoc_insertion_point(builder_implements:protocol.PacketTemplateChanged)
+        eu.novusmc.athena.common.Protocol.PacketTemplateChangedOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketTemplateChanged_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessage.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_fieldAccessorTable
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketTemplateChanged_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.class, eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.Builder.class);
+                eu.novusmc.athena.common.Protocol.PacketTemplateChanged.class, eu.novusmc.athena.common.Protocol.PacketTemplateChanged.Builder.class);
       }
 
-      // Construct using eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.newBuilder()
+      // Construct using eu.novusmc.athena.common.Protocol.PacketTemplateChanged.newBuilder()
       private Builder() {
 
       }
@@ -11905,25 +23789,26 @@ public final class Protocol {
       public Builder clear() {
         super.clear();
         bitField0_ = 0;
-        serviceName_ = "";
-        command_ = "";
+        template_ = "";
+        version_ = 0;
+        hash_ = "";
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketExecuteServiceCommand_descriptor;
+        return eu.novusmc.athena.common.Protocol.internal_static_protocol_PacketTemplateChanged_descriptor;
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand getDefaultInstanceForType() {
-        return eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.getDefaultInstance();
+      public eu.novusmc.athena.common.Protocol.PacketTemplateChanged getDefaultInstanceForType() {
+        return eu.novusmc.athena.common.Protocol.PacketTemplateChanged.getDefaultInstance();
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand build() {
-        eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand result = buildPartial();
+      public eu.novusmc.athena.common.Protocol.PacketTemplateChanged build() {
+        eu.novusmc.athena.common.Protocol.PacketTemplateChanged result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -11931,43 +23816,49 @@ public final class Protocol {
       }
 
       @java.lang.Override
-      public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand buildPartial() {
-        eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand result = new eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand(this);
+      public eu.novusmc.athena.common.Protocol.PacketTemplateChanged buildPartial() {
+        eu.novusmc.athena.common.Protocol.PacketTemplateChanged result = new eu.novusmc.athena.common.Protocol.PacketTemplateChanged(this);
         if (bitField0_ != 0) { buildPartial0(result); }
         onBuilt();
         return result;
       }
 
-      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand result) {
+      private void buildPartial0(eu.novusmc.athena.common.Protocol.PacketTemplateChanged result) {
         int from_bitField0_ = bitField0_;
         if (((from_bitField0_ & 0x00000001) != 0)) {
-          result.serviceName_ = serviceName_;
+          result.template_ = template_;
         }
         if (((from_bitField0_ & 0x00000002) != 0)) {
-          result.command_ = command_;
+          result.version_ = version_;
+        }
+        if (((from_bitField0_ & 0x00000004) != 0)) {
+          result.hash_ = hash_;
         }
       }
 
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand) {
-          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand)other);
+        if (other instanceof eu.novusmc.athena.common.Protocol.PacketTemplateChanged) {
+          return mergeFrom((eu.novusmc.athena.common.Protocol.PacketTemplateChanged)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand other) {
-        if (other == eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand.getDefaultInstance()) return this;
-        if (!other.getServiceName().isEmpty()) {
-          serviceName_ = other.serviceName_;
+      public Builder mergeFrom(eu.novusmc.athena.common.Protocol.PacketTemplateChanged other) {
+        if (other == eu.novusmc.athena.common.Protocol.PacketTemplateChanged.getDefaultInstance()) return this;
+        if (!other.getTemplate().isEmpty()) {
+          template_ = other.template_;
           bitField0_ |= 0x00000001;
           onChanged();
         }
-        if (!other.getCommand().isEmpty()) {
-          command_ = other.command_;
-          bitField0_ |= 0x00000002;
+        if (other.getVersion() != 0) {
+          setVersion(other.getVersion());
+        }
+        if (!other.getHash().isEmpty()) {
+          hash_ = other.hash_;
+          bitField0_ |= 0x00000004;
           onChanged();
         }
         this.mergeUnknownFields(other.getUnknownFields());
@@ -11997,15 +23888,20 @@ public final class Protocol {
                 done = true;
                 break;
               case 10: {
-                serviceName_ = input.readStringRequireUtf8();
+                template_ = input.readStringRequireUtf8();
                 bitField0_ |= 0x00000001;
                 break;
               } // case 10
-              case 18: {
-                command_ = input.readStringRequireUtf8();
+              case 16: {
+                version_ = input.readInt32();
                 bitField0_ |= 0x00000002;
                 break;
-              } // case 18
+              } // case 16
+              case 26: {
+                hash_ = input.readStringRequireUtf8();
+                bitField0_ |= 0x00000004;
+                break;
+              } // case 26
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -12023,167 +23919,199 @@ public final class Protocol {
       }
       private int bitField0_;
 
-      private java.lang.Object serviceName_ = "";
+      private java.lang.Object template_ = "";
       /**
-       * <code>string service_name = 1;</code>
-       * @return The serviceName.
+       * <code>string template = 1;</code>
+       * @return The template.
        */
-      public java.lang.String getServiceName() {
-        java.lang.Object ref = serviceName_;
+      public java.lang.String getTemplate() {
+        java.lang.Object ref = template_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          serviceName_ = s;
+          template_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @return The bytes for serviceName.
+       * <code>string template = 1;</code>
+       * @return The bytes for template.
        */
       public com.google.protobuf.ByteString
-          getServiceNameBytes() {
-        java.lang.Object ref = serviceName_;
+          getTemplateBytes() {
+        java.lang.Object ref = template_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          serviceName_ = b;
+          template_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The serviceName to set.
+       * <code>string template = 1;</code>
+       * @param value The template to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceName(
+      public Builder setTemplate(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        serviceName_ = value;
+        template_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
+       * <code>string template = 1;</code>
        * @return This builder for chaining.
        */
-      public Builder clearServiceName() {
-        serviceName_ = getDefaultInstance().getServiceName();
+      public Builder clearTemplate() {
+        template_ = getDefaultInstance().getTemplate();
         bitField0_ = (bitField0_ & ~0x00000001);
         onChanged();
         return this;
       }
       /**
-       * <code>string service_name = 1;</code>
-       * @param value The bytes for serviceName to set.
+       * <code>string template = 1;</code>
+       * @param value The bytes for template to set.
        * @return This builder for chaining.
        */
-      public Builder setServiceNameBytes(
+      public Builder setTemplateBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        serviceName_ = value;
+        template_ = value;
         bitField0_ |= 0x00000001;
         onChanged();
         return this;
       }
 
-      private java.lang.Object command_ = "";
+      private int version_ ;
       /**
-       * <code>string command = 2;</code>
-       * @return The command.
+       * <code>int32 version = 2;</code>
+       * @return The version.
        */
-      public java.lang.String getCommand() {
-        java.lang.Object ref = command_;
+      @java.lang.Override
+      public int getVersion() {
+        return version_;
+      }
+      /**
+       * <code>int32 version = 2;</code>
+       * @param value The version to set.
+       * @return This builder for chaining.
+       */
+      public Builder setVersion(int value) {
+
+        version_ = value;
+        bitField0_ |= 0x00000002;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 version = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearVersion() {
+        bitField0_ = (bitField0_ & ~0x00000002);
+        version_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object hash_ = "";
+      /**
+       * <code>string hash = 3;</code>
+       * @return The hash.
+       */
+      public java.lang.String getHash() {
+        java.lang.Object ref = hash_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          command_ = s;
+          hash_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string command = 2;</code>
-       * @return The bytes for command.
+       * <code>string hash = 3;</code>
+       * @return The bytes for hash.
        */
       public com.google.protobuf.ByteString
-          getCommandBytes() {
-        java.lang.Object ref = command_;
+          getHashBytes() {
+        java.lang.Object ref = hash_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          command_ = b;
+          hash_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string command = 2;</code>
-       * @param value The command to set.
+       * <code>string hash = 3;</code>
+       * @param value The hash to set.
        * @return This builder for chaining.
        */
-      public Builder setCommand(
+      public Builder setHash(
           java.lang.String value) {
         if (value == null) { throw new NullPointerException(); }
-        command_ = value;
-        bitField0_ |= 0x00000002;
+        hash_ = value;
+        bitField0_ |= 0x00000004;
         onChanged();
         return this;
       }
       /**
-       * <code>string command = 2;</code>
+       * <code>string hash = 3;</code>
        * @return This builder for chaining.
        */
-      public Builder clearCommand() {
-        command_ = getDefaultInstance().getCommand();
-        bitField0_ = (bitField0_ & ~0x00000002);
+      public Builder clearHash() {
+        hash_ = getDefaultInstance().getHash();
+        bitField0_ = (bitField0_ & ~0x00000004);
         onChanged();
         return this;
       }
       /**
-       * <code>string command = 2;</code>
-       * @param value The bytes for command to set.
+       * <code>string hash = 3;</code>
+       * @param value The bytes for hash to set.
        * @return This builder for chaining.
        */
-      public Builder setCommandBytes(
+      public Builder setHashBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) { throw new NullPointerException(); }
         checkByteStringIsUtf8(value);
-        command_ = value;
-        bitField0_ |= 0x00000002;
+        hash_ = value;
+        bitField0_ |= 0x00000004;
         onChanged();
         return this;
       }
 
-      // @@protoc_insertion_point(builder_scope:protocol.PacketExecuteServiceCommand)
+      // @@protoc_insertion_point(builder_scope:protocol.PacketTemplateChanged)
     }
 
-    // @@protoc_insertion_point(class_scope:protocol.PacketExecuteServiceCommand)
-    private static final eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:protocol.PacketTemplateChanged)
+    private static final eu.novusmc.athena.common.Protocol.PacketTemplateChanged DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand();
+      DEFAULT_INSTANCE = new eu.novusmc.athena.common.Protocol.PacketTemplateChanged();
     }
 
-    public static eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand getDefaultInstance() {
+    public static eu.novusmc.athena.common.Protocol.PacketTemplateChanged getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<PacketExecuteServiceCommand>
-        PARSER = new com.google.protobuf.AbstractParser<PacketExecuteServiceCommand>() {
+    private static final com.google.protobuf.Parser<PacketTemplateChanged>
+        PARSER = new com.google.protobuf.AbstractParser<PacketTemplateChanged>() {
       @java.lang.Override
-      public PacketExecuteServiceCommand parsePartialFrom(
+      public PacketTemplateChanged parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -12202,17 +24130,17 @@ public final class Protocol {
       }
     };
 
-    public static com.google.protobuf.Parser<PacketExecuteServiceCommand> parser() {
+    public static com.google.protobuf.Parser<PacketTemplateChanged> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<PacketExecuteServiceCommand> getParserForType() {
+    public com.google.protobuf.Parser<PacketTemplateChanged> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public eu.novusmc.athena.common.Protocol.PacketExecuteServiceCommand getDefaultInstanceForType() {
+    public eu.novusmc.athena.common.Protocol.PacketTemplateChanged getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
@@ -12223,11 +24151,36 @@ public final class Protocol {
   private static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_protocol_Service_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_Service_TemplateHashesEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_Service_TemplateHashesEntry_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_protocol_Group_descriptor;
   private static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_protocol_Group_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_Group_RequiredLabelsEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_Group_RequiredLabelsEntry_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_Group_PreferredLabelsEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_Group_PreferredLabelsEntry_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_Group_TemplateVersionsEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_Group_TemplateVersionsEntry_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_WeightedTemplate_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_WeightedTemplate_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_protocol_Envelope_descriptor;
   private static final 
@@ -12243,6 +24196,11 @@ public final class Protocol {
   private static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_protocol_PacketAuthenticate_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketAuthenticate_LabelsEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketAuthenticate_LabelsEntry_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_protocol_PacketAuthSuccess_descriptor;
   private static final 
@@ -12253,6 +24211,21 @@ public final class Protocol {
   private static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_protocol_PacketAuthFailed_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketReply_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketReply_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketPing_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketPing_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketPong_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketPong_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_protocol_PacketScheduleServiceRequest_descriptor;
   private static final 
@@ -12273,6 +24246,21 @@ public final class Protocol {
   private static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_protocol_PacketServiceOnline_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketServicePlayerCount_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketServicePlayerCount_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketSlaveMemoryUsage_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketSlaveMemoryUsage_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketSlaveMemoryUsage_ServicesEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketSlaveMemoryUsage_ServicesEntry_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_protocol_PacketServiceConnect_descriptor;
   private static final 
@@ -12288,6 +24276,11 @@ public final class Protocol {
   private static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_protocol_PacketProxyRegisterServer_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketServiceDrain_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketServiceDrain_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_protocol_PacketProxyUnregisterServer_descriptor;
   private static final 
@@ -12313,6 +24306,21 @@ public final class Protocol {
   private static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_protocol_PacketExecuteServiceCommand_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketSaveService_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketSaveService_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketServiceSaveChunk_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketServiceSaveChunk_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_protocol_PacketTemplateChanged_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_protocol_PacketTemplateChanged_fieldAccessorTable;
 
   public static com.google.protobuf.Descriptors.FileDescriptor
       getDescriptor() {
@@ -12323,44 +24331,90 @@ public final class Protocol {
   static {
     java.lang.String[] descriptorData = {
       "\n\016protocol.proto\022\010protocol\032\031google/proto" +
-      "buf/any.proto\"\331\002\n\007Service\022\014\n\004name\030\001 \001(\t\022" +
+      "buf/any.proto\"\202\004\n\007Service\022\014\n\004name\030\001 \001(\t\022" +
       "$\n\004type\030\002 \001(\0162\026.protocol.Service.Type\022&\n" +
       "\005state\030\003 \001(\0162\027.protocol.Service.State\022\016\n" +
       "\006memory\030\004 \001(\005\022\014\n\004port\030\005 \001(\005\022\r\n\005group\030\006 \001" +
-      "(\t\022\r\n\005slave\030\007 \001(\t\"9\n\004Type\022\020\n\014TYPE_UNKNOW" +
-      "N\020\000\022\016\n\nTYPE_PROXY\020\001\022\017\n\013TYPE_SERVER\020\002\"{\n\005" +
-      "State\022\021\n\rSTATE_UNKNOWN\020\000\022\021\n\rSTATE_PENDIN" +
-      "G\020\001\022\023\n\017STATE_SCHEDULED\020\002\022\020\n\014STATE_ONLINE" +
-      "\020\003\022\022\n\016STATE_STOPPING\020\004\022\021\n\rSTATE_OFFLINE\020" +
-      "\005\"\213\001\n\005Group\022\014\n\004name\030\001 \001(\t\022$\n\004type\030\002 \001(\0162" +
-      "\026.protocol.Service.Type\022\024\n\014min_services\030" +
-      "\003 \001(\005\022\024\n\014max_services\030\004 \001(\005\022\016\n\006memory\030\005 " +
-      "\001(\005\022\022\n\nstart_port\030\006 \001(\005\"1\n\010Envelope\022%\n\007p" +
-      "ayload\030\001 \001(\0132\024.google.protobuf.Any\"N\n\017Se" +
-      "rviceEnvelope\022\024\n\014service_name\030\001 \001(\t\022%\n\007p" +
-      "ayload\030\002 \001(\0132\024.google.protobuf.Any\"L\n\022Pa" +
-      "cketAuthenticate\022\022\n\nslave_name\030\001 \001(\t\022\022\n\n" +
-      "secret_key\030\002 \001(\t\022\016\n\006memory\030\003 \001(\005\"\023\n\021Pack" +
-      "etAuthSuccess\"#\n\020PacketAuthFailed\022\017\n\007mes" +
-      "sage\030\001 \001(\t\"b\n\034PacketScheduleServiceReque" +
-      "st\022\"\n\007service\030\001 \001(\0132\021.protocol.Service\022\036" +
-      "\n\005group\030\002 \001(\0132\017.protocol.Group\"A\n\030Packet" +
-      "ServiceStartFailed\022\024\n\014service_name\030\001 \001(\t" +
-      "\022\017\n\007message\030\002 \001(\t\",\n\024PacketServiceStoppe" +
-      "d\022\024\n\014service_name\030\001 \001(\t\"9\n\023PacketService" +
-      "Online\022\024\n\014service_name\030\001 \001(\t\022\014\n\004port\030\002 \001" +
-      "(\005\"#\n\024PacketServiceConnect\022\013\n\003key\030\001 \001(\t\"" +
-      ")\n\021PacketStopService\022\024\n\014service_name\030\001 \001" +
-      "(\t\"L\n\031PacketProxyRegisterServer\022\023\n\013serve" +
-      "r_name\030\001 \001(\t\022\014\n\004host\030\002 \001(\t\022\014\n\004port\030\003 \001(\005" +
-      "\"2\n\033PacketProxyUnregisterServer\022\023\n\013serve" +
-      "r_name\030\001 \001(\t\" \n\020PacketScreenLine\022\014\n\004line" +
-      "\030\001 \001(\t\"*\n\022PacketAttachScreen\022\024\n\014service_" +
-      "name\030\001 \001(\t\"*\n\022PacketDetachScreen\022\024\n\014serv" +
-      "ice_name\030\001 \001(\t\"D\n\033PacketExecuteServiceCo" +
-      "mmand\022\024\n\014service_name\030\001 \001(\t\022\017\n\007command\030\002" +
-      " \001(\tB%\n\030eu.novusmc.athena.commonZ\tprotoc" +
-      "ol/b\006proto3"
+      "(\t\022\r\n\005slave\030\007 \001(\t\022\017\n\007players\030\010 \001(\005\022\021\n\tte" +
+      "mplates\030\t \003(\t\022>\n\017template_hashes\030\n \003(\0132%" +
+      ".protocol.Service.TemplateHashesEntry\022\014\n" +
+      "\004warm\030\013 \001(\010\0325\n\023TemplateHashesEntry\022\013\n\003ke" +
+      "y\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"9\n\004Type\022\020\n\014TY" +
+      "PE_UNKNOWN\020\000\022\016\n\nTYPE_PROXY\020\001\022\017\n\013TYPE_SER" +
+      "VER\020\002\"{\n\005State\022\021\n\rSTATE_UNKNOWN\020\000\022\021\n\rSTA" +
+      "TE_PENDING\020\001\022\023\n\017STATE_SCHEDULED\020\002\022\020\n\014STA" +
+      "TE_ONLINE\020\003\022\022\n\016STATE_STOPPING\020\004\022\021\n\rSTATE" +
+      "_OFFLINE\020\005\"\232\006\n\005Group\022\014\n\004name\030\001 \001(\t\022$\n\004ty" +
+      "pe\030\002 \001(\0162\026.protocol.Service.Type\022\024\n\014min_" +
+      "services\030\003 \001(\005\022\024\n\014max_services\030\004 \001(\005\022\016\n\006" +
+      "memory\030\005 \001(\005\022\022\n\nstart_port\030\006 \001(\005\022\023\n\013max_" +
+      "players\030\007 \001(\005\022\027\n\017scale_threshold\030\010 \001(\005\022\030" +
+      "\n\020scale_down_delay\030\t \001(\005\022\021\n\tplacement\030\n " +
+      "\001(\t\022\024\n\014pinned_slave\030\013 \001(\t\022<\n\017required_la" +
+      "bels\030\014 \003(\0132#.protocol.Group.RequiredLabe" +
+      "lsEntry\022>\n\020preferred_labels\030\r \003(\0132$.prot" +
+      "ocol.Group.PreferredLabelsEntry\022\025\n\ranti_" +
+      "affinity\030\016 \003(\t\022\016\n\006static\030\017 \001(\010\022\021\n\ttempla" +
+      "tes\030\020 \003(\t\0224\n\020random_templates\030\021 \003(\0132\032.pr" +
+      "otocol.WeightedTemplate\022@\n\021template_vers" +
+      "ions\030\022 \003(\0132%.protocol.Group.TemplateVers" +
+      "ionsEntry\022\025\n\rwarm_services\030\023 \001(\005\022\026\n\016fall" +
+      "back_group\030\024 \001(\t\022\025\n\rdrain_timeout\030\025 \001(\005\032" +
+      "5\n\023RequiredLabelsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005v" +
+      "alue\030\002 \001(\t:\0028\001\0326\n\024PreferredLabelsEntry\022\013" +
+      "\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\0327\n\025Templa" +
+      "teVersionsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 " +
+      "\001(\005:\0028\001\"0\n\020WeightedTemplate\022\014\n\004name\030\001 \001(" +
+      "\t\022\016\n\006weight\030\002 \001(\005\"E\n\010Envelope\022%\n\007payload" +
+      "\030\001 \001(\0132\024.google.protobuf.Any\022\022\n\nrequest_" +
+      "id\030\002 \001(\004\"N\n\017ServiceEnvelope\022\024\n\014service_n" +
+      "ame\030\001 \001(\t\022%\n\007payload\030\002 \001(\0132\024.google.prot" +
+      "obuf.Any\"\212\002\n\022PacketAuthenticate\022\022\n\nslave" +
+      "_name\030\001 \001(\t\022\022\n\nsecret_key\030\002 \001(\t\022\016\n\006memor" +
+      "y\030\003 \001(\005\0228\n\006labels\030\004 \003(\0132(.protocol.Packe" +
+      "tAuthenticate.LabelsEntry\022#\n\010services\030\005 " +
+      "\003(\0132\021.protocol.Service\022\030\n\020protocol_versi" +
+      "on\030\006 \001(\005\022\024\n\014capabilities\030\007 \003(\t\032-\n\013Labels" +
+      "Entry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"z\n" +
+      "\021PacketAuthSuccess\022\032\n\022heartbeat_interval" +
+      "\030\001 \001(\005\022\031\n\021heartbeat_timeout\030\002 \001(\005\022\030\n\020pro" +
+      "tocol_version\030\003 \001(\005\022\024\n\014capabilities\030\004 \003(" +
+      "\t\"#\n\020PacketAuthFailed\022\017\n\007message\030\001 \001(\t\"0" +
+      "\n\013PacketReply\022\022\n\nrequest_id\030\001 \001(\004\022\r\n\005err" +
+      "or\030\002 \001(\t\"\014\n\nPacketPing\"\014\n\nPacketPong\"b\n\034" +
+      "PacketScheduleServiceRequest\022\"\n\007service\030" +
+      "\001 \001(\0132\021.protocol.Service\022\036\n\005group\030\002 \001(\0132" +
+      "\017.protocol.Group\"A\n\030PacketServiceStartFa" +
+      "iled\022\024\n\014service_name\030\001 \001(\t\022\017\n\007message\030\002 " +
+      "\001(\t\",\n\024PacketServiceStopped\022\024\n\014service_n" +
+      "ame\030\001 \001(\t\"9\n\023PacketServiceOnline\022\024\n\014serv" +
+      "ice_name\030\001 \001(\t\022\014\n\004port\030\002 \001(\005\"A\n\030PacketSe" +
+      "rvicePlayerCount\022\024\n\014service_name\030\001 \001(\t\022\017" +
+      "\n\007players\030\002 \001(\005\"\240\001\n\026PacketSlaveMemoryUsa" +
+      "ge\022\023\n\013used_memory\030\001 \001(\005\022@\n\010services\030\002 \003(" +
+      "\0132..protocol.PacketSlaveMemoryUsage.Serv" +
+      "icesEntry\032/\n\rServicesEntry\022\013\n\003key\030\001 \001(\t\022" +
+      "\r\n\005value\030\002 \001(\005:\0028\001\"S\n\024PacketServiceConne" +
+      "ct\022\013\n\003key\030\001 \001(\t\022\030\n\020protocol_version\030\002 \001(" +
+      "\005\022\024\n\014capabilities\030\003 \003(\t\")\n\021PacketStopSer" +
+      "vice\022\024\n\014service_name\030\001 \001(\t\"L\n\031PacketProx" +
+      "yRegisterServer\022\023\n\013server_name\030\001 \001(\t\022\014\n\004" +
+      "host\030\002 \001(\t\022\014\n\004port\030\003 \001(\005\".\n\022PacketServic" +
+      "eDrain\022\030\n\020fallback_servers\030\001 \003(\t\"2\n\033Pack" +
+      "etProxyUnregisterServer\022\023\n\013server_name\030\001" +
+      " \001(\t\" \n\020PacketScreenLine\022\014\n\004line\030\001 \001(\t\"*" +
+      "\n\022PacketAttachScreen\022\024\n\014service_name\030\001 \001" +
+      "(\t\"*\n\022PacketDetachScreen\022\024\n\014service_name" +
+      "\030\001 \001(\t\"D\n\033PacketExecuteServiceCommand\022\024\n" +
+      "\014service_name\030\001 \001(\t\022\017\n\007command\030\002 \001(\t\"^\n\021" +
+      "PacketSaveService\022\017\n\007save_id\030\001 \001(\004\022\024\n\014se" +
+      "rvice_name\030\002 \001(\t\022\020\n\010excludes\030\003 \003(\t\022\020\n\010sa" +
+      "ve_all\030\004 \001(\010\"T\n\026PacketServiceSaveChunk\022\017" +
+      "\n\007save_id\030\001 \001(\004\022\014\n\004data\030\002 \001(\014\022\014\n\004last\030\003 " +
+      "\001(\010\022\r\n\005error\030\004 \001(\t\"H\n\025PacketTemplateChan" +
+      "ged\022\020\n\010template\030\001 \001(\t\022\017\n\007version\030\002 \001(\005\022\014" +
+      "\n\004hash\030\003 \001(\tB%\n\030eu.novusmc.athena.common" +
+      "Z\tprotocol/b\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -12372,115 +24426,211 @@ public final class Protocol {
     internal_static_protocol_Service_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_Service_descriptor,
-        new java.lang.String[] { "Name", "Type", "State", "Memory", "Port", "Group", "Slave", });
+        new java.lang.String[] { "Name", "Type", "State", "Memory", "Port", "Group", "Slave", "Players", "Templates", "TemplateHashes", "Warm", });
+    internal_static_protocol_Service_TemplateHashesEntry_descriptor =
+      internal_static_protocol_Service_descriptor.getNestedTypes().get(0);
+    internal_static_protocol_Service_TemplateHashesEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_Service_TemplateHashesEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
     internal_static_protocol_Group_descriptor =
       getDescriptor().getMessageTypes().get(1);
     internal_static_protocol_Group_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_Group_descriptor,
-        new java.lang.String[] { "Name", "Type", "MinServices", "MaxServices", "Memory", "StartPort", });
-    internal_static_protocol_Envelope_descriptor =
+        new java.lang.String[] { "Name", "Type", "MinServices", "MaxServices", "Memory", "StartPort", "MaxPlayers", "ScaleThreshold", "ScaleDownDelay", "Placement", "PinnedSlave", "RequiredLabels", "PreferredLabels", "AntiAffinity", "Static", "Templates", "RandomTemplates", "TemplateVersions", "WarmServices", "FallbackGroup", "DrainTimeout", });
+    internal_static_protocol_Group_RequiredLabelsEntry_descriptor =
+      internal_static_protocol_Group_descriptor.getNestedTypes().get(0);
+    internal_static_protocol_Group_RequiredLabelsEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_Group_RequiredLabelsEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
+    internal_static_protocol_Group_PreferredLabelsEntry_descriptor =
+      internal_static_protocol_Group_descriptor.getNestedTypes().get(1);
+    internal_static_protocol_Group_PreferredLabelsEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_Group_PreferredLabelsEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
+    internal_static_protocol_Group_TemplateVersionsEntry_descriptor =
+      internal_static_protocol_Group_descriptor.getNestedTypes().get(2);
+    internal_static_protocol_Group_TemplateVersionsEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_Group_TemplateVersionsEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
+    internal_static_protocol_WeightedTemplate_descriptor =
       getDescriptor().getMessageTypes().get(2);
+    internal_static_protocol_WeightedTemplate_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_WeightedTemplate_descriptor,
+        new java.lang.String[] { "Name", "Weight", });
+    internal_static_protocol_Envelope_descriptor =
+      getDescriptor().getMessageTypes().get(3);
     internal_static_protocol_Envelope_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_Envelope_descriptor,
-        new java.lang.String[] { "Payload", });
+        new java.lang.String[] { "Payload", "RequestId", });
     internal_static_protocol_ServiceEnvelope_descriptor =
-      getDescriptor().getMessageTypes().get(3);
+      getDescriptor().getMessageTypes().get(4);
     internal_static_protocol_ServiceEnvelope_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_ServiceEnvelope_descriptor,
         new java.lang.String[] { "ServiceName", "Payload", });
     internal_static_protocol_PacketAuthenticate_descriptor =
-      getDescriptor().getMessageTypes().get(4);
+      getDescriptor().getMessageTypes().get(5);
     internal_static_protocol_PacketAuthenticate_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketAuthenticate_descriptor,
-        new java.lang.String[] { "SlaveName", "SecretKey", "Memory", });
+        new java.lang.String[] { "SlaveName", "SecretKey", "Memory", "Labels", "Services", "ProtocolVersion", "Capabilities", });
+    internal_static_protocol_PacketAuthenticate_LabelsEntry_descriptor =
+      internal_static_protocol_PacketAuthenticate_descriptor.getNestedTypes().get(0);
+    internal_static_protocol_PacketAuthenticate_LabelsEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketAuthenticate_LabelsEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
     internal_static_protocol_PacketAuthSuccess_descriptor =
-      getDescriptor().getMessageTypes().get(5);
+      getDescriptor().getMessageTypes().get(6);
     internal_static_protocol_PacketAuthSuccess_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketAuthSuccess_descriptor,
-        new java.lang.String[] { });
+        new java.lang.String[] { "HeartbeatInterval", "HeartbeatTimeout", "ProtocolVersion", "Capabilities", });
     internal_static_protocol_PacketAuthFailed_descriptor =
-      getDescriptor().getMessageTypes().get(6);
+      getDescriptor().getMessageTypes().get(7);
     internal_static_protocol_PacketAuthFailed_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketAuthFailed_descriptor,
         new java.lang.String[] { "Message", });
+    internal_static_protocol_PacketReply_descriptor =
+      getDescriptor().getMessageTypes().get(8);
+    internal_static_protocol_PacketReply_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketReply_descriptor,
+        new java.lang.String[] { "RequestId", "Error", });
+    internal_static_protocol_PacketPing_descriptor =
+      getDescriptor().getMessageTypes().get(9);
+    internal_static_protocol_PacketPing_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketPing_descriptor,
+        new java.lang.String[] { });
+    internal_static_protocol_PacketPong_descriptor =
+      getDescriptor().getMessageTypes().get(10);
+    internal_static_protocol_PacketPong_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketPong_descriptor,
+        new java.lang.String[] { });
     internal_static_protocol_PacketScheduleServiceRequest_descriptor =
-      getDescriptor().getMessageTypes().get(7);
+      getDescriptor().getMessageTypes().get(11);
     internal_static_protocol_PacketScheduleServiceRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketScheduleServiceRequest_descriptor,
         new java.lang.String[] { "Service", "Group", });
     internal_static_protocol_PacketServiceStartFailed_descriptor =
-      getDescriptor().getMessageTypes().get(8);
+      getDescriptor().getMessageTypes().get(12);
     internal_static_protocol_PacketServiceStartFailed_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketServiceStartFailed_descriptor,
         new java.lang.String[] { "ServiceName", "Message", });
     internal_static_protocol_PacketServiceStopped_descriptor =
-      getDescriptor().getMessageTypes().get(9);
+      getDescriptor().getMessageTypes().get(13);
     internal_static_protocol_PacketServiceStopped_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketServiceStopped_descriptor,
         new java.lang.String[] { "ServiceName", });
     internal_static_protocol_PacketServiceOnline_descriptor =
-      getDescriptor().getMessageTypes().get(10);
+      getDescriptor().getMessageTypes().get(14);
     internal_static_protocol_PacketServiceOnline_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketServiceOnline_descriptor,
         new java.lang.String[] { "ServiceName", "Port", });
+    internal_static_protocol_PacketServicePlayerCount_descriptor =
+      getDescriptor().getMessageTypes().get(15);
+    internal_static_protocol_PacketServicePlayerCount_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketServicePlayerCount_descriptor,
+        new java.lang.String[] { "ServiceName", "Players", });
+    internal_static_protocol_PacketSlaveMemoryUsage_descriptor =
+      getDescriptor().getMessageTypes().get(16);
+    internal_static_protocol_PacketSlaveMemoryUsage_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketSlaveMemoryUsage_descriptor,
+        new java.lang.String[] { "UsedMemory", "Services", });
+    internal_static_protocol_PacketSlaveMemoryUsage_ServicesEntry_descriptor =
+      internal_static_protocol_PacketSlaveMemoryUsage_descriptor.getNestedTypes().get(0);
+    internal_static_protocol_PacketSlaveMemoryUsage_ServicesEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketSlaveMemoryUsage_ServicesEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
     internal_static_protocol_PacketServiceConnect_descriptor =
-      getDescriptor().getMessageTypes().get(11);
+      getDescriptor().getMessageTypes().get(17);
     internal_static_protocol_PacketServiceConnect_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketServiceConnect_descriptor,
-        new java.lang.String[] { "Key", });
+        new java.lang.String[] { "Key", "ProtocolVersion", "Capabilities", });
     internal_static_protocol_PacketStopService_descriptor =
-      getDescriptor().getMessageTypes().get(12);
+      getDescriptor().getMessageTypes().get(18);
     internal_static_protocol_PacketStopService_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketStopService_descriptor,
         new java.lang.String[] { "ServiceName", });
     internal_static_protocol_PacketProxyRegisterServer_descriptor =
-      getDescriptor().getMessageTypes().get(13);
+      getDescriptor().getMessageTypes().get(19);
     internal_static_protocol_PacketProxyRegisterServer_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketProxyRegisterServer_descriptor,
         new java.lang.String[] { "ServerName", "Host", "Port", });
+    internal_static_protocol_PacketServiceDrain_descriptor =
+      getDescriptor().getMessageTypes().get(20);
+    internal_static_protocol_PacketServiceDrain_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketServiceDrain_descriptor,
+        new java.lang.String[] { "FallbackServers", });
     internal_static_protocol_PacketProxyUnregisterServer_descriptor =
-      getDescriptor().getMessageTypes().get(14);
+      getDescriptor().getMessageTypes().get(21);
     internal_static_protocol_PacketProxyUnregisterServer_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketProxyUnregisterServer_descriptor,
         new java.lang.String[] { "ServerName", });
     internal_static_protocol_PacketScreenLine_descriptor =
-      getDescriptor().getMessageTypes().get(15);
+      getDescriptor().getMessageTypes().get(22);
     internal_static_protocol_PacketScreenLine_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketScreenLine_descriptor,
         new java.lang.String[] { "Line", });
     internal_static_protocol_PacketAttachScreen_descriptor =
-      getDescriptor().getMessageTypes().get(16);
+      getDescriptor().getMessageTypes().get(23);
     internal_static_protocol_PacketAttachScreen_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketAttachScreen_descriptor,
         new java.lang.String[] { "ServiceName", });
     internal_static_protocol_PacketDetachScreen_descriptor =
-      getDescriptor().getMessageTypes().get(17);
+      getDescriptor().getMessageTypes().get(24);
     internal_static_protocol_PacketDetachScreen_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketDetachScreen_descriptor,
         new java.lang.String[] { "ServiceName", });
     internal_static_protocol_PacketExecuteServiceCommand_descriptor =
-      getDescriptor().getMessageTypes().get(18);
+      getDescriptor().getMessageTypes().get(25);
     internal_static_protocol_PacketExecuteServiceCommand_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_protocol_PacketExecuteServiceCommand_descriptor,
         new java.lang.String[] { "ServiceName", "Command", });
+    internal_static_protocol_PacketSaveService_descriptor =
+      getDescriptor().getMessageTypes().get(26);
+    internal_static_protocol_PacketSaveService_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketSaveService_descriptor,
+        new java.lang.String[] { "SaveId", "ServiceName", "Excludes", "SaveAll", });
+    internal_static_protocol_PacketServiceSaveChunk_descriptor =
+      getDescriptor().getMessageTypes().get(27);
+    internal_static_protocol_PacketServiceSaveChunk_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketServiceSaveChunk_descriptor,
+        new java.lang.String[] { "SaveId", "Data", "Last", "Error", });
+    internal_static_protocol_PacketTemplateChanged_descriptor =
+      getDescriptor().getMessageTypes().get(28);
+    internal_static_protocol_PacketTemplateChanged_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_protocol_PacketTemplateChanged_descriptor,
+        new java.lang.String[] { "Template", "Version", "Hash", });
     descriptor.resolveAllFeaturesImmutable();
     com.google.protobuf.AnyProto.getDescriptor();
   }
@@ -4,4 +4,5 @@ data class Configuration(
     val slaveAddr: String = "127.0.0.1",
     val slavePort: Int = 3000,
     val key: String = "",
+    val heartbeatTimeout: Int = 0,
 )
@@ -3,12 +3,29 @@ package eu.novusmc.athena.common
 import com.google.protobuf.Any
 import com.google.protobuf.Message
 import java.io.EOFException
+import java.io.IOException
 import java.io.InputStream
 import java.io.OutputStream
 import java.nio.ByteBuffer
 import java.nio.ByteOrder
 
 object Packet {
+    /** Protocol version spoken by the plugins, see protocol.Version of the slave. */
+    const val PROTOCOL_VERSION = 1
+
+    val CAPABILITIES = listOf("heartbeat", "player-count", "service-drain")
+
+    /** Same as protocol.DefaultMaxFrameSize of the slave. */
+    private const val MAX_FRAME_SIZE = 16 shl 20
+
+    fun serviceConnect(key: String): Protocol.PacketServiceConnect =
+        Protocol.PacketServiceConnect.newBuilder()
+            .setKey(key)
+            .setProtocolVersion(PROTOCOL_VERSION)
+            .addAllCapabilities(CAPABILITIES)
+            .build()
+
+    @Synchronized
     fun sendPacket(out: OutputStream, packet: Message) {
         val payload = Any.pack(packet)
         Protocol.Envelope.newBuilder().setPayload(payload).build()
@@ -20,6 +37,9 @@ object Packet {
 
     fun readPacket(input: InputStream): Message? {
         val len = readBigEndian32(input)
+        if (len < 0 || len > MAX_FRAME_SIZE) {
+            throw IOException("Frame of $len bytes exceeds limit of $MAX_FRAME_SIZE bytes")
+        }
         val buf = readExactly(input, len)
         val env = Protocol.Envelope.parseFrom(buf)
         val typeUrl = env.payload.typeUrl
@@ -5,6 +5,8 @@ import de.pauhull.novus_utils.common.PluginConfig
 import eu.novusmc.athena.common.Configuration
 import eu.novusmc.athena.common.Packet
 import eu.novusmc.athena.common.Protocol
+import java.io.ByteArrayOutputStream
+import java.io.DataOutputStream
 import java.io.File
 import java.net.Socket
 import org.bukkit.plugin.java.JavaPlugin
@@ -42,10 +44,20 @@ class AthenaPaperPlugin : JavaPlugin() {
                 }
             logger.info("Connected to slave at ${cfg.slaveAddr}:${cfg.slavePort}")
 
+            if (cfg.heartbeatTimeout > 0) {
+                sock!!.soTimeout = cfg.heartbeatTimeout * 1000
+            }
+
+            server.messenger.registerOutgoingPluginChannel(this, PROXY_CHANNEL)
+
             val out = sock!!.getOutputStream()
-            Packet.sendPacket(
-                out,
-                Protocol.PacketServiceConnect.newBuilder().setKey(cfg.key).build(),
+            Packet.sendPacket(out, Packet.serviceConnect(cfg.key))
+
+            server.scheduler.runTaskTimerAsynchronously(
+                this,
+                { -> reportPlayerCount(server.onlinePlayers.size) },
+                PLAYER_COUNT_INTERVAL_TICKS,
+                PLAYER_COUNT_INTERVAL_TICKS,
             )
 
             server.scheduler.runTaskAsynchronously(
@@ -80,7 +92,59 @@ class AthenaPaperPlugin : JavaPlugin() {
         sock?.close()
     }
 
+    private fun reportPlayerCount(players: Int) {
+        val out = sock?.getOutputStream() ?: return
+        try {
+            Packet.sendPacket(
+                out,
+                Protocol.PacketServicePlayerCount.newBuilder().setPlayers(players).build(),
+            )
+        } catch (e: Exception) {
+            if (!shuttingDown) {
+                logger.warning("Failed to report player count: ${e.message}")
+            }
+        }
+    }
+
     private fun handlePacket(p: Message) {
-        logger.info("Received packet: ${p.javaClass.name}")
+        when (p) {
+            // answered on the main thread, so a hung server stops answering pings
+            is Protocol.PacketPing -> server.scheduler.runTask(this, { -> sendPong() })
+            is Protocol.PacketAuthFailed -> logger.severe("Slave rejected connection: ${p.message}")
+            is Protocol.PacketServiceDrain ->
+                server.scheduler.runTask(this, { -> movePlayers(p.fallbackServersList) })
+            else -> logger.info("Received packet: ${p.javaClass.name}")
+        }
+    }
+
+    /** Sends all players to the fallback servers in turn, the proxy connects them. */
+    private fun movePlayers(servers: List<String>) {
+        if (servers.isEmpty()) {
+            return
+        }
+        logger.info("Moving ${server.onlinePlayers.size} players to ${servers.joinToString()}")
+        server.onlinePlayers.forEachIndexed { i, player ->
+            val buf = ByteArrayOutputStream()
+            val data = DataOutputStream(buf)
+            data.writeUTF("Connect")
+            data.writeUTF(servers[i % servers.size])
+            player.sendPluginMessage(this, PROXY_CHANNEL, buf.toByteArray())
+        }
+    }
+
+    private fun sendPong() {
+        val out = sock?.getOutputStream() ?: return
+        try {
+            Packet.sendPacket(out, Protocol.PacketPong.getDefaultInstance())
+        } catch (e: Exception) {
+            if (!shuttingDown) {
+                logger.warning("Failed to send pong: ${e.message}")
+            }
+        }
+    }
+
+    companion object {
+        private const val PLAYER_COUNT_INTERVAL_TICKS = 100L
+        private const val PROXY_CHANNEL = "BungeeCord"
     }
 }
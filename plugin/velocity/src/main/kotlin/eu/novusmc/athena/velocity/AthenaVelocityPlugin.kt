@@ -18,6 +18,7 @@ import eu.novusmc.athena.common.Protocol
 import java.io.File
 import java.net.InetSocketAddress
 import java.net.Socket
+import java.util.concurrent.TimeUnit
 import org.slf4j.Logger
 
 @Plugin(
@@ -55,11 +56,17 @@ constructor(private val server: ProxyServer, private val logger: Logger) {
                 }
             logger.info("Connected to slave at ${cfg.slaveAddr}:${cfg.slavePort}")
 
+            if (cfg.heartbeatTimeout > 0) {
+                sock!!.soTimeout = cfg.heartbeatTimeout * 1000
+            }
+
             val out = sock!!.getOutputStream()
-            Packet.sendPacket(
-                out,
-                Protocol.PacketServiceConnect.newBuilder().setKey(cfg.key).build(),
-            )
+            Packet.sendPacket(out, Packet.serviceConnect(cfg.key))
+
+            server.scheduler
+                .buildTask(this, { -> reportPlayerCount(server.playerCount) })
+                .repeat(PLAYER_COUNT_INTERVAL_SECONDS, TimeUnit.SECONDS)
+                .schedule()
 
             server.scheduler
                 .buildTask(
@@ -101,8 +108,24 @@ constructor(private val server: ProxyServer, private val logger: Logger) {
         event.setInitialServer(server.allServers.firstOrNull())
     }
 
+    private fun reportPlayerCount(players: Int) {
+        val out = sock?.getOutputStream() ?: return
+        try {
+            Packet.sendPacket(
+                out,
+                Protocol.PacketServicePlayerCount.newBuilder().setPlayers(players).build(),
+            )
+        } catch (e: Exception) {
+            if (!shuttingDown) {
+                logger.warn("Failed to report player count: ${e.message}")
+            }
+        }
+    }
+
     private fun handlePacket(p: Message) {
         when (p) {
+            is Protocol.PacketPing -> sendPong()
+            is Protocol.PacketAuthFailed -> logger.error("Slave rejected connection: ${p.message}")
             is Protocol.PacketProxyRegisterServer -> {
                 logger.info("Registering server ${p.serverName} at ${p.host}:${p.port}")
                 server.registerServer(
@@ -118,4 +141,13 @@ constructor(private val server: ProxyServer, private val logger: Logger) {
             }
         }
     }
+
+    private fun sendPong() {
+        val out = sock?.getOutputStream() ?: return
+        Packet.sendPacket(out, Protocol.PacketPong.getDefaultInstance())
+    }
+
+    companion object {
+        private const val PLAYER_COUNT_INTERVAL_SECONDS = 5L
+    }
 }
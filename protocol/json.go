@@ -0,0 +1,39 @@
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func (s *Service_Type) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("cannot unmarshal service type: %w", err)
+	}
+	if val, ok := Service_Type_value[strings.ToUpper("TYPE_"+str)]; ok {
+		*s = Service_Type(val)
+		return nil
+	}
+	return fmt.Errorf("invalid service type: %s", str)
+}
+
+func (s Service_Type) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.ToLower(strings.TrimPrefix(s.String(), "TYPE_")))
+}
+
+func (s *Service_State) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("cannot unmarshal service state: %w", err)
+	}
+	if val, ok := Service_State_value[strings.ToUpper("STATE_"+str)]; ok {
+		*s = Service_State(val)
+		return nil
+	}
+	return fmt.Errorf("invalid service state: %s", str)
+}
+
+func (s Service_State) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.ToLower(strings.TrimPrefix(s.String(), "STATE_")))
+}
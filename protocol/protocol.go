@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -9,13 +10,56 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	"io"
 	"strings"
+	"sync"
+	"time"
 )
 
-var typeRegistry map[string]func() proto.Message
+const packageName = "protocol"
+
+const (
+	// DefaultMaxFrameSize limits frames of authenticated peers.
+	DefaultMaxFrameSize = 16 << 20
+	// DefaultPreAuthMaxFrameSize limits frames of peers that have not
+	// authenticated yet, so unauthenticated peers cannot exhaust memory.
+	DefaultPreAuthMaxFrameSize = 64 << 10
+)
+
+var (
+	ErrFrameTooLarge  = errors.New("frame too large")
+	ErrUnknownMessage = errors.New("unknown message type")
+)
+
+var (
+	typeRegistry map[string]func() proto.Message
+	registryOnce sync.Once
+)
+
+type ReadOptions struct {
+	MaxFrameSize uint32
+	// Timeout is the time in which a whole frame must be read, zero means no
+	// timeout.
+	Timeout time.Duration
+}
+
+type Direction string
+
+const (
+	DirectionSent     Direction = "sent"
+	DirectionReceived Direction = "received"
+)
+
+// PacketObserver is called for every packet that was sent or received, if set.
+// It must be set before any packets are sent.
+var PacketObserver func(dir Direction, p proto.Message)
 
 func populateRegistry() map[string]func() proto.Message {
 	registry := make(map[string]func() proto.Message)
 	protoregistry.GlobalTypes.RangeMessages(func(mt protoreflect.MessageType) bool {
+		// only accept messages of this package, so peers cannot make us
+		// decode arbitrary well-known types
+		if mt.Descriptor().ParentFile().Package() != packageName {
+			return true
+		}
 		name := string(mt.Descriptor().FullName())
 		registry[name] = func() proto.Message { return mt.New().Interface() }
 		return true
@@ -24,11 +68,17 @@ func populateRegistry() map[string]func() proto.Message {
 }
 
 func SendPacket(w io.Writer, packet proto.Message) error {
+	return SendRequest(w, packet, 0)
+}
+
+// SendRequest sends a packet with a request id, which the receiver answers
+// with a PacketReply carrying the same id.
+func SendRequest(w io.Writer, packet proto.Message, requestId uint64) error {
 	payload, err := anypb.New(packet)
 	if err != nil {
 		return fmt.Errorf("failed to create Any message: %w", err)
 	}
-	env := &Envelope{Payload: payload}
+	env := &Envelope{Payload: payload, RequestId: requestId}
 	buf, err := proto.Marshal(env)
 	if err != nil {
 		return fmt.Errorf("failed to marshal envelope: %w", err)
@@ -41,40 +91,78 @@ func SendPacket(w io.Writer, packet proto.Message) error {
 	if err != nil {
 		return fmt.Errorf("failed to write envelope: %w", err)
 	}
+	if PacketObserver != nil {
+		PacketObserver(DirectionSent, packet)
+	}
 	return nil
 }
 
+// ReadPacket reads a single packet limited to DefaultMaxFrameSize.
 func ReadPacket(r io.Reader) (proto.Message, error) {
+	return ReadPacketWithOptions(r, ReadOptions{MaxFrameSize: DefaultMaxFrameSize})
+}
+
+// ReadPacketWithOptions reads a single packet. Frames larger than the maximum
+// frame size are rejected with ErrFrameTooLarge before anything is allocated.
+// If r is a connection and a timeout is given, the whole frame must arrive
+// within the timeout.
+func ReadPacketWithOptions(r io.Reader, opts ReadOptions) (proto.Message, error) {
+	msg, _, err := ReadRequest(r, opts)
+	return msg, err
+}
+
+// ReadRequest reads a single packet like ReadPacketWithOptions and also returns
+// its request id, which is zero if the sender does not expect a reply.
+func ReadRequest(r io.Reader, opts ReadOptions) (proto.Message, uint64, error) {
+	if conn, ok := r.(interface{ SetReadDeadline(time.Time) error }); ok {
+		var deadline time.Time
+		if opts.Timeout > 0 {
+			deadline = time.Now().Add(opts.Timeout)
+		}
+		err := conn.SetReadDeadline(deadline)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to set read deadline: %w", err)
+		}
+	}
 	var bufLen uint32
 	err := binary.Read(r, binary.BigEndian, &bufLen)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read envelope size: %w", err)
+		return nil, 0, fmt.Errorf("failed to read envelope size: %w", err)
+	}
+	if bufLen > opts.MaxFrameSize {
+		return nil, 0, fmt.Errorf("%w: %d bytes exceeds limit of %d bytes", ErrFrameTooLarge, bufLen, opts.MaxFrameSize)
 	}
 	var buf = make([]byte, bufLen)
 	_, err = io.ReadFull(r, buf)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read envelope: %w", err)
+		return nil, 0, fmt.Errorf("failed to read envelope: %w", err)
 	}
 	env := &Envelope{}
 	err = proto.Unmarshal(buf, env)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal envelope: %w", err)
+		return nil, 0, fmt.Errorf("failed to unmarshal envelope: %w", err)
 	}
 	msg, err := UnmarshalPayload(env.Payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+		return nil, 0, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+	if PacketObserver != nil {
+		PacketObserver(DirectionReceived, msg)
 	}
-	return msg, nil
+	return msg, env.RequestId, nil
 }
 
 func UnmarshalPayload(payload *anypb.Any) (proto.Message, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("%w: missing payload", ErrUnknownMessage)
+	}
 	typeName := strings.TrimPrefix(payload.TypeUrl, "type.googleapis.com/")
-	if typeRegistry == nil {
+	registryOnce.Do(func() {
 		typeRegistry = populateRegistry()
-	}
+	})
 	factory, found := typeRegistry[typeName]
 	if !found {
-		return nil, fmt.Errorf("unknown message type: %s", typeName)
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMessage, typeName)
 	}
 	message := factory()
 	if err := payload.UnmarshalTo(message); err != nil {
@@ -0,0 +1,121 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strings"
+	"testing"
+)
+
+func encodeFrame(t testing.TB, p proto.Message, requestId uint64) []byte {
+	var buf bytes.Buffer
+	err := SendRequest(&buf, p, requestId)
+	if err != nil {
+		t.Fatalf("failed to encode frame: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func frameWithPrefix(size uint32, body []byte) []byte {
+	b := binary.BigEndian.AppendUint32(nil, size)
+	return append(b, body...)
+}
+
+func FuzzReadPacket(f *testing.F) {
+	f.Add(encodeFrame(f, &PacketPing{}, 0))
+	f.Add(encodeFrame(f, &PacketAuthenticate{SlaveName: "slave-01", Memory: 1024, Labels: map[string]string{"zone": "a"}}, 7))
+	f.Add(encodeFrame(f, &ServiceEnvelope{ServiceName: "lobby-01"}, 0))
+	// oversized length prefixes must be rejected before allocating
+	f.Add(frameWithPrefix(DefaultPreAuthMaxFrameSize+1, nil))
+	f.Add(frameWithPrefix(0xffffffff, []byte{0x0a, 0x00}))
+	// length prefix longer than the data
+	f.Add(frameWithPrefix(100, []byte{0x0a, 0x02}))
+	// envelope with an unknown type url
+	env, _ := proto.Marshal(&Envelope{Payload: &anypb.Any{TypeUrl: "type.googleapis.com/protocol.DoesNotExist"}})
+	f.Add(frameWithPrefix(uint32(len(env)), env))
+	// envelope with a well-known type of another package
+	d, _ := anypb.New(durationpb.New(0))
+	env, _ = proto.Marshal(&Envelope{Payload: d})
+	f.Add(frameWithPrefix(uint32(len(env)), env))
+	f.Add([]byte{})
+	f.Add([]byte{0x00, 0x00})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		opts := ReadOptions{MaxFrameSize: DefaultPreAuthMaxFrameSize}
+		msg, requestId, err := ReadRequest(bytes.NewReader(data), opts)
+		if len(data) >= 4 && binary.BigEndian.Uint32(data) > opts.MaxFrameSize {
+			if !errors.Is(err, ErrFrameTooLarge) {
+				t.Fatalf("oversized frame returned %v, want ErrFrameTooLarge", err)
+			}
+			return
+		}
+		if err != nil {
+			return
+		}
+		if msg == nil {
+			t.Fatalf("no message and no error")
+		}
+		// a decoded packet must survive another round trip
+		frame := encodeFrame(t, msg, requestId)
+		msg2, requestId2, err := ReadRequest(bytes.NewReader(frame), ReadOptions{MaxFrameSize: DefaultMaxFrameSize})
+		if err != nil {
+			t.Fatalf("failed to read re-encoded packet: %v", err)
+		}
+		if requestId2 != requestId || !proto.Equal(msg, msg2) {
+			t.Fatalf("round trip changed packet: %v != %v", msg, msg2)
+		}
+	})
+}
+
+func FuzzUnmarshalPayload(f *testing.F) {
+	for _, p := range []proto.Message{
+		&PacketPing{},
+		&PacketReply{RequestId: 3, Error: "failed"},
+		&PacketStopService{ServiceName: "lobby-01"},
+	} {
+		payload, err := anypb.New(p)
+		if err != nil {
+			f.Fatalf("failed to create payload: %v", err)
+		}
+		f.Add(payload.TypeUrl, payload.Value)
+	}
+	f.Add("type.googleapis.com/protocol.DoesNotExist", []byte{})
+	f.Add("type.googleapis.com/google.protobuf.Duration", []byte{0x08, 0x01})
+	f.Add("protocol.PacketPing", []byte{})
+	f.Add("", []byte{0xff, 0xff})
+	f.Add("type.googleapis.com/protocol.PacketStopService", []byte{0x0a, 0xff, 0xff, 0xff, 0xff, 0x0f})
+
+	f.Fuzz(func(t *testing.T, typeUrl string, value []byte) {
+		msg, err := UnmarshalPayload(&anypb.Any{TypeUrl: typeUrl, Value: value})
+		if err != nil {
+			if msg != nil {
+				t.Fatalf("message returned along with error %v", err)
+			}
+			return
+		}
+		name := proto.MessageName(msg)
+		if !strings.HasPrefix(string(name), packageName+".") {
+			t.Fatalf("decoded message %q of another package", name)
+		}
+		if typeUrl[strings.LastIndex(typeUrl, "/")+1:] != string(name) {
+			t.Fatalf("decoded message %q from type url %q", name, typeUrl)
+		}
+	})
+}
+
+func TestUnmarshalPayloadRejectsUnknownTypes(t *testing.T) {
+	for _, payload := range []*anypb.Any{
+		nil,
+		{TypeUrl: "type.googleapis.com/protocol.DoesNotExist"},
+		{TypeUrl: "type.googleapis.com/google.protobuf.Duration"},
+	} {
+		_, err := UnmarshalPayload(payload)
+		if !errors.Is(err, ErrUnknownMessage) {
+			t.Errorf("UnmarshalPayload(%v) = %v, want ErrUnknownMessage", payload, err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package protocol
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// TemplateVersionsDir is the directory inside the template directory that
+// holds the immutable versions of all templates.
+const TemplateVersionsDir = ".versions"
+
+// TemplateRef references a version of a template, e.g. "lobby@3".
+func TemplateRef(name string, version int) string {
+	return fmt.Sprintf("%s@%d", name, version)
+}
+
+// TemplatePath returns the path of a template or template version relative to
+// the template directory.
+func TemplatePath(ref string) string {
+	name, version, found := strings.Cut(ref, "@")
+	if !found {
+		return name
+	}
+	return path.Join(TemplateVersionsDir, name, version)
+}
@@ -3,6 +3,16 @@ package protocol
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	PlacementBestFit    = "best-fit"
+	PlacementWorstFit   = "worst-fit"
+	PlacementSpread     = "spread"
+	PlacementRoundRobin = "round-robin"
+	PlacementPin        = "pin"
 )
 
 func (g *Group) Validate() error {
@@ -21,5 +31,75 @@ func (g *Group) Validate() error {
 	if g.Memory < 1 {
 		return errors.New("memory cannot be smaller than 1")
 	}
+	if g.MaxPlayers < 0 {
+		return errors.New("max_players cannot be smaller than 0")
+	}
+	if g.ScaleThreshold < 0 || g.ScaleThreshold > 100 {
+		return errors.New("scale_threshold must be between 0 and 100")
+	}
+	if g.ScaleDownDelay < 0 {
+		return errors.New("scale_down_delay cannot be smaller than 0")
+	}
+	if g.WarmServices < 0 {
+		return errors.New("warm_services cannot be smaller than 0")
+	}
+	if g.WarmServices > 0 && g.Type != Service_TYPE_SERVER {
+		return errors.New("warm_services is only supported for server groups")
+	}
+	if g.DrainTimeout < 0 {
+		return errors.New("drain_timeout cannot be smaller than 0")
+	}
+	if g.FallbackGroup != "" && g.Type != Service_TYPE_SERVER {
+		return errors.New("fallback_group is only supported for server groups")
+	}
+	switch g.Placement {
+	case "", PlacementBestFit, PlacementWorstFit, PlacementSpread, PlacementRoundRobin:
+	case PlacementPin:
+		if g.PinnedSlave == "" {
+			return errors.New("pinned_slave must be set for pin placement")
+		}
+	default:
+		return fmt.Errorf("unknown placement %q", g.Placement)
+	}
+	if g.Static && g.Placement != PlacementPin {
+		return errors.New("static groups must use pin placement")
+	}
+	if g.Static && g.WarmServices > 0 {
+		// warm services would take the names and data of static services
+		return errors.New("warm_services is not supported for static groups")
+	}
+	for _, t := range g.Templates {
+		err := ValidateTemplateName(t)
+		if err != nil {
+			return err
+		}
+	}
+	for _, t := range g.RandomTemplates {
+		err := ValidateTemplateName(t.Name)
+		if err != nil {
+			return err
+		}
+		if t.Weight < 1 {
+			return fmt.Errorf("weight of random template %q must be at least 1", t.Name)
+		}
+	}
+	for t, v := range g.TemplateVersions {
+		err := ValidateTemplateName(t)
+		if err != nil {
+			return err
+		}
+		if v < 1 {
+			return fmt.Errorf("pinned version of template %q must be at least 1", t)
+		}
+	}
+	return nil
+}
+
+// ValidateTemplateName returns an error if name cannot be used as the name of a
+// template directory.
+func ValidateTemplateName(name string) error {
+	if !filepath.IsLocal(name) || strings.ContainsAny(name, `/\@`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid template name %q", name)
+	}
 	return nil
 }
@@ -0,0 +1,53 @@
+package protocol
+
+import (
+	"testing"
+)
+
+func validGroup() *Group {
+	return &Group{
+		Name:        "lobby",
+		Type:        Service_TYPE_SERVER,
+		MinServices: 1,
+		MaxServices: 2,
+		Memory:      1024,
+	}
+}
+
+func TestGroupValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		modify func(g *Group)
+		valid  bool
+	}{
+		{"default", func(g *Group) {}, true},
+		{"warm services", func(g *Group) { g.WarmServices = 1 }, true},
+		{"warm proxy services", func(g *Group) {
+			g.Type = Service_TYPE_PROXY
+			g.WarmServices = 1
+		}, false},
+		{"static", func(g *Group) {
+			g.Static = true
+			g.Placement = PlacementPin
+			g.PinnedSlave = "slave-01"
+		}, true},
+		{"static without pin placement", func(g *Group) { g.Static = true }, false},
+		{"static with warm services", func(g *Group) {
+			g.Static = true
+			g.Placement = PlacementPin
+			g.PinnedSlave = "slave-01"
+			g.WarmServices = 1
+		}, false},
+		{"min above max", func(g *Group) { g.MinServices = 3 }, false},
+		{"invalid template", func(g *Group) { g.Templates = []string{"../lobby"} }, false},
+	} {
+		g := validGroup()
+		tc.modify(g)
+		err := g.Validate()
+		if tc.valid && err != nil {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		} else if !tc.valid && err == nil {
+			t.Errorf("%s: invalid group was accepted", tc.name)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package protocol
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	// Version is incremented on every incompatible change of the protocol.
+	Version = 1
+	// MinVersion is the oldest protocol version that peers may speak.
+	MinVersion = 1
+)
+
+// Capabilities announce optional features, so newer packets are only sent to
+// peers that understand them.
+const (
+	CapabilityHeartbeat    = "heartbeat"
+	CapabilityPlayerCount  = "player-count"
+	CapabilityMemoryUsage  = "memory-usage"
+	CapabilityReply        = "reply"
+	CapabilityServiceSave  = "service-save"
+	CapabilityTemplatePush = "template-push"
+	CapabilityServiceDrain = "service-drain"
+)
+
+// Capabilities are all capabilities supported by this build.
+var Capabilities = []string{
+	CapabilityHeartbeat,
+	CapabilityPlayerCount,
+	CapabilityMemoryUsage,
+	CapabilityReply,
+	CapabilityServiceSave,
+	CapabilityTemplatePush,
+	CapabilityServiceDrain,
+}
+
+// CheckVersion returns an error if a peer speaking the given version cannot be
+// talked to. Peers from before the negotiation was introduced report version 0.
+func CheckVersion(version int32) error {
+	if version < MinVersion {
+		return fmt.Errorf("protocol version %d is too old, at least version %d is required", version, MinVersion)
+	}
+	return nil
+}
+
+// NegotiateCapabilities returns the capabilities supported by both peers.
+func NegotiateCapabilities(remote []string) map[string]bool {
+	caps := make(map[string]bool)
+	for _, c := range Capabilities {
+		if slices.Contains(remote, c) {
+			caps[c] = true
+		}
+	}
+	return caps
+}
@@ -6,15 +6,31 @@ import (
 	"log"
 	"net"
 	"protocol"
+	"time"
 )
 
 type interruptCmd struct{}
 
-type slaveDisconnectCmd struct{}
+type masterConnectedCmd struct {
+	conn net.Conn
+}
+
+type masterDisconnectedCmd struct {
+	conn net.Conn
+}
+
+type authTimeoutCmd struct {
+	conn net.Conn
+}
+
+type reportMemoryCmd struct{}
+
+type heartbeatCmd struct{}
 
 type handleMasterPacketCmd struct {
-	p     proto.Message
-	errCh chan<- error
+	p         proto.Message
+	requestId uint64
+	errCh     chan<- error
 }
 
 type handleServicePacketCmd struct {
@@ -29,10 +45,16 @@ type serviceDisconnectCmd struct {
 
 type serviceConnectCmd struct {
 	key   string
+	caps  map[string]bool
 	conn  net.Conn
 	svcCh chan<- *service
 }
 
+type servicePreparedCmd struct {
+	svc *service
+	err error
+}
+
 type serviceStoppedCmd struct {
 	svc *service
 }
@@ -43,7 +65,7 @@ loop:
 		cmd := <-ch
 		switch cmd := cmd.(type) {
 		case handleMasterPacketCmd:
-			cmd.errCh <- s.handlePacket(cmd.p)
+			cmd.errCh <- s.handlePacket(cmd.p, cmd.requestId)
 			close(cmd.errCh)
 		case handleServicePacketCmd:
 			cmd.errCh <- cmd.svc.handlePacket(cmd.p)
@@ -52,26 +74,17 @@ loop:
 			var connectedSvc *service
 			for _, svc := range s.svcm.services {
 				if svc.conn == nil && cmd.key == svc.key && svc.State == protocol.Service_STATE_SCHEDULED {
+					svc.caps = cmd.caps
 					s.svcm.setConnected(svc, cmd.conn)
 					connectedSvc = svc
 				}
 			}
 			cmd.svcCh <- connectedSvc
 			close(cmd.svcCh)
+		case servicePreparedCmd:
+			s.servicePrepared(cmd.svc, cmd.err)
 		case serviceStoppedCmd:
-			err := s.sendPacket(&protocol.PacketServiceStopped{
-				ServiceName: cmd.svc.Name,
-			})
-			if err != nil {
-				log.Printf("failed to send service stopped packet: %v", err)
-			}
-			cmd.svc.State = protocol.Service_STATE_OFFLINE
-			cmd.svc.Port = 0
-			cmd.svc.cmd = nil
-			err = s.svcm.deleteService(cmd.svc)
-			if err != nil {
-				log.Printf("failed to delete service %q: %v", cmd.svc.Name, err)
-			}
+			s.svcm.serviceStopped(cmd.svc)
 		case serviceDisconnectCmd:
 			if cmd.svc.State == protocol.Service_STATE_PENDING || cmd.svc.State == protocol.Service_STATE_ONLINE {
 				err := s.svcm.stopService(cmd.svc)
@@ -79,11 +92,56 @@ loop:
 					log.Printf("failed to stop service %q: %v", cmd.svc.Name, err)
 				}
 			}
-		case slaveDisconnectCmd:
-			break loop
+		case reportMemoryCmd:
+			s.metrics.update(s.svcm)
+			if s.authenticated && s.masterCaps[protocol.CapabilityMemoryUsage] {
+				s.svcm.reportMemoryUsage()
+			}
+		case heartbeatCmd:
+			for _, svc := range s.svcm.services {
+				if svc.conn == nil || !svc.caps[protocol.CapabilityHeartbeat] {
+					continue
+				}
+				err := svc.sendPacket(&protocol.PacketPing{})
+				if err != nil {
+					log.Printf("failed to ping service %q: %v", svc.Name, err)
+				}
+			}
+		case masterConnectedCmd:
+			s.setConn(cmd.conn)
+			log.Println("connected to master")
+			go handleMasterConnection(s.ch, cmd.conn, s.cfg)
+			err := s.authenticate()
+			if err != nil {
+				log.Printf("could not authenticate with master: %v", err)
+				_ = cmd.conn.Close()
+			}
+			go func() {
+				defer recoverPanic()
+				time.Sleep(10 * time.Second)
+				s.ch <- authTimeoutCmd{conn: cmd.conn}
+			}()
+		case authTimeoutCmd:
+			if s.conn == cmd.conn && !s.authenticated {
+				log.Println("authentication with master timed out")
+				_ = cmd.conn.Close()
+			}
+		case masterDisconnectedCmd:
+			if s.conn != cmd.conn {
+				continue
+			}
+			s.setConn(nil)
+			s.authenticated = false
+			for _, svc := range s.svcm.services {
+				svc.sc.mu.Lock()
+				svc.sc.report = false
+				svc.sc.mu.Unlock()
+			}
+			s.reconnectDelay = nextReconnectDelay(s.reconnectDelay)
+			log.Printf("lost connection to master, reconnecting in %s", s.reconnectDelay)
+			go s.connectToMaster(s.reconnectDelay)
 		case interruptCmd:
 			log.Printf("received interrupt, exiting cleanly")
-			_ = s.conn.Close()
 			break loop
 		default:
 			panic(fmt.Sprintf("unknown command type %T", cmd))
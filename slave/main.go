@@ -1,6 +1,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"fmt"
 	"github.com/fatih/color"
 	"google.golang.org/protobuf/proto"
@@ -12,28 +13,48 @@ import (
 	"protocol"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	"common"
 )
 
+const (
+	maxReconnectDelay = time.Minute
+	// defaultHeartbeatTimeout is used for the master connection until the
+	// master announced its heartbeat timeout.
+	defaultHeartbeatTimeout = 30 * time.Second
+)
+
 type slave struct {
-	conn          net.Conn
-	cfg           *config
-	authenticated bool
-	tmpl          *templateManager
-	svcm          *serviceManager
-	ch            chan<- any
+	conn           net.Conn
+	connMu         sync.Mutex
+	cfg            *config
+	tlsCfg         *tls.Config
+	authenticated  bool
+	masterCaps     map[string]bool
+	reconnectDelay time.Duration
+	tmpl           *templateManager
+	svcm           *serviceManager
+	metrics        *metrics
+	ch             chan<- any
 }
 
 type config struct {
-	Name           string `yaml:"name"`
-	BindAddr       string `yaml:"bind_addr"`
-	MasterAddr     string `yaml:"master_addr"`
-	FileServerHost string `yaml:"file_server_host"`
-	FileServerPort string `yaml:"file_server_port"`
-	SecretKey      string `yaml:"secret_key"`
-	Memory         int32  `yaml:"memory"`
+	Name                string            `yaml:"name"`
+	BindAddr            string            `yaml:"bind_addr"`
+	MasterAddr          string            `yaml:"master_addr"`
+	FileServerHost      string            `yaml:"file_server_host"`
+	FileServerPort      string            `yaml:"file_server_port"`
+	SecretKey           string            `yaml:"secret_key"`
+	Memory              int32             `yaml:"memory"`
+	Labels              map[string]string `yaml:"labels"`
+	MetricsBindAddr     string            `yaml:"metrics_bind_addr"`
+	Tls                 tlsConfig         `yaml:"tls"`
+	HeartbeatInterval   int               `yaml:"heartbeat_interval"`
+	HeartbeatTimeout    int               `yaml:"heartbeat_timeout"`
+	MaxFrameSize        int               `yaml:"max_frame_size"`
+	MaxPreAuthFrameSize int               `yaml:"max_pre_auth_frame_size"`
 }
 
 func main() {
@@ -57,74 +78,97 @@ func main() {
 	log.SetPrefix(color.YellowString("[slave] "))
 	log.Printf("starting Athena-Slave %s", common.Version)
 
-	var s slave
-
-	err = checkForRsync()
-	if err != nil {
-		log.Fatalf("%v", err)
-	}
+	s := slave{metrics: newMetrics()}
 
 	ch := make(chan any)
 	s.ch = ch
 
 	s.cfg, err = common.ReadConfig("slave.yaml", config{
-		Name:           "slave-01",
-		BindAddr:       ":3000",
-		MasterAddr:     "127.0.0.1:5000",
-		FileServerHost: "127.0.0.1",
-		FileServerPort: "5001",
-		SecretKey:      "",
-		Memory:         1024,
+		Name:                "slave-01",
+		BindAddr:            ":3000",
+		MasterAddr:          "127.0.0.1:5000",
+		FileServerHost:      "127.0.0.1",
+		FileServerPort:      "5001",
+		SecretKey:           "",
+		Memory:              1024,
+		Labels:              map[string]string{},
+		MetricsBindAddr:     ":3001",
+		HeartbeatInterval:   5,
+		HeartbeatTimeout:    15,
+		MaxFrameSize:        protocol.DefaultMaxFrameSize,
+		MaxPreAuthFrameSize: protocol.DefaultPreAuthMaxFrameSize,
+		Tls: tlsConfig{
+			CaFile:   "pki/ca.crt",
+			CertFile: defaultClientCertFile,
+			KeyFile:  defaultClientKeyFile,
+		},
 	})
 	if err != nil {
 		log.Fatalf("error loading config: %v", err)
 	}
 
+	if s.cfg.HeartbeatInterval <= 0 || s.cfg.HeartbeatTimeout <= s.cfg.HeartbeatInterval {
+		log.Fatalf("heartbeat_timeout must be greater than heartbeat_interval")
+	}
+
+	if s.cfg.MaxPreAuthFrameSize <= 0 || s.cfg.MaxFrameSize < s.cfg.MaxPreAuthFrameSize {
+		log.Fatalf("max_frame_size must be at least max_pre_auth_frame_size, which must be positive")
+	}
+
 	s.tmpl, err = newTemplateManager(&s)
 	if err != nil {
 		log.Fatalf("error loading templates: %v", err)
 	}
 
+	if s.cfg.Tls.Enabled {
+		masterHost, _, err := net.SplitHostPort(s.cfg.MasterAddr)
+		if err != nil {
+			log.Fatalf("invalid master address: %v", err)
+		}
+		s.tlsCfg, err = s.cfg.Tls.clientTlsConfig(masterHost)
+		if err != nil {
+			log.Fatalf("failed to load tls config: %v", err)
+		}
+	}
+
 	s.svcm, err = newServiceManager(&s)
 	if err != nil {
 		log.Fatalf("error initializing service manager: %v", err)
 	}
 
-	log.Printf("connecting to master at %s", s.cfg.MasterAddr)
-	s.conn, err = net.Dial("tcp", s.cfg.MasterAddr)
-	if err != nil {
-		log.Fatalf("could not connect to master: %v", err)
+	if s.cfg.MetricsBindAddr != "" {
+		err = s.metrics.startServer(s.cfg.MetricsBindAddr)
+		if err != nil {
+			log.Fatalf("failed starting metrics server: %v", err)
+		}
 	}
-	log.Println("connected to master")
 
-	err = s.sendPacket(&protocol.PacketAuthenticate{
-		SlaveName: s.cfg.Name,
-		SecretKey: s.cfg.SecretKey,
-		Memory:    s.cfg.Memory,
-	})
+	lis, err := net.Listen("tcp", s.cfg.BindAddr)
 	if err != nil {
-		log.Fatalf("could not authenticate with master: %v", err)
+		log.Fatalf("failed starting server: %v", err)
 	}
+	defer func() {
+		_ = lis.Close()
+	}()
+	log.Printf("listening on %s", s.cfg.BindAddr)
+	go handleServiceConnection(ch, lis, s.cfg)
+	go s.connectToMaster(0)
 
 	go func() {
 		defer recoverPanic()
-		time.Sleep(10 * time.Second)
-		if !s.authenticated {
-			log.Println("authentication with master timed out")
-			_ = s.conn.Close()
+		t := time.NewTicker(10 * time.Second)
+		for range t.C {
+			ch <- reportMemoryCmd{}
 		}
 	}()
 
-	lis, err := net.Listen("tcp", s.cfg.BindAddr)
-	if err != nil {
-		log.Fatalf("failed starting server: %v", err)
-	}
-	defer func() {
-		_ = lis.Close()
+	go func() {
+		defer recoverPanic()
+		t := time.NewTicker(time.Duration(s.cfg.HeartbeatInterval) * time.Second)
+		for range t.C {
+			ch <- heartbeatCmd{}
+		}
 	}()
-	log.Printf("listening on %s", s.cfg.BindAddr)
-	go handleMasterConnection(ch, s.conn)
-	go handleServiceConnection(ch, lis)
 
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt)
@@ -136,73 +180,121 @@ func main() {
 
 	s.runCommandQueue(ch)
 
-	log.Printf("disconnecting from master at %s", s.conn.RemoteAddr())
-	_ = s.conn.Close()
+	if s.conn != nil {
+		log.Printf("disconnecting from master at %s", s.conn.RemoteAddr())
+		_ = s.conn.Close()
+	}
+}
+
+// connectToMaster dials the master after the given delay and keeps retrying
+// with exponential backoff until a connection is established.
+func (s *slave) connectToMaster(delay time.Duration) {
+	defer recoverPanic()
+	for {
+		time.Sleep(delay)
+		log.Printf("connecting to master at %s", s.cfg.MasterAddr)
+		conn, err := s.dial()
+		if err == nil {
+			s.ch <- masterConnectedCmd{conn: conn}
+			return
+		}
+		delay = nextReconnectDelay(delay)
+		log.Printf("could not connect to master: %v, retrying in %s", err, delay)
+	}
+}
+
+func (s *slave) dial() (net.Conn, error) {
+	if s.tlsCfg != nil {
+		return tls.Dial("tcp", s.cfg.MasterAddr, s.tlsCfg)
+	}
+	return net.Dial("tcp", s.cfg.MasterAddr)
+}
+
+func nextReconnectDelay(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return time.Second
+	}
+	return min(delay*2, maxReconnectDelay)
+}
+
+func (s *slave) setConn(conn net.Conn) {
+	s.connMu.Lock()
+	s.conn = conn
+	s.connMu.Unlock()
+}
+
+// authenticate sends the credentials of the slave along with an inventory of
+// all services that are still running, so the master can adopt them.
+func (s *slave) authenticate() error {
+	var services []*protocol.Service
+	for _, svc := range s.svcm.services {
+		services = append(services, svc.Service)
+	}
+	return s.sendPacket(&protocol.PacketAuthenticate{
+		SlaveName:       s.cfg.Name,
+		SecretKey:       s.cfg.SecretKey,
+		Memory:          s.cfg.Memory,
+		Labels:          s.cfg.Labels,
+		Services:        services,
+		ProtocolVersion: protocol.Version,
+		Capabilities:    protocol.Capabilities,
+	})
 }
 
 func (s *slave) sendPacket(p proto.Message) error {
+	s.connMu.Lock()
+	defer s.connMu.Unlock()
+	if s.conn == nil {
+		return fmt.Errorf("not connected to master")
+	}
+	_ = s.conn.SetWriteDeadline(time.Now().Add(time.Duration(s.cfg.HeartbeatTimeout) * time.Second))
 	return protocol.SendPacket(s.conn, p)
 }
 
 func (s *slave) handlePacketPreAuth(p proto.Message) error {
 	switch p := p.(type) {
 	case *protocol.PacketAuthSuccess:
+		err := protocol.CheckVersion(p.ProtocolVersion)
+		if err != nil {
+			return fmt.Errorf("incompatible master: %w", err)
+		}
+		s.masterCaps = protocol.NegotiateCapabilities(p.Capabilities)
 		s.authenticated = true
+		s.reconnectDelay = 0
 		log.Println("authenticated with master")
+	case *protocol.PacketAuthFailed:
+		return fmt.Errorf("authentication failed: %s", p.Message)
 	default:
 		return fmt.Errorf("received packet before authentication: %T", p)
 	}
 	return nil
 }
 
-func (s *slave) handlePacket(p proto.Message) error {
+func (s *slave) handlePacket(p proto.Message, requestId uint64) error {
 	if !s.authenticated {
 		return s.handlePacketPreAuth(p)
 	}
 
 	switch p := p.(type) {
-	case *protocol.PacketAuthFailed:
-		return fmt.Errorf("authentication failed: %s", p.Message)
-
 	case *protocol.PacketScheduleServiceRequest:
-		log.Printf("asked to schedule service %s", p.Service.Name)
-		svc, err := s.svcm.createService(p.Service, p.Group)
-		if err != nil {
-			log.Printf("failed to schedule service %s: %v", p.Service.Name, err)
-			err = s.sendPacket(&protocol.PacketServiceStartFailed{
-				ServiceName: p.Service.Name,
-				Message:     fmt.Sprintf("failed to create service: %v", err),
-			})
-			if err != nil {
-				return fmt.Errorf("failed to send packet: %w", err)
-			}
-			return nil
-		}
-		log.Printf("starting service %q", p.Service.Name)
-		err = s.svcm.startService(svc)
-		if err != nil {
-			log.Printf("failed to start service %q: %v", p.Service.Name, err)
-			err = s.sendPacket(&protocol.PacketServiceStartFailed{
-				ServiceName: p.Service.Name,
-				Message:     fmt.Sprintf("failed to start service: %v", err),
-			})
-			if err != nil {
-				return fmt.Errorf("failed to send packet: %w", err)
-			}
-			return nil
-		}
+		return s.reply(requestId, s.scheduleService(p))
 
 	case *protocol.PacketStopService:
-		svc := s.svcm.getService(p.ServiceName)
-		if svc == nil {
-			log.Printf("service %q not found", p.ServiceName)
-			return nil
-		}
-		log.Printf("stopping service %q", p.ServiceName)
-		err := s.svcm.stopService(svc)
+		return s.reply(requestId, s.stopService(p))
+
+	case *protocol.PacketExecuteServiceCommand:
+		return s.reply(requestId, s.executeCommand(p))
+
+	case *protocol.PacketSaveService:
+		return s.reply(requestId, s.saveService(p))
+
+	case *protocol.PacketTemplateChanged:
+		s.tmpl.templateChanged(p)
+
+	case *protocol.PacketPing:
+		err := s.sendPacket(&protocol.PacketPong{})
 		if err != nil {
-			log.Printf("failed to stop service %q: %v", p.ServiceName, err)
-			return nil
+			return fmt.Errorf("failed to send packet: %w", err)
 		}
 
 	case *protocol.ServiceEnvelope:
@@ -216,6 +308,12 @@ func (s *slave) handlePacket(p proto.Message) error {
 			log.Printf("failed to unmarshal payload: %v", err)
 			return nil
 		}
+		// older plugins cannot parse the drain packet, their players stay
+		// until the drain times out
+		if _, ok := msg.(*protocol.PacketServiceDrain); ok && !svc.caps[protocol.CapabilityServiceDrain] {
+			log.Printf("service %q does not support draining", svc.Name)
+			return nil
+		}
 		err = svc.sendPacket(msg)
 		if err != nil {
 			return fmt.Errorf("failed to send packet: %w", err)
@@ -247,42 +345,134 @@ func (s *slave) handlePacket(p proto.Message) error {
 		svc.sc.report = false
 		svc.sc.mu.Unlock()
 
-	case *protocol.PacketExecuteServiceCommand:
-		svc := s.svcm.getService(p.ServiceName)
-		if svc == nil {
-			log.Printf("service %q not found", p.ServiceName)
-			return nil
-		}
-		if svc.w == nil {
-			log.Printf("service %q is not writeable", p.ServiceName)
-			return nil
-		}
-		_, err := svc.w.Write([]byte(p.Command + "\n"))
+	}
+	return nil
+}
+
+// reply answers a request of the master with the outcome of the request. Only
+// errors sending the reply are returned.
+func (s *slave) reply(requestId uint64, err error) error {
+	if err != nil {
+		log.Printf("%v", err)
+	}
+	if requestId == 0 {
+		return nil
+	}
+	reply := &protocol.PacketReply{RequestId: requestId}
+	if err != nil {
+		reply.Error = err.Error()
+	}
+	err = s.sendPacket(reply)
+	if err != nil {
+		return fmt.Errorf("failed to send reply: %w", err)
+	}
+	return nil
+}
+
+// scheduleService registers the service, it is started once its templates are
+// in place. Start failures are reported with PacketServiceStartFailed.
+func (s *slave) scheduleService(p *protocol.PacketScheduleServiceRequest) error {
+	log.Printf("asked to schedule service %s", p.Service.Name)
+	_, err := s.svcm.createService(p.Service, p.Group)
+	if err != nil {
+		_ = s.sendPacket(&protocol.PacketServiceStartFailed{
+			ServiceName: p.Service.Name,
+			Message:     fmt.Sprintf("failed to create service: %v", err),
+		})
+		return fmt.Errorf("failed to schedule service %q: %w", p.Service.Name, err)
+	}
+	return nil
+}
+
+// servicePrepared starts a service once its directory was prepared, unless
+// the master stopped it in the meantime.
+func (s *slave) servicePrepared(svc *service, err error) {
+	svc.preparing = false
+	if svc.State == protocol.Service_STATE_STOPPING {
+		log.Printf("service %q was stopped before it started", svc.Name)
+		s.svcm.serviceStopped(svc)
+		return
+	}
+	msg := ""
+	if err != nil {
+		msg = fmt.Sprintf("failed to create service: %v", err)
+	} else {
+		log.Printf("starting service %q", svc.Name)
+		err = s.svcm.startService(svc)
 		if err != nil {
-			log.Printf("failed to write to service %q: %v", p.ServiceName, err)
-			return nil
+			msg = fmt.Sprintf("failed to start service: %v", err)
 		}
 	}
+	if err == nil {
+		return
+	}
+	log.Printf("failed to start service %q: %v", svc.Name, err)
+	_ = s.sendPacket(&protocol.PacketServiceStartFailed{
+		ServiceName: svc.Name,
+		Message:     msg,
+	})
+	svc.State = protocol.Service_STATE_OFFLINE
+	svc.cmd = nil
+	err = s.svcm.deleteService(svc)
+	if err != nil {
+		log.Printf("failed to delete service %q: %v", svc.Name, err)
+	}
+}
+
+func (s *slave) stopService(p *protocol.PacketStopService) error {
+	svc := s.svcm.getService(p.ServiceName)
+	if svc == nil {
+		return fmt.Errorf("service %q not found", p.ServiceName)
+	}
+	log.Printf("stopping service %q", p.ServiceName)
+	err := s.svcm.stopService(svc)
+	if err != nil {
+		return fmt.Errorf("failed to stop service %q: %w", p.ServiceName, err)
+	}
+	return nil
+}
+
+func (s *slave) executeCommand(p *protocol.PacketExecuteServiceCommand) error {
+	svc := s.svcm.getService(p.ServiceName)
+	if svc == nil {
+		return fmt.Errorf("service %q not found", p.ServiceName)
+	}
+	if svc.w == nil {
+		return fmt.Errorf("service %q is not writeable", p.ServiceName)
+	}
+	_, err := svc.w.Write([]byte(p.Command + "\n"))
+	if err != nil {
+		return fmt.Errorf("failed to write to service %q: %w", p.ServiceName, err)
+	}
 	return nil
 }
 
-func handleMasterConnection(ch chan<- any, conn net.Conn) {
+func handleMasterConnection(ch chan<- any, conn net.Conn, cfg *config) {
 	defer recoverPanic()
+	opts := protocol.ReadOptions{
+		MaxFrameSize: uint32(cfg.MaxPreAuthFrameSize),
+		Timeout:      defaultHeartbeatTimeout,
+	}
 	for {
-		p, err := protocol.ReadPacket(conn)
+		p, requestId, err := protocol.ReadRequest(conn, opts)
 		if err != nil {
 			log.Printf("failed to read packet: %v", err)
 			break
 		}
+		if p, ok := p.(*protocol.PacketAuthSuccess); ok {
+			opts.MaxFrameSize = uint32(cfg.MaxFrameSize)
+			opts.Timeout = time.Duration(p.HeartbeatTimeout) * time.Second
+		}
 		errCh := make(chan error)
-		ch <- handleMasterPacketCmd{p: p, errCh: errCh}
+		ch <- handleMasterPacketCmd{p: p, requestId: requestId, errCh: errCh}
 		err = <-errCh
 		if err != nil {
 			log.Printf("failed to handle packet: %v", err)
 			break
 		}
 	}
-	ch <- slaveDisconnectCmd{}
+	_ = conn.Close()
+	ch <- masterDisconnectedCmd{conn: conn}
 }
 
 func recoverPanic() {
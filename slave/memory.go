@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"protocol"
+	"strconv"
+	"strings"
+)
+
+// readRss returns the resident set size of the process with the given pid in MiB.
+func readRss(pid int) (int32, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		return 0, fmt.Errorf("failed to open process status: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "VmRSS:" {
+			continue
+		}
+		kb, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid VmRSS value %q: %w", fields[1], err)
+		}
+		return int32(kb / 1024), nil
+	}
+	if err = scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read process status: %w", err)
+	}
+	return 0, fmt.Errorf("VmRSS not found for process %d", pid)
+}
+
+func (svcm *serviceManager) reportMemoryUsage() {
+	p := &protocol.PacketSlaveMemoryUsage{
+		Services: make(map[string]int32),
+	}
+	for _, svc := range svcm.services {
+		if svc.cmd == nil || svc.cmd.Process == nil {
+			continue
+		}
+		rss, err := readRss(svc.cmd.Process.Pid)
+		if err != nil {
+			continue
+		}
+		p.Services[svc.Name] = rss
+		p.UsedMemory += rss
+	}
+	err := svcm.s.sendPacket(p)
+	if err != nil {
+		log.Printf("failed to send memory usage: %v", err)
+	}
+}
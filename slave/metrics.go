@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"net"
+	"net/http"
+	"protocol"
+	"strings"
+)
+
+type metrics struct {
+	reg               *prometheus.Registry
+	services          *gaugeVec
+	templateSync      prometheus.Histogram
+	templateSyncFiles prometheus.Counter
+	templateSyncBytes prometheus.Counter
+	packets           *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	mt := &metrics{
+		reg: prometheus.NewRegistry(),
+		services: newGaugeVec(prometheus.GaugeOpts{
+			Name: "athena_slave_services",
+			Help: "Number of services on this slave per group and state.",
+		}, []string{"group", "state"}),
+		templateSync: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "athena_slave_template_sync_duration_seconds",
+			Help:    "Time it takes to sync the templates from the master.",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 10),
+		}),
+		templateSyncFiles: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "athena_slave_template_sync_files_total",
+			Help: "Number of template files downloaded from the master.",
+		}),
+		templateSyncBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "athena_slave_template_sync_bytes_total",
+			Help: "Number of template bytes downloaded from the master.",
+		}),
+		packets: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "athena_slave_packets_total",
+			Help: "Number of packets sent and received per packet type.",
+		}, []string{"direction", "type"}),
+	}
+	mt.reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		mt.services,
+		mt.templateSync,
+		mt.templateSyncFiles,
+		mt.templateSyncBytes,
+		mt.packets,
+	)
+	protocol.PacketObserver = mt.observePacket
+	return mt
+}
+
+func (mt *metrics) observePacket(dir protocol.Direction, p proto.Message) {
+	name := string(proto.MessageName(p).Name())
+	mt.packets.WithLabelValues(string(dir), name).Inc()
+}
+
+// update refreshes all gauges that reflect the services of the slave.
+func (mt *metrics) update(svcm *serviceManager) {
+	counts := make(map[[2]string]float64)
+	for _, svc := range svcm.services {
+		state := strings.ToLower(strings.TrimPrefix(svc.State.String(), "STATE_"))
+		counts[[2]string{svc.Group, state}]++
+	}
+	for labels, n := range counts {
+		mt.services.set(n, labels[0], labels[1])
+	}
+	mt.services.flush()
+}
+
+// gaugeVec is a gauge vec that deletes the label sets which were not set
+// since the last flush. Unlike resetting the vec before setting all values
+// again, a concurrent scrape never misses a series.
+type gaugeVec struct {
+	*prometheus.GaugeVec
+	last map[string][]string
+	cur  map[string][]string
+}
+
+func newGaugeVec(opts prometheus.GaugeOpts, labelNames []string) *gaugeVec {
+	return &gaugeVec{
+		GaugeVec: prometheus.NewGaugeVec(opts, labelNames),
+		cur:      make(map[string][]string),
+	}
+}
+
+func (gv *gaugeVec) set(v float64, labels ...string) {
+	gv.cur[strings.Join(labels, "\x00")] = labels
+	gv.WithLabelValues(labels...).Set(v)
+}
+
+func (gv *gaugeVec) flush() {
+	for key, labels := range gv.last {
+		if _, ok := gv.cur[key]; !ok {
+			gv.DeleteLabelValues(labels...)
+		}
+	}
+	gv.last, gv.cur = gv.cur, make(map[string][]string)
+}
+
+func (mt *metrics) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(mt.reg, promhttp.HandlerOpts{}))
+	return mux
+}
+
+func (mt *metrics) startServer(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	log.Printf("started metrics server on %s", addr)
+	go func() {
+		defer recoverPanic()
+		err := http.Serve(lis, mt.handler())
+		if err != nil {
+			log.Printf("failed to serve metrics: %v", err)
+		}
+	}()
+	return nil
+}
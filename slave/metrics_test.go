@@ -0,0 +1,80 @@
+package main
+
+import (
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"net/http"
+	"net/http/httptest"
+	"protocol"
+	"testing"
+)
+
+func scrapeMetrics(t *testing.T, mt *metrics) map[string]*dto.MetricFamily {
+	t.Helper()
+	srv := httptest.NewServer(mt.handler())
+	defer srv.Close()
+	res, err := http.Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("failed to scrape metrics: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d", res.StatusCode)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(res.Body)
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	return families
+}
+
+func serviceCount(families map[string]*dto.MetricFamily, group, state string) (float64, bool) {
+	f, ok := families["athena_slave_services"]
+	if !ok {
+		return 0, false
+	}
+	for _, metric := range f.Metric {
+		labels := make(map[string]string)
+		for _, l := range metric.Label {
+			labels[l.GetName()] = l.GetValue()
+		}
+		if labels["group"] == group && labels["state"] == state {
+			return metric.GetGauge().GetValue(), true
+		}
+	}
+	return 0, false
+}
+
+func TestMetricsScrape(t *testing.T) {
+	mt := newMetrics()
+	svcm := &serviceManager{services: []*service{
+		{Service: &protocol.Service{Name: "lobby-01", Group: "lobby", State: protocol.Service_STATE_ONLINE}},
+		{Service: &protocol.Service{Name: "lobby-02", Group: "lobby", State: protocol.Service_STATE_ONLINE}},
+		{Service: &protocol.Service{Name: "game-01", Group: "game", State: protocol.Service_STATE_SCHEDULED}},
+	}}
+	mt.update(svcm)
+	mt.templateSyncFiles.Add(3)
+
+	families := scrapeMetrics(t, mt)
+	if n, _ := serviceCount(families, "lobby", "online"); n != 2 {
+		t.Errorf("online lobby services = %v, want 2", n)
+	}
+	if n, _ := serviceCount(families, "game", "scheduled"); n != 1 {
+		t.Errorf("scheduled game services = %v, want 1", n)
+	}
+	if f := families["athena_slave_template_sync_files_total"]; f == nil || f.Metric[0].GetCounter().GetValue() != 3 {
+		t.Errorf("template sync files counter is missing or wrong")
+	}
+
+	svcm.services = svcm.services[:1]
+	mt.update(svcm)
+
+	families = scrapeMetrics(t, mt)
+	if n, _ := serviceCount(families, "lobby", "online"); n != 1 {
+		t.Errorf("online lobby services = %v, want 1", n)
+	}
+	if _, ok := serviceCount(families, "game", "scheduled"); ok {
+		t.Errorf("series of stopped group is still exported")
+	}
+}
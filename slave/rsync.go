@@ -0,0 +1,503 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/mmcloughlin/md4"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// This file implements the receiving side of the rsync daemon protocol version
+// 27, as spoken by the gokrazy/rsync server of the master. Files are always
+// transferred whole, unchanged files are skipped by their size and
+// modification time.
+
+const (
+	rsyncProtocolVersion = 27
+	rsyncMplexBase       = 7
+	rsyncMsgData         = 0
+	rsyncMsgError        = 1
+	rsyncMsgInfo         = 2
+	rsyncMaxMessageSize  = 1 << 24
+	rsyncXmitSameMode    = 1 << 1
+	rsyncXmitSameName    = 1 << 5
+	rsyncXmitLongName    = 1 << 6
+	rsyncXmitSameTime    = 1 << 7
+	rsyncIfmt            = 0o170000
+	rsyncIfdir           = 0o040000
+	rsyncIfreg           = 0o100000
+)
+
+type rsyncStats struct {
+	Files       int
+	Transferred int
+	Deleted     int
+	// Bytes is the amount of file data received.
+	Bytes     int64
+	TotalSize int64
+}
+
+type rsyncFile struct {
+	name  string
+	size  int64
+	mtime int32
+	mode  int32
+}
+
+func (f *rsyncFile) isDir() bool {
+	return f.mode&rsyncIfmt == rsyncIfdir
+}
+
+func (f *rsyncFile) isRegular() bool {
+	return f.mode&rsyncIfmt == rsyncIfreg
+}
+
+// rsyncPull mirrors the directory src of the module into dest. Files in dest
+// that do not exist in src are deleted.
+func rsyncPull(conn io.ReadWriter, module, src, dest string) (rsyncStats, error) {
+	var stats rsyncStats
+	rd := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+
+	err := rsyncHandshake(rd, w, module, src)
+	if err != nil {
+		return stats, err
+	}
+	seed, err := readInt32(rd)
+	if err != nil {
+		return stats, fmt.Errorf("failed to read checksum seed: %w", err)
+	}
+	// the server multiplexes everything it sends from here on
+	r := &rsyncMuxReader{r: rd}
+
+	// empty exclusion list
+	err = writeInt32(w, 0)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		return stats, fmt.Errorf("failed to send exclusion list: %w", err)
+	}
+
+	files, err := readFileList(r)
+	if err != nil {
+		return stats, fmt.Errorf("failed to read file list: %w", err)
+	}
+
+	err = os.MkdirAll(dest, 0755)
+	if err != nil {
+		return stats, fmt.Errorf("failed to create directory: %w", err)
+	}
+	var wanted []int32
+	for i, f := range files {
+		target := filepath.Join(dest, f.name)
+		if f.isDir() {
+			err = os.MkdirAll(target, 0755)
+			if err != nil {
+				return stats, fmt.Errorf("failed to create directory: %w", err)
+			}
+			continue
+		}
+		if !f.isRegular() {
+			continue
+		}
+		stats.Files++
+		stats.TotalSize += f.size
+		info, err := os.Lstat(target)
+		if err == nil && info.Mode().IsRegular() && info.Size() == f.size && info.ModTime().Unix() == int64(f.mtime) {
+			continue
+		}
+		wanted = append(wanted, int32(i))
+	}
+
+	// the requests are sent while the files are received, so neither side
+	// blocks on a full socket buffer
+	genErr := make(chan error, 1)
+	go func() {
+		defer recoverPanic()
+		genErr <- writeFileRequests(w, wanted)
+	}()
+
+	phases := 0
+	for phases < 2 {
+		idx, err := readInt32(r)
+		if err != nil {
+			return stats, fmt.Errorf("failed to read file index: %w", err)
+		}
+		if idx == -1 {
+			phases++
+			continue
+		}
+		if idx < 0 || int(idx) >= len(files) {
+			return stats, fmt.Errorf("invalid file index %d", idx)
+		}
+		f := files[idx]
+		n, err := receiveFile(r, seed, filepath.Join(dest, f.name), f)
+		if err != nil {
+			return stats, fmt.Errorf("failed to receive %q: %w", f.name, err)
+		}
+		stats.Transferred++
+		stats.Bytes += n
+	}
+	err = <-genErr
+	if err != nil {
+		return stats, fmt.Errorf("failed to request files: %w", err)
+	}
+
+	// total bytes read, total bytes written and total size of the server
+	for range 3 {
+		_, err = readInt64(r)
+		if err != nil {
+			return stats, fmt.Errorf("failed to read statistics: %w", err)
+		}
+	}
+	err = writeInt32(w, -1)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		return stats, fmt.Errorf("failed to finish transfer: %w", err)
+	}
+
+	stats.Deleted, err = deleteExtraneous(dest, files)
+	if err != nil {
+		return stats, fmt.Errorf("failed to delete extraneous files: %w", err)
+	}
+	return stats, nil
+}
+
+func rsyncHandshake(rd *bufio.Reader, w *bufio.Writer, module, src string) error {
+	greeting, err := rd.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read greeting: %w", err)
+	}
+	if !strings.HasPrefix(greeting, "@RSYNCD: ") {
+		return fmt.Errorf("invalid greeting %q", strings.TrimSpace(greeting))
+	}
+	_, _ = fmt.Fprintf(w, "@RSYNCD: %d\n%s\n", rsyncProtocolVersion, module)
+	err = w.Flush()
+	if err != nil {
+		return fmt.Errorf("failed to send greeting: %w", err)
+	}
+	for {
+		line, err := rd.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read module response: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		if line == "@RSYNCD: OK" {
+			break
+		}
+		if strings.HasPrefix(line, "@ERROR") || line == "@RSYNCD: EXIT" {
+			return fmt.Errorf("server refused module %q: %s", module, line)
+		}
+	}
+	// a trailing slash transfers the content of the directory
+	args := []string{"--server", "--sender", "-r", ".", module + "/" + strings.TrimSuffix(src, "/") + "/", ""}
+	_, _ = w.WriteString(strings.Join(args, "\n") + "\n")
+	err = w.Flush()
+	if err != nil {
+		return fmt.Errorf("failed to send arguments: %w", err)
+	}
+	return nil
+}
+
+func readFileList(r io.Reader) ([]*rsyncFile, error) {
+	var files []*rsyncFile
+	var last rsyncFile
+	for {
+		flags, err := readByte(r)
+		if err != nil {
+			return nil, err
+		}
+		if flags == 0 {
+			break
+		}
+		var inherit int
+		if flags&rsyncXmitSameName != 0 {
+			b, err := readByte(r)
+			if err != nil {
+				return nil, err
+			}
+			inherit = int(b)
+		}
+		var length int
+		if flags&rsyncXmitLongName != 0 {
+			n, err := readInt32(r)
+			if err != nil {
+				return nil, err
+			}
+			length = int(n)
+		} else {
+			b, err := readByte(r)
+			if err != nil {
+				return nil, err
+			}
+			length = int(b)
+		}
+		if inherit > len(last.name) || length < 0 || length > 4096 {
+			return nil, errors.New("invalid file name length")
+		}
+		name := make([]byte, length)
+		_, err = io.ReadFull(r, name)
+		if err != nil {
+			return nil, err
+		}
+		f := &rsyncFile{name: last.name[:inherit] + string(name), mtime: last.mtime, mode: last.mode}
+		f.size, err = readInt64(r)
+		if err != nil {
+			return nil, err
+		}
+		if flags&rsyncXmitSameTime == 0 {
+			f.mtime, err = readInt32(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if flags&rsyncXmitSameMode == 0 {
+			f.mode, err = readInt32(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if f.name != "." && !filepath.IsLocal(f.name) {
+			return nil, fmt.Errorf("invalid file name %q", f.name)
+		}
+		last = *f
+		files = append(files, f)
+	}
+	// the user and group lists are always sent by the server, followed by
+	// the io error flag
+	for range 2 {
+		for {
+			id, err := readInt32(r)
+			if err != nil {
+				return nil, err
+			}
+			if id == 0 {
+				break
+			}
+			n, err := readByte(r)
+			if err != nil {
+				return nil, err
+			}
+			_, err = io.CopyN(io.Discard, r, int64(n))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	_, err := readInt32(r)
+	if err != nil {
+		return nil, err
+	}
+	// the server sends the files sorted by name, the indices refer to this order
+	slices.SortStableFunc(files, func(a, b *rsyncFile) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return files, nil
+}
+
+// writeFileRequests asks for the given files without sending block checksums,
+// so they are transferred whole, and ends both transfer phases.
+func writeFileRequests(w *bufio.Writer, indices []int32) error {
+	for _, idx := range indices {
+		// file index, followed by checksum count, block length, checksum
+		// length and remainder length
+		for _, v := range []int32{idx, 0, 0, 0, 0} {
+			err := writeInt32(w, v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for range 2 {
+		err := writeInt32(w, -1)
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// receiveFile reads the data of a file and moves it into place once its
+// checksum was verified.
+func receiveFile(r io.Reader, seed int32, target string, f *rsyncFile) (int64, error) {
+	// checksum count, block length, checksum length and remainder length
+	for range 4 {
+		_, err := readInt32(r)
+		if err != nil {
+			return 0, err
+		}
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	h := md4.New()
+	_ = binary.Write(h, binary.LittleEndian, seed)
+	out := io.MultiWriter(tmp, h)
+	var size int64
+	for {
+		n, err := readInt32(r)
+		if err != nil {
+			_ = tmp.Close()
+			return 0, err
+		}
+		if n == 0 {
+			break
+		}
+		if n < 0 {
+			_ = tmp.Close()
+			return 0, errors.New("server sent a block reference for a whole file transfer")
+		}
+		_, err = io.CopyN(out, r, int64(n))
+		if err != nil {
+			_ = tmp.Close()
+			return 0, err
+		}
+		size += int64(n)
+	}
+	sum := make([]byte, md4.Size)
+	_, err = io.ReadFull(r, sum)
+	if err != nil {
+		_ = tmp.Close()
+		return 0, err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(sum, h.Sum(nil)) {
+		return 0, errors.New("checksum mismatch")
+	}
+	err = os.Chmod(tmp.Name(), os.FileMode(f.mode)&os.ModePerm)
+	if err != nil {
+		return 0, err
+	}
+	mtime := time.Unix(int64(f.mtime), 0)
+	err = os.Chtimes(tmp.Name(), mtime, mtime)
+	if err != nil {
+		return 0, err
+	}
+	return size, os.Rename(tmp.Name(), target)
+}
+
+// deleteExtraneous removes everything in dest that is not in the file list.
+func deleteExtraneous(dest string, files []*rsyncFile) (int, error) {
+	keep := make(map[string]bool, len(files))
+	for _, f := range files {
+		keep[filepath.Clean(f.name)] = true
+	}
+	var deleted int
+	err := filepath.WalkDir(dest, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dest, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." || keep[filepath.ToSlash(rel)] {
+			return nil
+		}
+		deleted++
+		err = os.RemoveAll(p)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// rsyncMuxReader reads the data of multiplexed messages, other messages are
+// logged or returned as errors.
+type rsyncMuxReader struct {
+	r   io.Reader
+	buf []byte
+}
+
+func (mr *rsyncMuxReader) Read(p []byte) (int, error) {
+	for len(mr.buf) == 0 {
+		var hdr [4]byte
+		_, err := io.ReadFull(mr.r, hdr[:])
+		if err != nil {
+			return 0, err
+		}
+		header := binary.LittleEndian.Uint32(hdr[:])
+		tag := int(header>>24) - rsyncMplexBase
+		length := header & 0xFFFFFF
+		if length > rsyncMaxMessageSize {
+			return 0, fmt.Errorf("message of %d bytes is too large", length)
+		}
+		msg := make([]byte, length)
+		_, err = io.ReadFull(mr.r, msg)
+		if err != nil {
+			return 0, err
+		}
+		switch tag {
+		case rsyncMsgData:
+			mr.buf = msg
+		case rsyncMsgInfo:
+			log.Printf("file server: %s", strings.TrimSpace(string(msg)))
+		case rsyncMsgError:
+			return 0, fmt.Errorf("file server: %s", strings.TrimSpace(string(msg)))
+		default:
+			return 0, fmt.Errorf("unexpected message tag %d", tag)
+		}
+	}
+	n := copy(p, mr.buf)
+	mr.buf = mr.buf[n:]
+	return n, nil
+}
+
+func readByte(r io.Reader) (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(r, b[:])
+	return b[0], err
+}
+
+func readInt32(r io.Reader) (int32, error) {
+	var b [4]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.LittleEndian.Uint32(b[:])), nil
+}
+
+// readInt64 reads a 64 bit integer, which rsync sends as a 32 bit integer if
+// it fits.
+func readInt64(r io.Reader) (int64, error) {
+	n, err := readInt32(r)
+	if err != nil || n != -1 {
+		return int64(n), err
+	}
+	var b [8]byte
+	_, err = io.ReadFull(r, b[:])
+	if err != nil {
+		return 0, err
+	}
+	return int64(binary.LittleEndian.Uint64(b[:])), nil
+}
+
+func writeInt32(w io.Writer, v int32) error {
+	return binary.Write(w, binary.LittleEndian, v)
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/gokrazy/rsync/rsyncd"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startRsyncd serves src as the templates module of an in-process rsync
+// daemon, like the file server of the master.
+func startRsyncd(t *testing.T, src string) string {
+	t.Helper()
+	srv, err := rsyncd.NewServer([]rsyncd.Module{
+		{
+			Name: fileServerModule,
+			Path: src,
+		},
+	}, rsyncd.WithLogger(log.New(io.Discard, "", 0)))
+	if err != nil {
+		t.Fatalf("failed to create rsync server: %v", err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		_ = lis.Close()
+	})
+	go func() {
+		_ = srv.Serve(ctx, lis)
+	}()
+	return lis.Addr().String()
+}
+
+func pull(t *testing.T, addr, src, dest string) (rsyncStats, error) {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	return rsyncPull(&deadlineConn{Conn: conn, timeout: 5 * time.Second}, fileServerModule, src, dest)
+}
+
+func writeFile(t *testing.T, file, content string, mtime time.Time) {
+	t.Helper()
+	err := os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(file, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chtimes(file, mtime, mtime)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertFile(t *testing.T, file, content string) {
+	t.Helper()
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", file, err)
+	}
+	if string(b) != content {
+		t.Fatalf("%s contains %q, want %q", file, b, content)
+	}
+}
+
+func TestRsyncPull(t *testing.T) {
+	root := t.TempDir()
+	src := filepath.Join(root, "src")
+	dest := filepath.Join(root, "dest")
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	writeFile(t, filepath.Join(src, "lobby", "server.properties"), "motd=hello\n", mtime)
+	writeFile(t, filepath.Join(src, "lobby", "plugins", "config.yml"), "enabled: true\n", mtime)
+	writeFile(t, filepath.Join(src, "lobby", "world", "level.dat"), strings.Repeat("x", 256<<10), mtime)
+	addr := startRsyncd(t, src)
+
+	// full transfer into an empty directory
+	stats, err := pull(t, addr, "lobby", dest)
+	if err != nil {
+		t.Fatalf("full pull failed: %v", err)
+	}
+	if stats.Files != 3 || stats.Transferred != 3 || stats.Bytes != int64(256<<10+25) {
+		t.Fatalf("full pull: %+v", stats)
+	}
+	assertFile(t, filepath.Join(dest, "server.properties"), "motd=hello\n")
+	assertFile(t, filepath.Join(dest, "plugins", "config.yml"), "enabled: true\n")
+	info, err := os.Stat(filepath.Join(dest, "world", "level.dat"))
+	if err != nil || !info.ModTime().Equal(mtime) {
+		t.Fatalf("modification time was not preserved: %v", err)
+	}
+
+	// unchanged files are skipped
+	stats, err = pull(t, addr, "lobby", dest)
+	if err != nil {
+		t.Fatalf("incremental pull failed: %v", err)
+	}
+	if stats.Transferred != 0 || stats.Deleted != 0 {
+		t.Fatalf("unchanged pull: %+v", stats)
+	}
+
+	// only the changed file is transferred
+	writeFile(t, filepath.Join(src, "lobby", "server.properties"), "motd=changed\n", mtime.Add(time.Minute))
+	stats, err = pull(t, addr, "lobby", dest)
+	if err != nil {
+		t.Fatalf("incremental pull failed: %v", err)
+	}
+	if stats.Transferred != 1 || stats.Bytes != int64(len("motd=changed\n")) {
+		t.Fatalf("incremental pull: %+v", stats)
+	}
+	assertFile(t, filepath.Join(dest, "server.properties"), "motd=changed\n")
+
+	// files and directories that are gone from the source are deleted
+	err = os.RemoveAll(filepath.Join(src, "lobby", "plugins"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(dest, "local.txt"), "local", mtime)
+	stats, err = pull(t, addr, "lobby", dest)
+	if err != nil {
+		t.Fatalf("pull with deletions failed: %v", err)
+	}
+	if stats.Deleted != 2 || stats.Transferred != 0 {
+		t.Fatalf("pull with deletions: %+v", stats)
+	}
+	for _, p := range []string{"plugins", "local.txt"} {
+		if _, err := os.Stat(filepath.Join(dest, p)); !os.IsNotExist(err) {
+			t.Fatalf("%s was not deleted", p)
+		}
+	}
+	assertFile(t, filepath.Join(dest, "server.properties"), "motd=changed\n")
+}
+
+// corruptingProxy forwards a connection to the rsync daemon and replaces old
+// with new in the multiplexed data the daemon sends.
+func corruptingProxy(t *testing.T, addr string, old, new []byte) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lis.Close()
+	})
+	go func() {
+		for {
+			client, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			server, err := net.Dial("tcp", addr)
+			if err != nil {
+				_ = client.Close()
+				return
+			}
+			go func() {
+				_, _ = io.Copy(server, client)
+				_ = server.Close()
+			}()
+			go func() {
+				defer func() {
+					_ = client.Close()
+				}()
+				r := bufio.NewReader(server)
+				// the greeting and module response are sent as lines,
+				// followed by the checksum seed
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					_, _ = client.Write([]byte(line))
+					if strings.TrimSpace(line) == "@RSYNCD: OK" {
+						break
+					}
+				}
+				var seed [4]byte
+				if _, err := io.ReadFull(r, seed[:]); err != nil {
+					return
+				}
+				_, _ = client.Write(seed[:])
+				for {
+					var hdr [4]byte
+					if _, err := io.ReadFull(r, hdr[:]); err != nil {
+						return
+					}
+					msg := make([]byte, binary.LittleEndian.Uint32(hdr[:])&0xFFFFFF)
+					if _, err := io.ReadFull(r, msg); err != nil {
+						return
+					}
+					msg = bytes.ReplaceAll(msg, old, new)
+					if _, err := client.Write(append(hdr[:], msg...)); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return lis.Addr().String()
+}
+
+func TestRsyncPullChecksumMismatch(t *testing.T) {
+	root := t.TempDir()
+	src := filepath.Join(root, "src")
+	dest := filepath.Join(root, "dest")
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	writeFile(t, filepath.Join(src, "lobby", "server.properties"), "motd=original\n", mtime)
+	addr := corruptingProxy(t, startRsyncd(t, src), []byte("original"), []byte("tampered"))
+
+	_, err := pull(t, addr, "lobby", dest)
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("pull of corrupted data returned %v, want checksum mismatch", err)
+	}
+	// the corrupted file must not end up in the cache
+	entries, _ := os.ReadDir(dest)
+	for _, e := range entries {
+		t.Errorf("unexpected file %s after failed pull", e.Name())
+	}
+}
+
+func TestRsyncPullStalledServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer func() {
+		_ = lis.Close()
+	}()
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		// greet, then never answer
+		_, _ = conn.Write([]byte("@RSYNCD: 27.0\n"))
+		time.Sleep(5 * time.Second)
+		_ = conn.Close()
+	}()
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	start := time.Now()
+	_, err = rsyncPull(&deadlineConn{Conn: conn, timeout: 200 * time.Millisecond}, fileServerModule, "lobby", t.TempDir())
+	if err == nil {
+		t.Fatalf("pull from a stalled server succeeded")
+	}
+	if time.Since(start) > 2*time.Second {
+		t.Fatalf("pull from a stalled server took %s", time.Since(start))
+	}
+}
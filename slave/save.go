@@ -0,0 +1,193 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"protocol"
+	"strings"
+	"time"
+)
+
+const (
+	// saveChunkSize is the size of the chunks a saved service directory is
+	// streamed to the master in.
+	saveChunkSize = 1 << 20
+	// saveAllDelay is the time servers get to flush their worlds.
+	saveAllDelay = 5 * time.Second
+)
+
+// defaultSaveExcludes are never saved, the athena config contains the key of
+// the service.
+var defaultSaveExcludes = []string{"plugins/athena/config.json"}
+
+func (s *slave) saveService(p *protocol.PacketSaveService) error {
+	svc := s.svcm.getService(p.ServiceName)
+	if svc == nil {
+		return fmt.Errorf("service %q not found", p.ServiceName)
+	}
+	if svc.preparing {
+		return fmt.Errorf("service %q is still being prepared", p.ServiceName)
+	}
+	excludes := append(p.Excludes, defaultSaveExcludes...)
+	for _, pattern := range excludes {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("invalid exclude pattern %q: %w", pattern, err)
+		}
+	}
+	saveAll := p.SaveAll && svc.Type == protocol.Service_TYPE_SERVER && svc.w != nil
+	if saveAll {
+		_, err := svc.w.Write([]byte("save-all flush\n"))
+		if err != nil {
+			return fmt.Errorf("failed to write to service %q: %w", p.ServiceName, err)
+		}
+	}
+	log.Printf("saving service %q", svc.Name)
+	dir := svc.dir
+	go func() {
+		defer recoverPanic()
+		if saveAll {
+			time.Sleep(saveAllDelay)
+		}
+		w := &saveWriter{s: s, saveId: p.SaveId}
+		err := packDir(w, dir, excludes)
+		if err == nil {
+			err = w.flush(true)
+		}
+		if err != nil {
+			log.Printf("failed to save service %q: %v", p.ServiceName, err)
+			_ = s.sendPacket(&protocol.PacketServiceSaveChunk{
+				SaveId: p.SaveId,
+				Last:   true,
+				Error:  err.Error(),
+			})
+			return
+		}
+		log.Printf("saved service %q (%d KiB)", p.ServiceName, w.size>>10)
+	}()
+	return nil
+}
+
+// packDir writes a gzipped tar archive of all regular files and directories
+// in dir that are not excluded.
+func packDir(w io.Writer, dir string, excludes []string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if isExcluded(rel, excludes) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = rel
+		if d.IsDir() {
+			hdr.Name += "/"
+		}
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		// files that grow while they are packed are cut off at their size
+		// from before
+		_, err = io.CopyN(tw, f, hdr.Size)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pack directory: %w", err)
+	}
+	err = tw.Close()
+	if err != nil {
+		return fmt.Errorf("failed to pack directory: %w", err)
+	}
+	return gz.Close()
+}
+
+// isExcluded reports whether the slash separated path matches one of the
+// patterns. Patterns without a slash also match the base name, so "*.log"
+// excludes log files in every directory.
+func isExcluded(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// saveWriter sends everything written to it to the master in chunks.
+type saveWriter struct {
+	s      *slave
+	saveId uint64
+	buf    []byte
+	size   int64
+}
+
+func (w *saveWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= saveChunkSize {
+		err := w.flush(false)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *saveWriter) flush(last bool) error {
+	err := w.s.sendPacket(&protocol.PacketServiceSaveChunk{
+		SaveId: w.saveId,
+		Data:   w.buf,
+		Last:   last,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send chunk: %w", err)
+	}
+	w.size += int64(len(w.buf))
+	w.buf = nil
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"common"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"google.golang.org/protobuf/proto"
 	"io"
@@ -20,18 +21,26 @@ import (
 	"time"
 )
 
+// serviceConnectTimeout is the time a service has to identify itself after
+// connecting to the slave.
+const serviceConnectTimeout = 10 * time.Second
+
 type serviceManager struct {
 	s        *slave
 	services []*service
 	byName   map[string]*service
 	tmpDir   string
+	// staticDir holds the working directories of static services, which
+	// survive restarts of the service and the slave.
+	staticDir string
 }
 
 func newServiceManager(s *slave) (*serviceManager, error) {
 	svcm := &serviceManager{
-		s:      s,
-		tmpDir: "tmp",
-		byName: make(map[string]*service),
+		s:         s,
+		tmpDir:    "tmp",
+		staticDir: "static",
+		byName:    make(map[string]*service),
 	}
 	err := svcm.init()
 	if err != nil {
@@ -49,6 +58,10 @@ func (svcm *serviceManager) init() error {
 	if err != nil {
 		return fmt.Errorf("failed to create temp directory: %w", err)
 	}
+	err = os.MkdirAll(svcm.staticDir, 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create static directory: %w", err)
+	}
 	return nil
 }
 
@@ -60,6 +73,7 @@ type screen struct {
 
 type service struct {
 	*protocol.Service
+	svcm *serviceManager
 	g    *protocol.Group
 	conn net.Conn
 	dir  string
@@ -67,6 +81,10 @@ type service struct {
 	cmd  *exec.Cmd
 	w    io.Writer
 	sc   *screen
+	caps map[string]bool
+	// preparing is set while the templates of the service are synced and
+	// copied outside the command queue.
+	preparing bool
 }
 
 func (svcm *serviceManager) setConnected(svc *service, conn net.Conn) {
@@ -79,67 +97,119 @@ func (svcm *serviceManager) setConnected(svc *service, conn net.Conn) {
 	})
 }
 
+// createService registers the service and prepares its directory in the
+// background, since syncing and copying the templates can take longer than the
+// heartbeat timeout. Once prepared, a servicePreparedCmd starts the service.
 func (svcm *serviceManager) createService(protoService *protocol.Service, group *protocol.Group) (*service, error) {
+	if svcm.byName[protoService.Name] != nil {
+		return nil, fmt.Errorf("service %q already exists", protoService.Name)
+	}
 	svc := &service{
-		Service: protoService,
-		g:       group,
-		key:     common.GenerateRandomHex(32),
-		sc:      &screen{},
+		Service:   protoService,
+		svcm:      svcm,
+		g:         group,
+		key:       common.GenerateRandomHex(32),
+		sc:        &screen{},
+		preparing: true,
+	}
+
+	// templates are only applied to new directories, so static services keep
+	// the changes made while they were running
+	applyTemplates := true
+	if group.Static {
+		svc.dir = path.Join(svcm.staticDir, svc.Name)
+		_, err := os.Stat(svc.dir)
+		if err == nil {
+			applyTemplates = false
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to stat static directory: %w", err)
+		}
+	} else {
+		svc.dir = path.Join(svcm.tmpDir, fmt.Sprintf("%s-%s", svc.Name, common.GenerateRandomHex(3)))
 	}
 
-	svc.dir = path.Join(svcm.tmpDir, fmt.Sprintf("%s-%s", svc.Name, common.GenerateRandomHex(3)))
+	svcm.services = append(svcm.services, svc)
+	svcm.byName[svc.Name] = svc
+	go func() {
+		defer recoverPanic()
+		err := svcm.prepareService(svc, applyTemplates)
+		svcm.s.ch <- servicePreparedCmd{svc: svc, err: err}
+	}()
+	return svc, nil
+}
+
+// prepareService creates the directory of the service and writes its
+// templates and athena config. It runs outside the command queue and must
+// only touch the directory of the service.
+func (svcm *serviceManager) prepareService(svc *service, applyTemplates bool) error {
 	err := os.MkdirAll(svc.dir, 0755)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+		return fmt.Errorf("failed to create service directory: %w", err)
+	}
+	if applyTemplates {
+		err = svcm.applyTemplates(svc)
+		if err != nil {
+			if svc.g.Static {
+				// let the next attempt start from scratch
+				_ = os.RemoveAll(svc.dir)
+			}
+			return err
+		}
 	}
 
 	_, port := common.SplitBindAddr(svcm.s.cfg.BindAddr)
 
 	athenaConfig := map[string]any{
-		"slaveAddr": "127.0.0.1",
-		"slavePort": port,
-		"key":       svc.key,
+		"slaveAddr":        "127.0.0.1",
+		"slavePort":        port,
+		"key":              svc.key,
+		"heartbeatTimeout": svcm.s.cfg.HeartbeatTimeout,
 	}
 	configBytes, err := json.Marshal(athenaConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal athena config: %w", err)
+		return fmt.Errorf("failed to marshal athena config: %w", err)
 	}
 	athenaDataDir := path.Join(svc.dir, "plugins", "athena")
 	err = os.MkdirAll(athenaDataDir, 0755)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create athena data directory: %w", err)
+		return fmt.Errorf("failed to create athena data directory: %w", err)
 	}
 	err = os.WriteFile(path.Join(athenaDataDir, "config.json"), configBytes, 0644)
 	if err != nil {
-		return nil, fmt.Errorf("failed to write athena config: %w", err)
+		return fmt.Errorf("failed to write athena config: %w", err)
 	}
+	return nil
+}
 
-	var typeSpecificTempl string
-	if svc.Type == protocol.Service_TYPE_PROXY {
-		typeSpecificTempl = "global_proxy"
-	} else if svc.Type == protocol.Service_TYPE_SERVER {
-		typeSpecificTempl = "global_server"
-	} else {
-		return nil, fmt.Errorf("unknown service type: %v", svc.Type)
+func (svcm *serviceManager) applyTemplates(svc *service) error {
+	// the master resolves the templates of the service, older masters only
+	// use the global templates and the one of the group
+	templates := svc.Templates
+	if len(templates) == 0 {
+		var typeSpecificTempl string
+		if svc.Type == protocol.Service_TYPE_PROXY {
+			typeSpecificTempl = "global_proxy"
+		} else if svc.Type == protocol.Service_TYPE_SERVER {
+			typeSpecificTempl = "global_server"
+		} else {
+			return fmt.Errorf("unknown service type: %v", svc.Type)
+		}
+		templates = []string{"global_all", typeSpecificTempl, svc.g.Name}
 	}
 
-	log.Println("downloading templates")
-	err = svcm.s.tmpl.syncTemplates()
+	err := svcm.s.tmpl.syncTemplates(templates, svc.TemplateHashes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to download template: %w", err)
+		return fmt.Errorf("failed to download templates: %w", err)
 	}
 
-	templates := []string{"global_all", typeSpecificTempl, group.Name}
 	for _, tmpl := range templates {
-		templateDir := path.Join(svcm.s.tmpl.templateDir, tmpl)
-		err = os.CopyFS(svc.dir, os.DirFS(templateDir))
+		templateDir := path.Join(svcm.s.tmpl.templateDir, protocol.TemplatePath(tmpl))
+		err = copyTemplate(svc.dir, templateDir)
 		if err != nil {
-			return nil, fmt.Errorf("failed to copy template %q: %w", tmpl, err)
+			return fmt.Errorf("failed to copy template %q: %w", tmpl, err)
 		}
 	}
-	svcm.services = append(svcm.services, svc)
-	svcm.byName[svc.Name] = svc
-	return svc, err
+	return nil
 }
 
 func (svcm *serviceManager) startService(svc *service) error {
@@ -157,6 +227,9 @@ func (svcm *serviceManager) startService(svc *service) error {
 	}
 	if svc.Type == protocol.Service_TYPE_SERVER {
 		serverArgs = append(serverArgs, "--nogui", "--online-mode=false")
+		if svc.g.MaxPlayers > 0 {
+			serverArgs = append(serverArgs, "--max-players", strconv.Itoa(int(svc.g.MaxPlayers)))
+		}
 	}
 	args := append(append(jvmArgs, "-jar", "server.jar"), serverArgs...)
 
@@ -223,6 +296,11 @@ func (svcm *serviceManager) startService(svc *service) error {
 }
 
 func (svcm *serviceManager) stopService(svc *service) error {
+	if svc.preparing {
+		// the service is not started once it is prepared
+		svc.State = protocol.Service_STATE_STOPPING
+		return nil
+	}
 	if svc.cmd == nil {
 		return fmt.Errorf("service is not running")
 	}
@@ -240,6 +318,23 @@ func (svcm *serviceManager) stopService(svc *service) error {
 	return nil
 }
 
+// serviceStopped reports the stopped service to the master and removes it.
+func (svcm *serviceManager) serviceStopped(svc *service) {
+	err := svcm.s.sendPacket(&protocol.PacketServiceStopped{
+		ServiceName: svc.Name,
+	})
+	if err != nil {
+		log.Printf("failed to send service stopped packet: %v", err)
+	}
+	svc.State = protocol.Service_STATE_OFFLINE
+	svc.Port = 0
+	svc.cmd = nil
+	err = svcm.deleteService(svc)
+	if err != nil {
+		log.Printf("failed to delete service %q: %v", svc.Name, err)
+	}
+}
+
 func (svcm *serviceManager) getService(name string) *service {
 	return svcm.byName[name]
 }
@@ -250,6 +345,9 @@ func (svcm *serviceManager) deleteService(svc *service) error {
 	}
 	svcm.services = common.DeleteItem(svcm.services, svc)
 	delete(svcm.byName, svc.Name)
+	if svc.g.Static {
+		return nil
+	}
 	err := os.RemoveAll(svc.dir)
 	if err != nil {
 		return fmt.Errorf("failed to remove service directory: %w", err)
@@ -286,7 +384,7 @@ func (svcm *serviceManager) checkPort(host string, port int32) bool {
 	return true
 }
 
-func handleServiceConnection(ch chan<- any, lis net.Listener) {
+func handleServiceConnection(ch chan<- any, lis net.Listener, cfg *config) {
 	defer recoverPanic()
 	for {
 		conn, err := lis.Accept()
@@ -298,20 +396,11 @@ func handleServiceConnection(ch chan<- any, lis net.Listener) {
 		go func() {
 			defer recoverPanic()
 			var svc *service
-			closed := false
-			defer func() {
-				closed = true
-			}()
-			go func() {
-				defer recoverPanic()
-				time.Sleep(10 * time.Second)
-				if svc == nil && !closed {
-					closed = true
-					log.Printf("service at %s timed out", conn.RemoteAddr())
-					_ = conn.Close()
-				}
-			}()
-			p, err := protocol.ReadPacket(conn)
+			opts := protocol.ReadOptions{
+				MaxFrameSize: uint32(cfg.MaxPreAuthFrameSize),
+				Timeout:      serviceConnectTimeout,
+			}
+			p, err := protocol.ReadPacketWithOptions(conn, opts)
 			if err != nil {
 				log.Printf("failed reading packet: %v", err)
 				_ = conn.Close()
@@ -321,9 +410,20 @@ func handleServiceConnection(ch chan<- any, lis net.Listener) {
 				log.Printf("%s sent invalid packet %T", conn.RemoteAddr(), p)
 				_ = conn.Close()
 				return
+			} else if err := protocol.CheckVersion(p.ProtocolVersion); err != nil {
+				log.Printf("service at %s is incompatible: %v", conn.RemoteAddr(), err)
+				_ = protocol.SendPacket(conn, &protocol.PacketAuthFailed{Message: fmt.Sprintf("incompatible plugin: %v", err)})
+				_ = conn.Close()
+				return
 			} else {
+				caps := protocol.NegotiateCapabilities(p.Capabilities)
+				opts.MaxFrameSize = uint32(cfg.MaxFrameSize)
+				opts.Timeout = 0
+				if caps[protocol.CapabilityHeartbeat] {
+					opts.Timeout = time.Duration(cfg.HeartbeatTimeout) * time.Second
+				}
 				svcCh := make(chan *service)
-				ch <- serviceConnectCmd{key: p.Key, conn: conn, svcCh: svcCh}
+				ch <- serviceConnectCmd{key: p.Key, caps: caps, conn: conn, svcCh: svcCh}
 				svc = <-svcCh
 			}
 			if svc == nil {
@@ -332,7 +432,7 @@ func handleServiceConnection(ch chan<- any, lis net.Listener) {
 				return
 			}
 			for {
-				p, err := protocol.ReadPacket(conn)
+				p, err := protocol.ReadPacketWithOptions(conn, opts)
 				if err != nil {
 					log.Printf("failed reading packet: %v", err)
 					break
@@ -355,9 +455,26 @@ func (svc *service) sendPacket(p proto.Message) error {
 	if svc.conn == nil {
 		return fmt.Errorf("not connected")
 	}
+	_ = svc.conn.SetWriteDeadline(time.Now().Add(time.Duration(svc.svcm.s.cfg.HeartbeatTimeout) * time.Second))
 	return protocol.SendPacket(svc.conn, p)
 }
 
 func (svc *service) handlePacket(p proto.Message) error {
+	switch p := p.(type) {
+	case *protocol.PacketPong:
+		// the read deadline of the connection was already extended
+	case *protocol.PacketServicePlayerCount:
+		svc.Players = p.Players
+		if !svc.svcm.s.masterCaps[protocol.CapabilityPlayerCount] {
+			return nil
+		}
+		err := svc.svcm.s.sendPacket(&protocol.PacketServicePlayerCount{
+			ServiceName: svc.Name,
+			Players:     p.Players,
+		})
+		if err != nil {
+			log.Printf("failed to send player count of service %q: %v", svc.Name, err)
+		}
+	}
 	return nil
 }
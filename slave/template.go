@@ -1,30 +1,56 @@
 package main
 
 import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"syscall"
+	"protocol"
+	"strings"
+	"sync"
+	"time"
 )
 
-func checkForRsync() error {
-	_, err := exec.LookPath("rsync")
-	if err != nil {
-		return fmt.Errorf("rsync is not installed: %v", err)
-	}
-	return nil
-}
+const (
+	fileServerModule      = "templates"
+	fileServerDialTimeout = 10 * time.Second
+	// fileServerIoTimeout is the time the file server may stall before a
+	// sync fails.
+	fileServerIoTimeout = 30 * time.Second
+	manifestFile        = "manifest.json"
+)
 
 type templateManager struct {
 	s           *slave
 	templateDir string
+
+	mu sync.Mutex
+	// syncing holds the templates that are being synced, later syncs of the
+	// same template wait for the running one.
+	syncing map[string]*templateSync
+	// manifest maps the template versions in the cache to their content
+	// hashes. Versions in the manifest are not synced again.
+	manifest map[string]string
+}
+
+type templateSync struct {
+	done  chan struct{}
+	stats rsyncStats
+	err   error
 }
 
 func newTemplateManager(s *slave) (*templateManager, error) {
 	tmpl := &templateManager{
 		s:           s,
 		templateDir: "template_cache",
+		syncing:     make(map[string]*templateSync),
+		manifest:    make(map[string]string),
 	}
 	err := tmpl.init()
 	if err != nil {
@@ -38,24 +64,251 @@ func (tmpl *templateManager) init() error {
 	if err != nil {
 		return fmt.Errorf("failed to create template directory: %w", err)
 	}
+	b, err := os.ReadFile(filepath.Join(tmpl.templateDir, manifestFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read template manifest: %w", err)
+	}
+	err = json.Unmarshal(b, &tmpl.manifest)
+	if err != nil {
+		log.Printf("ignoring invalid template manifest: %v", err)
+		tmpl.manifest = make(map[string]string)
+	}
+	for ref := range tmpl.manifest {
+		_, err = os.Stat(filepath.Join(tmpl.templateDir, protocol.TemplatePath(ref)))
+		if err != nil {
+			delete(tmpl.manifest, ref)
+		}
+	}
 	return nil
 }
 
-func (tmpl *templateManager) syncTemplates() error {
-	path, err := filepath.Abs(tmpl.templateDir)
+// saveManifest writes the manifest to the cache, so it survives restarts. The
+// caller must hold the lock.
+func (tmpl *templateManager) saveManifest() error {
+	b, err := json.Marshal(tmpl.manifest)
 	if err != nil {
-		return fmt.Errorf("could not get absolute path for template directory: %w", err)
+		return fmt.Errorf("failed to marshal template manifest: %w", err)
 	}
-	url := fmt.Sprintf("rsync://%s/templates/", tmpl.s.cfg.FileServerHost)
-	cmd := exec.Command("rsync", "-a", "--delete", "--port", tmpl.s.cfg.FileServerPort, url, path)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Pdeathsig: syscall.SIGKILL,
+	file := filepath.Join(tmpl.templateDir, manifestFile)
+	err = os.WriteFile(file+".tmp", b, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write template manifest: %w", err)
 	}
-	err = cmd.Run()
+	err = os.Rename(file+".tmp", file)
 	if err != nil {
-		return fmt.Errorf("failed to sync templates: %w", err)
+		return fmt.Errorf("failed to write template manifest: %w", err)
 	}
 	return nil
 }
+
+// syncTemplates downloads the given templates from the file server of the
+// master in parallel. Templates whose cached copy matches the given content
+// hash are skipped.
+func (tmpl *templateManager) syncTemplates(templates []string, hashes map[string]string) error {
+	start := time.Now()
+	var wg sync.WaitGroup
+	errs := make([]error, len(templates))
+	for i, ref := range templates {
+		wg.Add(1)
+		go func() {
+			defer recoverPanic()
+			defer wg.Done()
+			errs[i] = tmpl.syncTemplate(ref, hashes[ref])
+		}()
+	}
+	wg.Wait()
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+	tmpl.s.metrics.templateSync.Observe(time.Since(start).Seconds())
+	return nil
+}
+
+// syncTemplate downloads a template unless it is already being downloaded, in
+// which case it waits for that download.
+func (tmpl *templateManager) syncTemplate(ref, hash string) error {
+	tmpl.mu.Lock()
+	if cached, exists := tmpl.manifest[ref]; exists && cached == hash {
+		tmpl.mu.Unlock()
+		return nil
+	}
+	if running, exists := tmpl.syncing[ref]; exists {
+		tmpl.mu.Unlock()
+		<-running.done
+		return running.err
+	}
+	running := &templateSync{done: make(chan struct{})}
+	tmpl.syncing[ref] = running
+	tmpl.mu.Unlock()
+
+	start := time.Now()
+	running.stats, running.err = tmpl.download(ref)
+
+	tmpl.mu.Lock()
+	delete(tmpl.syncing, ref)
+	// the content of a template version never changes, templates without a
+	// version are synced every time
+	if running.err == nil && (hash != "" || strings.Contains(ref, "@")) {
+		tmpl.manifest[ref] = hash
+		err := tmpl.saveManifest()
+		if err != nil {
+			log.Printf("%v", err)
+		}
+	}
+	tmpl.mu.Unlock()
+	close(running.done)
+
+	if running.err != nil {
+		return fmt.Errorf("failed to sync template %q: %w", ref, running.err)
+	}
+	stats := running.stats
+	tmpl.s.metrics.templateSyncFiles.Add(float64(stats.Transferred))
+	tmpl.s.metrics.templateSyncBytes.Add(float64(stats.Bytes))
+	if stats.Transferred > 0 || stats.Deleted > 0 {
+		log.Printf("synced template %q: %d of %d files transferred (%d KiB of %d KiB), %d deleted in %s",
+			ref, stats.Transferred, stats.Files, stats.Bytes>>10, stats.TotalSize>>10, stats.Deleted,
+			time.Since(start).Round(time.Millisecond))
+	}
+	return nil
+}
+
+// templateChanged drops the older versions of a changed template from the
+// cache. If the slave used the template before, the new version is fetched in
+// the background, so the next service does not wait for it.
+func (tmpl *templateManager) templateChanged(p *protocol.PacketTemplateChanged) {
+	ref := protocol.TemplateRef(p.Template, int(p.Version))
+	tmpl.mu.Lock()
+	used := false
+	for cached := range tmpl.manifest {
+		name, _, _ := strings.Cut(cached, "@")
+		if name != p.Template || cached == ref {
+			continue
+		}
+		used = true
+		if _, exists := tmpl.syncing[cached]; exists {
+			continue
+		}
+		delete(tmpl.manifest, cached)
+		err := os.RemoveAll(filepath.Join(tmpl.templateDir, protocol.TemplatePath(cached)))
+		if err != nil {
+			log.Printf("failed to remove template %q from cache: %v", cached, err)
+		}
+	}
+	if used {
+		err := tmpl.saveManifest()
+		if err != nil {
+			log.Printf("%v", err)
+		}
+	}
+	tmpl.mu.Unlock()
+	if !used {
+		return
+	}
+	log.Printf("template %q changed to version %d", p.Template, p.Version)
+	go func() {
+		defer recoverPanic()
+		err := tmpl.syncTemplate(ref, p.Hash)
+		if err != nil {
+			log.Printf("%v", err)
+		}
+	}()
+}
+
+func (tmpl *templateManager) download(ref string) (rsyncStats, error) {
+	err := protocol.ValidateTemplateName(strings.SplitN(ref, "@", 2)[0])
+	if err != nil {
+		return rsyncStats{}, err
+	}
+	conn, err := tmpl.dialFileServer()
+	if err != nil {
+		return rsyncStats{}, fmt.Errorf("failed to connect to file server: %w", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	templatePath := protocol.TemplatePath(ref)
+	return rsyncPull(&deadlineConn{Conn: conn, timeout: fileServerIoTimeout}, fileServerModule, templatePath, filepath.Join(tmpl.templateDir, templatePath))
+}
+
+func (tmpl *templateManager) dialFileServer() (net.Conn, error) {
+	addr := net.JoinHostPort(tmpl.s.cfg.FileServerHost, tmpl.s.cfg.FileServerPort)
+	dialer := &net.Dialer{Timeout: fileServerDialTimeout}
+	if tmpl.s.tlsCfg != nil {
+		return tls.DialWithDialer(dialer, "tcp", addr, tmpl.s.tlsCfg)
+	}
+	return dialer.Dial("tcp", addr)
+}
+
+// deadlineConn extends the deadline of the connection before every read and
+// write, so a peer that stalls fails the operation instead of blocking it
+// forever.
+type deadlineConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *deadlineConn) Read(p []byte) (int, error) {
+	err := c.SetReadDeadline(time.Now().Add(c.timeout))
+	if err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(p)
+}
+
+func (c *deadlineConn) Write(p []byte) (int, error) {
+	err := c.SetWriteDeadline(time.Now().Add(c.timeout))
+	if err != nil {
+		return 0, err
+	}
+	return c.Conn.Write(p)
+}
+
+// copyTemplate copies the template into dir. Unlike os.CopyFS, existing files
+// are overwritten, so later templates can replace files of earlier ones.
+func copyTemplate(dir, templateDir string) error {
+	return filepath.WalkDir(templateDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(templateDir, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return copyFile(target, p, info.Mode().Perm())
+	})
+}
+
+func copyFile(dst, src string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	defaultClientCertFile = "pki/slave.crt"
+	defaultClientKeyFile  = "pki/slave.key"
+)
+
+type tlsConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CaFile     string `yaml:"ca_file"`
+	CertFile   string `yaml:"cert_file"`
+	KeyFile    string `yaml:"key_file"`
+	ServerName string `yaml:"server_name"`
+}
+
+// clientTlsConfig returns the tls config used to connect to the master and its
+// file server. The client certificate is optional, without it the slave
+// authenticates with the secret key.
+func (cfg *tlsConfig) clientTlsConfig(masterHost string) (*tls.Config, error) {
+	caPem, err := os.ReadFile(cfg.CaFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ca certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("no certificates found in %s", cfg.CaFile)
+	}
+	serverName := cfg.ServerName
+	if serverName == "" {
+		serverName = masterHost
+	}
+	tlsCfg := &tls.Config{
+		RootCAs:    pool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.hasClientCert() {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return tlsCfg, nil
+}
+
+// hasClientCert reports whether a client certificate should be loaded. A
+// missing certificate at the default path is not an error, since mutual tls is
+// optional.
+func (cfg *tlsConfig) hasClientCert() bool {
+	if cfg.CertFile == "" {
+		return false
+	}
+	if cfg.CertFile != defaultClientCertFile {
+		return true
+	}
+	_, err := os.Stat(cfg.CertFile)
+	return !errors.Is(err, os.ErrNotExist)
+}